@@ -202,6 +202,7 @@ variable "service_labels" {
     "machinelearning",
     "macie",
     "macie2",
+    "mailmanager",
     "managedblockchain",
     "marketplacecatalog",
     "marketplacecommerceanalytics",
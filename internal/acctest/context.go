@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	helperlogging "github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func Context(t *testing.T) context.Context {
@@ -26,6 +27,22 @@ func Context(t *testing.T) context.Context {
 	return ctx
 }
 
+// RegionalContext returns a Context in which AWS API clients are created for the specified Region,
+// as they are for resources that override the provider's Region.
+// An empty Region leaves the provider's Region in effect.
+func RegionalContext(ctx context.Context, region string) context.Context {
+	if region == "" {
+		return ctx
+	}
+
+	ctx = conns.NewResourceContext(ctx, "", "", "")
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.OverrideRegion = region
+	}
+
+	return ctx
+}
+
 func logger(ctx context.Context, t *testing.T, name string) context.Context {
 	t.Helper()
 
@@ -44,3 +44,33 @@ func GlobalARN(arnService, arnResource string) globalARNCheck {
 		arnResource: arnResource,
 	}
 }
+
+var _ knownvalue.Check = accountIDCheck{}
+
+type accountIDCheck struct{}
+
+func (v accountIDCheck) CheckValue(other any) error {
+	otherVal, ok := other.(string)
+
+	if !ok {
+		return fmt.Errorf("expected string value for AccountID check, got: %T", other)
+	}
+
+	accountID := AccountID(context.Background())
+
+	if otherVal != accountID {
+		return fmt.Errorf("expected value %s for AccountID check, got: %s", accountID, otherVal)
+	}
+
+	return nil
+}
+
+// String returns the string representation of the value.
+func (v accountIDCheck) String() string {
+	return AccountID(context.Background())
+}
+
+// KnownAccountID returns a check that the value is the account ID of the default provider.
+func KnownAccountID() accountIDCheck {
+	return accountIDCheck{}
+}
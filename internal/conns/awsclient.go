@@ -42,9 +42,10 @@ type AWSClient struct {
 	region                    string
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3UsePathStyle            bool                          // From provider configuration.
+	s3USEast1RegionalEndpoint string                        // From provider configuration.
+	serviceRetryConfigs       map[string]ServiceRetryConfig // From provider configuration.
+	stsRegion                 string                        // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -55,7 +56,12 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the provider's default tags configuration.
+// For resources, any per-resource type exclusion or override is applied.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok && inContext.TypeName != "" {
+		return c.defaultTagsConfig.ForResourceType(inContext.TypeName)
+	}
 	return c.defaultTagsConfig
 }
 
@@ -63,8 +69,12 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	awsConfig := c.awsConfig.Copy()
+	if region := c.Region(ctx); region != c.region {
+		awsConfig.Region = region
+	}
+	return awsConfig
 }
 
 // AwsSession and Endpoints can be removed once the simpledb service is removed.
@@ -87,10 +97,31 @@ func (c *AWSClient) Partition(context.Context) string {
 }
 
 // Region returns the ID of the configured AWS Region.
-func (c *AWSClient) Region(context.Context) string {
+// If a per-resource Region override is present in Context, it takes precedence.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.OverrideRegion != "" {
+		return inContext.OverrideRegion
+	}
 	return c.region
 }
 
+// ValidateInContextRegionInPartition returns an error if any per-resource Region override
+// present in Context is not in the configured AWS partition.
+func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) error {
+	region := c.Region(ctx)
+	if region == c.region {
+		return nil
+	}
+
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		if partition.ID() != c.Partition(ctx) {
+			return fmt.Errorf("region (%s) is in partition (%s), expected partition (%s)", region, partition.ID(), c.Partition(ctx))
+		}
+	}
+
+	return nil
+}
+
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
 // e.g. PREFIX.amazonaws.com
 // The prefix should not contain a trailing period.
@@ -142,6 +173,17 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	s3Client := c.S3Client(ctx)
 
+	// Only the client for the provider's Region is cached.
+	if c.Region(ctx) != c.region {
+		if s3Client.Options().Region == endpoints.AwsGlobalRegionID {
+			return errs.Must(client[*s3.Client](ctx, c, names.S3, map[string]any{
+				"s3_us_east_1_regional_endpoint": "regional",
+			}))
+		}
+
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
@@ -301,8 +343,21 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.awsConfig
+	if region := c.Region(ctx); region != c.region {
+		// Per-resource Region override.
+		v := c.awsConfig.Copy()
+		v.Region = region
+		awsConfig = &v
+	}
+	if v, ok := c.serviceRetryConfigs[servicePackageName]; ok {
+		// Per-service retry configuration.
+		cfg := awsConfig.Copy()
+		v.apply(&cfg, maxBackoff)
+		awsConfig = &cfg
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -323,18 +378,22 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached per Region. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := servicePackageName
+	if region := c.Region(ctx); region != c.region {
+		key = servicePackageName + "@" + region
+	}
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -369,7 +428,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -150,6 +150,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go-v2/service/m2"
 	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
 	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
 	"github.com/aws/aws-sdk-go-v2/service/mediaconvert"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
@@ -847,6 +848,10 @@ func (c *AWSClient) Macie2Client(ctx context.Context) *macie2.Client {
 	return errs.Must(client[*macie2.Client](ctx, c, names.Macie2, make(map[string]any)))
 }
 
+func (c *AWSClient) MailManagerClient(ctx context.Context) *mailmanager.Client {
+	return errs.Must(client[*mailmanager.Client](ctx, c, names.MailManager, make(map[string]any)))
+}
+
 func (c *AWSClient) MediaConnectClient(ctx context.Context) *mediaconnect.Client {
 	return errs.Must(client[*mediaconnect.Client](ctx, c, names.MediaConnect, make(map[string]any)))
 }
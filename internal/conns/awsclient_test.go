@@ -7,6 +7,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 )
 
@@ -99,6 +100,146 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestAWSClientRegionOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		AWSClient      *AWSClient
+		OverrideRegion string
+		Expected       string
+		ExpectError    bool
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override in partition",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "eu-west-1", //lintignore:AWSAT003
+			Expected:       "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			Name: "override not in partition",
+			AWSClient: &AWSClient{
+				partition: standardPartition,
+				region:    "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "cn-northwest-1", //lintignore:AWSAT003
+			Expected:       "cn-northwest-1", //lintignore:AWSAT003
+			ExpectError:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.TODO(), "test", "Test", "aws_test")
+			if inContext, ok := FromContext(ctx); ok {
+				inContext.OverrideRegion = testCase.OverrideRegion
+			}
+
+			got := testCase.AWSClient.Region(ctx)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			err := testCase.AWSClient.ValidateInContextRegionInPartition(ctx)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Errorf("got error %v, expected error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientServiceRetryConfig(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	awsConfig := aws.Config{
+		Region:           "us-west-2", //lintignore:AWSAT003
+		RetryMaxAttempts: 25,
+		RetryMode:        aws.RetryModeStandard,
+	}
+	awsClient := &AWSClient{
+		awsConfig: &awsConfig,
+		partition: standardPartition,
+		region:    "us-west-2", //lintignore:AWSAT003
+		serviceRetryConfigs: map[string]ServiceRetryConfig{
+			"ec2": ServiceRetryConfig{MaxAttempts: 10}.resolve(&awsConfig, 0),
+			"s3":  ServiceRetryConfig{RetryMode: aws.RetryModeAdaptive}.resolve(&awsConfig, 0),
+		},
+	}
+
+	testCases := []struct {
+		Name                string
+		ServicePackageName  string
+		ExpectedMaxAttempts int
+		ExpectedRetryMode   aws.RetryMode
+		ExpectOverride      bool
+	}{
+		{
+			Name:                "no override",
+			ServicePackageName:  "sqs",
+			ExpectedMaxAttempts: 25,
+			ExpectedRetryMode:   aws.RetryModeStandard,
+		},
+		{
+			Name:                "max attempts override",
+			ServicePackageName:  "ec2",
+			ExpectedMaxAttempts: 10,
+			ExpectedRetryMode:   aws.RetryModeStandard,
+			ExpectOverride:      true,
+		},
+		{
+			Name:                "retry mode override",
+			ServicePackageName:  "s3",
+			ExpectedMaxAttempts: 25,
+			ExpectedRetryMode:   aws.RetryModeAdaptive,
+			ExpectOverride:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.TODO(), testCase.ServicePackageName, "Test", "aws_test")
+			got := awsClient.apiClientConfig(ctx, testCase.ServicePackageName)["aws_sdkv2_config"].(*aws.Config)
+
+			if got, want := got != &awsConfig, testCase.ExpectOverride; got != want {
+				t.Errorf("got copied configuration %t, expected %t", got, want)
+			}
+
+			if got, want := got.RetryMaxAttempts, testCase.ExpectedMaxAttempts; got != want {
+				t.Errorf("got RetryMaxAttempts %d, expected %d", got, want)
+			}
+
+			if got, want := got.RetryMode, testCase.ExpectedRetryMode; got != want {
+				t.Errorf("got RetryMode %s, expected %s", got, want)
+			}
+
+			if !testCase.ExpectOverride {
+				return
+			}
+
+			if got, want := got.Retryer().MaxAttempts(), testCase.ExpectedMaxAttempts; got != want {
+				t.Errorf("got Retryer MaxAttempts %d, expected %d", got, want)
+			}
+		})
+	}
+}
+
 func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
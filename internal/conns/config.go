@@ -26,6 +26,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+const (
+	maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+)
+
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
@@ -50,6 +54,7 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRetries                 map[string]ServiceRetryConfig
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -70,9 +75,6 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
-	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
-	)
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -224,6 +226,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
 
+	if len(c.ServiceRetries) > 0 {
+		client.serviceRetryConfigs = make(map[string]ServiceRetryConfig, len(c.ServiceRetries))
+		for k, v := range c.ServiceRetries {
+			client.serviceRetryConfigs[k] = v.resolve(&cfg, c.TokenBucketRateLimiterCapacity)
+		}
+	}
+
 	return client, diags
 }
 
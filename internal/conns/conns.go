@@ -26,6 +26,20 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithActions is an interface that extends ServicePackage with actions.
+// Actions are operations that are invoked during the Terraform lifecycle but which do not manage state.
+type ServicePackageWithActions interface {
+	ServicePackage
+	Actions(context.Context) []*types.ServicePackageAction
+}
+
+// ServicePackageWithSDKListResources is an interface that extends ServicePackage with list resources for Plugin SDK resources.
+// List resources enumerate existing infrastructure for `terraform query`.
+type ServicePackageWithSDKListResources interface {
+	ServicePackage
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -36,10 +50,24 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
+	IsAction            bool   // Action?
 	IsDataSource        bool   // Data source?
 	IsEphemeralResource bool   // Ephemeral resource?
+	IsListResource      bool   // List resource?
+	OverrideRegion      string // Per-resource Region override, empty if the provider's Region is used
 	ResourceName        string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName  string // Canonical name defined as a constant in names package
+	TypeName            string // Resource type name, e.g. "aws_subnet"
+}
+
+func NewActionContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsAction:           true,
+		ResourceName:       resourceName,
+		ServicePackageName: servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
 }
 
 func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
@@ -62,10 +90,21 @@ func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resour
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewListResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsListResource:     true,
+		ResourceName:       resourceName,
+		ServicePackageName: servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// ServiceRetryConfig overrides the provider's retry configuration for a single service's API clients.
+// Zero values inherit the provider-level setting.
+type ServiceRetryConfig struct {
+	MaxAttempts                    int
+	RetryMode                      aws.RetryMode
+	TokenBucketRateLimiterCapacity int
+}
+
+// resolve returns a copy of the configuration with any unset values taken from the provider-level AWS configuration.
+func (c ServiceRetryConfig) resolve(awsConfig *aws.Config, tokenBucketRateLimiterCapacity int) ServiceRetryConfig {
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = awsConfig.RetryMaxAttempts
+	}
+	if c.RetryMode == "" {
+		c.RetryMode = awsConfig.RetryMode
+	}
+	if c.RetryMode == "" {
+		c.RetryMode = aws.RetryModeStandard
+	}
+	if c.TokenBucketRateLimiterCapacity == 0 {
+		c.TokenBucketRateLimiterCapacity = tokenBucketRateLimiterCapacity
+	}
+
+	return c
+}
+
+// apply sets the retry configuration on the specified AWS configuration.
+// The Retryer is constructed in the same way as the provider-level Retryer.
+func (c ServiceRetryConfig) apply(awsConfig *aws.Config, maxBackoff time.Duration) {
+	standardOptions := []func(*retry.StandardOptions){
+		func(so *retry.StandardOptions) {
+			so.Backoff = &v1CompatibleBackoff{maxRetryDelay: maxBackoff}
+			so.MaxBackoff = maxBackoff
+		},
+	}
+
+	if v := c.MaxAttempts; v > 0 {
+		standardOptions = append(standardOptions, func(so *retry.StandardOptions) {
+			so.MaxAttempts = v
+		})
+	}
+
+	if v := c.TokenBucketRateLimiterCapacity; v > 0 {
+		standardOptions = append(standardOptions, func(so *retry.StandardOptions) {
+			so.RateLimiter = ratelimit.NewTokenRateLimit(uint(v))
+		})
+	} else {
+		standardOptions = append(standardOptions, func(so *retry.StandardOptions) {
+			so.RateLimiter = ratelimit.None
+		})
+	}
+
+	retryMode := c.RetryMode
+	awsConfig.RetryMaxAttempts = c.MaxAttempts // Service clients wrap the Retryer with this value.
+	awsConfig.RetryMode = retryMode
+	awsConfig.Retryer = func() aws.Retryer {
+		// Each invocation returns an independent Retryer.
+		switch retryMode {
+		case aws.RetryModeAdaptive:
+			return retry.NewAdaptiveMode(func(ao *retry.AdaptiveModeOptions) {
+				ao.StandardOptions = append(ao.StandardOptions, standardOptions...)
+			})
+		default:
+			return retry.NewStandard(standardOptions...)
+		}
+	}
+}
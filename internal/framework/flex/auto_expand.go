@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -189,7 +191,7 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 
 	case basetypes.MapValuable:
-		diags.Append(expander.map_(ctx, vFrom, vTo)...)
+		diags.Append(expander.map_(ctx, sourcePath, vFrom, targetPath, vTo)...)
 		return diags
 
 	case basetypes.SetValuable:
@@ -751,7 +753,7 @@ func (expander autoExpander) listOrSetOfString(ctx context.Context, vFrom valueW
 }
 
 // map_ copies a Plugin Framework Map(ish) value to a compatible AWS API value.
-func (expander autoExpander) map_(ctx context.Context, vFrom basetypes.MapValuable, vTo reflect.Value) diag.Diagnostics {
+func (expander autoExpander) map_(ctx context.Context, sourcePath path.Path, vFrom basetypes.MapValuable, targetPath path.Path, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	v, d := vFrom.ToMapValue(ctx)
@@ -765,6 +767,12 @@ func (expander autoExpander) map_(ctx context.Context, vFrom basetypes.MapValuab
 		diags.Append(expander.mapOfString(ctx, v, vTo)...)
 		return diags
 
+	case basetypes.ObjectTypable:
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectMapValue); ok {
+			diags.Append(expander.nestedObjectMap(ctx, sourcePath, vFrom, targetPath, vTo)...)
+			return diags
+		}
+
 	case basetypes.MapTypable:
 		data, d := v.ToMapValue(ctx)
 		diags.Append(d...)
@@ -1124,6 +1132,73 @@ func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, sourcePat
 	return diags
 }
 
+// nestedObjectMap copies a Plugin Framework NestedObjectMapValue to a compatible AWS API map[string](*)struct value.
+func (expander autoExpander) nestedObjectMap(ctx context.Context, sourcePath path.Path, vFrom fwtypes.NestedObjectMapValue, targetPath path.Path, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tTo := vTo.Type()
+	if vTo.Kind() != reflect.Map || tTo.Key().Kind() != reflect.String {
+		diags.AddError("Incompatible types", fmt.Sprintf("nestedObjectMap[%s] cannot be expanded to %s", vFrom.Type(ctx).(attr.TypeWithElementType).ElementType(), vTo.Kind()))
+		return diags
+	}
+
+	tElem := tTo.Elem()
+	if tElem.Kind() == reflect.Pointer {
+		tElem = tElem.Elem()
+	}
+	if tElem.Kind() != reflect.Struct {
+		diags.AddError("Incompatible types", fmt.Sprintf("nestedObjectMap[%s] cannot be expanded to map[string]%s", vFrom.Type(ctx).(attr.TypeWithElementType).ElementType(), tTo.Elem()))
+		return diags
+	}
+
+	// Get the nested Objects as a map.
+	from, d := vFrom.ToObjectMap(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	f := reflect.ValueOf(from)
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Expanding nested object map", map[string]any{
+		logAttrKeySourceSize: f.Len(),
+	})
+
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetType, fullTypeName(tElem))
+
+	// Create a new target map and expand each element.
+	// Keys are sorted so that conversions are deterministic.
+	keys := f.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	m := reflect.MakeMapWithSize(tTo, len(keys))
+	for _, key := range keys {
+		sourcePath := sourcePath.AtMapKey(key.String())
+		targetPath := targetPath.AtMapKey(key.String())
+		ctx := tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
+		ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetPath, targetPath.String())
+
+		// Create a new target structure and walk its fields.
+		target := reflect.New(tElem)
+		diags.Append(autoFlexConvertStruct(ctx, sourcePath, f.MapIndex(key).Interface(), targetPath, target.Interface(), expander)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Set value (or pointer) in the target map.
+		if tTo.Elem().Kind() == reflect.Struct {
+			m.SetMapIndex(key.Convert(tTo.Key()), target.Elem())
+		} else {
+			m.SetMapIndex(key.Convert(tTo.Key()), target)
+		}
+	}
+
+	vTo.Set(m)
+
+	return diags
+}
+
 // mapBlockKey takes a struct and extracts the value of the `key`
 func mapBlockKey(ctx context.Context, from any) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -4479,6 +4479,109 @@ func TestExpandMapBlock(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandMapNestedObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"null map": {
+			Source: &tfMapNestedObject{
+				MapBlock: fwtypes.NewMapNestedObjectValueOfNull[tfMapBlockElementNoKey](ctx),
+			},
+			Target: &awsMapBlockValues{},
+			WantTarget: &awsMapBlockValues{
+				MapBlock: nil,
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfMapNestedObject](), reflect.TypeFor[*awsMapBlockValues]()),
+				infoConverting(reflect.TypeFor[tfMapNestedObject](), reflect.TypeFor[*awsMapBlockValues]()),
+				traceMatchedFields("MapBlock", reflect.TypeFor[tfMapNestedObject](), "MapBlock", reflect.TypeFor[*awsMapBlockValues]()),
+				infoConvertingWithPath("MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]](), "MapBlock", reflect.TypeFor[map[string]awsMapBlockElement]()),
+				traceExpandingNullValue("MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]](), "MapBlock", reflect.TypeFor[map[string]awsMapBlockElement]()),
+			},
+		},
+		"map of values": {
+			Source: &tfMapNestedObject{
+				MapBlock: fwtypes.NewMapNestedObjectValueOfValueMapMust(ctx, map[string]tfMapBlockElementNoKey{
+					"x": {
+						Attr1: types.StringValue("a"),
+						Attr2: types.StringValue("b"),
+					},
+					"y": {
+						Attr1: types.StringValue("c"),
+						Attr2: types.StringValue("d"),
+					},
+				}),
+			},
+			Target: &awsMapBlockValues{},
+			WantTarget: &awsMapBlockValues{
+				MapBlock: map[string]awsMapBlockElement{
+					"x": {
+						Attr1: "a",
+						Attr2: "b",
+					},
+					"y": {
+						Attr1: "c",
+						Attr2: "d",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfMapNestedObject](), reflect.TypeFor[*awsMapBlockValues]()),
+				infoConverting(reflect.TypeFor[tfMapNestedObject](), reflect.TypeFor[*awsMapBlockValues]()),
+
+				traceMatchedFields("MapBlock", reflect.TypeFor[tfMapNestedObject](), "MapBlock", reflect.TypeFor[*awsMapBlockValues]()),
+				infoConvertingWithPath("MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]](), "MapBlock", reflect.TypeFor[map[string]awsMapBlockElement]()),
+				traceExpandingNestedObjectMap("MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]](), 2, "MapBlock", reflect.TypeFor[map[string]awsMapBlockElement]()),
+
+				traceMatchedFieldsWithPath("MapBlock[\"x\"]", "Attr1", reflect.TypeFor[tfMapBlockElementNoKey](), "MapBlock[\"x\"]", "Attr1", reflect.TypeFor[*awsMapBlockElement]()),
+				infoConvertingWithPath("MapBlock[\"x\"].Attr1", reflect.TypeFor[types.String](), "MapBlock[\"x\"].Attr1", reflect.TypeFor[string]()),
+				traceMatchedFieldsWithPath("MapBlock[\"x\"]", "Attr2", reflect.TypeFor[tfMapBlockElementNoKey](), "MapBlock[\"x\"]", "Attr2", reflect.TypeFor[*awsMapBlockElement]()),
+				infoConvertingWithPath("MapBlock[\"x\"].Attr2", reflect.TypeFor[types.String](), "MapBlock[\"x\"].Attr2", reflect.TypeFor[string]()),
+
+				traceMatchedFieldsWithPath("MapBlock[\"y\"]", "Attr1", reflect.TypeFor[tfMapBlockElementNoKey](), "MapBlock[\"y\"]", "Attr1", reflect.TypeFor[*awsMapBlockElement]()),
+				infoConvertingWithPath("MapBlock[\"y\"].Attr1", reflect.TypeFor[types.String](), "MapBlock[\"y\"].Attr1", reflect.TypeFor[string]()),
+				traceMatchedFieldsWithPath("MapBlock[\"y\"]", "Attr2", reflect.TypeFor[tfMapBlockElementNoKey](), "MapBlock[\"y\"]", "Attr2", reflect.TypeFor[*awsMapBlockElement]()),
+				infoConvertingWithPath("MapBlock[\"y\"].Attr2", reflect.TypeFor[types.String](), "MapBlock[\"y\"].Attr2", reflect.TypeFor[string]()),
+			},
+		},
+		"map of pointers": {
+			Source: &tfMapNestedObject{
+				MapBlock: fwtypes.NewMapNestedObjectValueOfValueMapMust(ctx, map[string]tfMapBlockElementNoKey{
+					"x": {
+						Attr1: types.StringValue("a"),
+						Attr2: types.StringValue("b"),
+					},
+				}),
+			},
+			Target: &awsMapBlockPointers{},
+			WantTarget: &awsMapBlockPointers{
+				MapBlock: map[string]*awsMapBlockElement{
+					"x": {
+						Attr1: "a",
+						Attr2: "b",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfMapNestedObject](), reflect.TypeFor[*awsMapBlockPointers]()),
+				infoConverting(reflect.TypeFor[tfMapNestedObject](), reflect.TypeFor[*awsMapBlockPointers]()),
+
+				traceMatchedFields("MapBlock", reflect.TypeFor[tfMapNestedObject](), "MapBlock", reflect.TypeFor[*awsMapBlockPointers]()),
+				infoConvertingWithPath("MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]](), "MapBlock", reflect.TypeFor[map[string]*awsMapBlockElement]()),
+				traceExpandingNestedObjectMap("MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]](), 1, "MapBlock", reflect.TypeFor[map[string]*awsMapBlockElement]()),
+
+				traceMatchedFieldsWithPath("MapBlock[\"x\"]", "Attr1", reflect.TypeFor[tfMapBlockElementNoKey](), "MapBlock[\"x\"]", "Attr1", reflect.TypeFor[*awsMapBlockElement]()),
+				infoConvertingWithPath("MapBlock[\"x\"].Attr1", reflect.TypeFor[types.String](), "MapBlock[\"x\"].Attr1", reflect.TypeFor[string]()),
+				traceMatchedFieldsWithPath("MapBlock[\"x\"]", "Attr2", reflect.TypeFor[tfMapBlockElementNoKey](), "MapBlock[\"x\"]", "Attr2", reflect.TypeFor[*awsMapBlockElement]()),
+				infoConvertingWithPath("MapBlock[\"x\"].Attr2", reflect.TypeFor[types.String](), "MapBlock[\"x\"].Attr2", reflect.TypeFor[string]()),
+			},
+		},
+	}
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandOptions(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -871,6 +872,15 @@ func (flattener autoFlattener) map_(ctx context.Context, sourcePath path.Path, v
 					diags.Append(flattener.structMapToObjectList(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 					return diags
 				}
+
+			case basetypes.MapTypable:
+				//
+				// map[string]struct -> fwtypes.MapNestedObjectOf[Object]
+				//
+				if tTo, ok := tTo.(fwtypes.NestedObjectMapType); ok {
+					diags.Append(flattener.structMapToObjectMap(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
+					return diags
+				}
 			}
 
 		case reflect.String:
@@ -1026,6 +1036,14 @@ func (flattener autoFlattener) map_(ctx context.Context, sourcePath path.Path, v
 					return diags
 				}
 
+				//
+				// map[string]*struct -> fwtypes.MapNestedObjectOf[Object]
+				//
+				if tTo, ok := tTo.(fwtypes.NestedObjectMapType); ok {
+					diags.Append(flattener.structMapToObjectMap(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
+					return diags
+				}
+
 			case reflect.String:
 				switch tTo := tTo.(type) {
 				case basetypes.ListTypable:
@@ -1156,6 +1174,74 @@ func (flattener autoFlattener) structMapToObjectList(ctx context.Context, source
 	return diags
 }
 
+// structMapToObjectMap copies an AWS API map[string](*)struct value to a compatible Plugin Framework NestedObjectMapValue value.
+func (flattener autoFlattener) structMapToObjectMap(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo fwtypes.NestedObjectMapType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		tflog.SubsystemTrace(ctx, subsystemName, "Flattening null value")
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	to, d := tTo.NewObjectMap(ctx, vFrom.Len())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+
+	// Keys are sorted so that conversions are deterministic.
+	keys := vFrom.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	for _, key := range keys {
+		sourcePath := sourcePath.AtMapKey(key.String())
+		targetPath := targetPath.AtMapKey(key.String())
+		ctx := tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourcePath, sourcePath.String())
+		ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetPath, targetPath.String())
+
+		fromVal := vFrom.MapIndex(key)
+		if fromVal.Kind() == reflect.Pointer {
+			if fromVal.IsNil() {
+				continue
+			}
+			fromVal = fromVal.Elem()
+		}
+
+		target, d := tTo.NewObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(autoFlexConvertStruct(ctx, sourcePath, fromVal.Interface(), targetPath, target, flattener)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		t.SetMapIndex(reflect.ValueOf(key.String()), reflect.ValueOf(target))
+	}
+
+	val, d := tTo.ValueFromObjectMap(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+
+	return diags
+}
+
 // structToNestedObject copies an AWS API struct value to a compatible Plugin Framework NestedObjectValue value.
 func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value, fieldOpts fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -1625,6 +1711,14 @@ func flattenPrePopulate(ctx context.Context, toVal reflect.Value) diag.Diagnosti
 				return diags
 			}
 			fieldVal.Set(reflect.ValueOf(v))
+
+		case fwtypes.NestedObjectMapType:
+			v, d := tTo.NullValue(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+			fieldVal.Set(reflect.ValueOf(v))
 		}
 	}
 
@@ -3929,6 +3929,102 @@ func TestFlattenMapBlock(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenMapNestedObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nil map": {
+			Source: &awsMapBlockValues{
+				MapBlock: nil,
+			},
+			Target: &tfMapNestedObject{},
+			WantTarget: &tfMapNestedObject{
+				MapBlock: fwtypes.NewMapNestedObjectValueOfNull[tfMapBlockElementNoKey](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsMapBlockValues](), reflect.TypeFor[*tfMapNestedObject]()),
+				infoConverting(reflect.TypeFor[awsMapBlockValues](), reflect.TypeFor[*tfMapNestedObject]()),
+				traceMatchedFields("MapBlock", reflect.TypeFor[awsMapBlockValues](), "MapBlock", reflect.TypeFor[*tfMapNestedObject]()),
+				infoConvertingWithPath("MapBlock", reflect.TypeFor[map[string]awsMapBlockElement](), "MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]]()),
+				traceFlatteningNullValue("MapBlock", reflect.TypeFor[map[string]awsMapBlockElement](), "MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]]()),
+			},
+		},
+		"map of values": {
+			Source: &awsMapBlockValues{
+				MapBlock: map[string]awsMapBlockElement{
+					"x": {
+						Attr1: "a",
+						Attr2: "b",
+					},
+					"y": {
+						Attr1: "c",
+						Attr2: "d",
+					},
+				},
+			},
+			Target: &tfMapNestedObject{},
+			WantTarget: &tfMapNestedObject{
+				MapBlock: fwtypes.NewMapNestedObjectValueOfValueMapMust(ctx, map[string]tfMapBlockElementNoKey{
+					"x": {
+						Attr1: types.StringValue("a"),
+						Attr2: types.StringValue("b"),
+					},
+					"y": {
+						Attr1: types.StringValue("c"),
+						Attr2: types.StringValue("d"),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsMapBlockValues](), reflect.TypeFor[*tfMapNestedObject]()),
+				infoConverting(reflect.TypeFor[awsMapBlockValues](), reflect.TypeFor[*tfMapNestedObject]()),
+				traceMatchedFields("MapBlock", reflect.TypeFor[awsMapBlockValues](), "MapBlock", reflect.TypeFor[*tfMapNestedObject]()),
+				infoConvertingWithPath("MapBlock", reflect.TypeFor[map[string]awsMapBlockElement](), "MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]]()),
+				traceMatchedFieldsWithPath("MapBlock[\"x\"]", "Attr1", reflect.TypeFor[awsMapBlockElement](), "MapBlock[\"x\"]", "Attr1", reflect.TypeFor[*tfMapBlockElementNoKey]()),
+				infoConvertingWithPath("MapBlock[\"x\"].Attr1", reflect.TypeFor[string](), "MapBlock[\"x\"].Attr1", reflect.TypeFor[types.String]()),
+				traceMatchedFieldsWithPath("MapBlock[\"x\"]", "Attr2", reflect.TypeFor[awsMapBlockElement](), "MapBlock[\"x\"]", "Attr2", reflect.TypeFor[*tfMapBlockElementNoKey]()),
+				infoConvertingWithPath("MapBlock[\"x\"].Attr2", reflect.TypeFor[string](), "MapBlock[\"x\"].Attr2", reflect.TypeFor[types.String]()),
+				traceMatchedFieldsWithPath("MapBlock[\"y\"]", "Attr1", reflect.TypeFor[awsMapBlockElement](), "MapBlock[\"y\"]", "Attr1", reflect.TypeFor[*tfMapBlockElementNoKey]()),
+				infoConvertingWithPath("MapBlock[\"y\"].Attr1", reflect.TypeFor[string](), "MapBlock[\"y\"].Attr1", reflect.TypeFor[types.String]()),
+				traceMatchedFieldsWithPath("MapBlock[\"y\"]", "Attr2", reflect.TypeFor[awsMapBlockElement](), "MapBlock[\"y\"]", "Attr2", reflect.TypeFor[*tfMapBlockElementNoKey]()),
+				infoConvertingWithPath("MapBlock[\"y\"].Attr2", reflect.TypeFor[string](), "MapBlock[\"y\"].Attr2", reflect.TypeFor[types.String]()),
+			},
+		},
+		"map of pointers": {
+			Source: &awsMapBlockPointers{
+				MapBlock: map[string]*awsMapBlockElement{
+					"x": {
+						Attr1: "a",
+						Attr2: "b",
+					},
+				},
+			},
+			Target: &tfMapNestedObject{},
+			WantTarget: &tfMapNestedObject{
+				MapBlock: fwtypes.NewMapNestedObjectValueOfValueMapMust(ctx, map[string]tfMapBlockElementNoKey{
+					"x": {
+						Attr1: types.StringValue("a"),
+						Attr2: types.StringValue("b"),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsMapBlockPointers](), reflect.TypeFor[*tfMapNestedObject]()),
+				infoConverting(reflect.TypeFor[awsMapBlockPointers](), reflect.TypeFor[*tfMapNestedObject]()),
+				traceMatchedFields("MapBlock", reflect.TypeFor[awsMapBlockPointers](), "MapBlock", reflect.TypeFor[*tfMapNestedObject]()),
+				infoConvertingWithPath("MapBlock", reflect.TypeFor[map[string]*awsMapBlockElement](), "MapBlock", reflect.TypeFor[fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey]]()),
+				traceMatchedFieldsWithPath("MapBlock[\"x\"]", "Attr1", reflect.TypeFor[awsMapBlockElement](), "MapBlock[\"x\"]", "Attr1", reflect.TypeFor[*tfMapBlockElementNoKey]()),
+				infoConvertingWithPath("MapBlock[\"x\"].Attr1", reflect.TypeFor[string](), "MapBlock[\"x\"].Attr1", reflect.TypeFor[types.String]()),
+				traceMatchedFieldsWithPath("MapBlock[\"x\"]", "Attr2", reflect.TypeFor[awsMapBlockElement](), "MapBlock[\"x\"]", "Attr2", reflect.TypeFor[*tfMapBlockElementNoKey]()),
+				infoConvertingWithPath("MapBlock[\"x\"].Attr2", reflect.TypeFor[string](), "MapBlock[\"x\"].Attr2", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenSimpleListOfPrimitiveValues(t *testing.T) {
 	t.Parallel()
 
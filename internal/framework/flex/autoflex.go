@@ -119,60 +119,44 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
-	opts := flexer.getOptions()
-	for i := 0; i < typeFrom.NumField(); i++ {
-		fromField := typeFrom.Field(i)
-		if fromField.PkgPath != "" {
-			continue // Skip unexported fields.
-		}
-		fromNameOverride, fromOpts := autoflexTags(fromField)
-		fieldName := fromField.Name
-		if opts.isIgnoredField(fieldName) {
-			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-				logAttrKeySourceFieldname: fieldName,
-			})
-			continue
-		}
-		// TODO: this only applies when Expanding
-		if fromNameOverride == "-" {
+	for _, mapping := range fieldMappingsFor(ctx, typeFrom, typeTo, flexer) {
+		fieldName := mapping.fromName
+		switch mapping.skipReason {
+		case fieldSkipReasonIgnoredSource:
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
 			continue
-		}
-		if fieldName == mapBlockKeyFieldName {
+		case fieldSkipReasonMapBlockKey:
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
 				logAttrKeySourceFieldname: mapBlockKeyFieldName,
 			})
 			continue
-		}
-
-		toField, ok := findFieldFuzzy(ctx, fieldName, typeFrom, typeTo, flexer)
-		if !ok {
+		case fieldSkipReasonNoCorrespondingField:
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
 			continue
 		}
-		toFieldName := toField.Name
-		// TODO: this only applies when Flattening
-		toNameOverride, toOpts := autoflexTags(toField)
-		toFieldVal := valTo.FieldByIndex(toField.Index)
-		if toNameOverride == "-" {
+
+		toFieldName := mapping.toName
+		switch mapping.skipReason {
+		case fieldSkipReasonIgnoredTarget:
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored target field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
 			continue
-		}
-		if toOpts.NoFlatten() {
+		case fieldSkipReasonNoFlatten:
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping noflatten target field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
 			continue
 		}
+
+		toFieldVal := valTo.FieldByIndex(mapping.toIndex)
 		if !toFieldVal.CanSet() {
 			// Corresponding field value can't be changed.
 			tflog.SubsystemDebug(ctx, subsystemName, "Field cannot be set", map[string]any{
@@ -188,11 +172,11 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		})
 
 		opts := fieldOpts{
-			legacy:    fromOpts.Legacy() || toOpts.Legacy(),
-			omitempty: toOpts.OmitEmpty(),
+			legacy:    mapping.fromOpts.Legacy() || mapping.toOpts.Legacy(),
+			omitempty: mapping.toOpts.OmitEmpty(),
 		}
 
-		diags.Append(flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath.AtName(toFieldName), toFieldVal, opts)...)
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(mapping.fromIndex), targetPath.AtName(toFieldName), toFieldVal, opts)...)
 		if diags.HasError() {
 			break
 		}
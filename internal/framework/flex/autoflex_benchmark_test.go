@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// go test -bench=BenchmarkAutoFlex -benchmem -run=Bench ./internal/framework/flex
+
+type tfBenchmarkListOfNestedObjects struct {
+	Field1 types.String                                             `tfsdk:"field1"`
+	Field2 fwtypes.ListNestedObjectValueOf[tfAllThePrimitiveFields] `tfsdk:"field2"`
+	Field3 fwtypes.ListNestedObjectValueOf[tfListOfNestedObject]    `tfsdk:"field3"`
+}
+
+type awsBenchmarkSliceOfNestedObjects struct {
+	Field1 *string
+	Field2 []awsAllThePrimitiveFields
+	Field3 []awsNestedObjectPointer
+}
+
+const benchmarkNestedObjectCount = 100
+
+func benchmarkTFAllThePrimitiveFields() tfAllThePrimitiveFields {
+	return tfAllThePrimitiveFields{
+		Field1:  types.StringValue("field1"),
+		Field2:  types.StringValue("field2"),
+		Field3:  types.Int64Value(3),
+		Field4:  types.Int64Value(-4),
+		Field5:  types.Int64Value(5),
+		Field6:  types.Int64Value(-6),
+		Field7:  types.Float64Value(7.7),
+		Field8:  types.Float64Value(-8.8),
+		Field9:  types.Float64Value(9.99),
+		Field10: types.Float64Value(-10.101),
+		Field11: types.BoolValue(true),
+		Field12: types.BoolValue(false),
+	}
+}
+
+func benchmarkAWSAllThePrimitiveFields() awsAllThePrimitiveFields {
+	return awsAllThePrimitiveFields{
+		Field1:  "field1",
+		Field2:  aws.String("field2"),
+		Field3:  3,
+		Field4:  aws.Int32(-4),
+		Field5:  5,
+		Field6:  aws.Int64(-6),
+		Field7:  7.7,
+		Field8:  aws.Float32(-8.8),
+		Field9:  9.99,
+		Field10: aws.Float64(-10.101),
+		Field11: true,
+		Field12: aws.Bool(false),
+	}
+}
+
+func benchmarkTFComplexValue(ctx context.Context) tfComplexValue {
+	return tfComplexValue{
+		Field1: types.StringValue("m"),
+		Field2: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfListOfNestedObject{
+			Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+				Field1: types.StringValue("n"),
+			}),
+		}),
+		Field3: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"X": types.StringValue("x"),
+			"Y": types.StringValue("y"),
+		}),
+		Field4: fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, []tfSingleInt64Field{
+			{Field1: types.Int64Value(100)},
+			{Field1: types.Int64Value(2000)},
+			{Field1: types.Int64Value(30000)},
+		}),
+	}
+}
+
+func benchmarkAWSComplexValue() awsComplexValue {
+	return awsComplexValue{
+		Field1: "m",
+		Field2: &awsNestedObjectPointer{
+			Field1: &awsSingleStringValue{
+				Field1: "n",
+			},
+		},
+		Field3: aws.StringMap(map[string]string{
+			"X": "x",
+			"Y": "y",
+		}),
+		Field4: []awsSingleInt64Value{
+			{Field1: 100},
+			{Field1: 2000},
+			{Field1: 30000},
+		},
+	}
+}
+
+func benchmarkTFListOfNestedObjects(ctx context.Context) tfBenchmarkListOfNestedObjects {
+	primitives := make([]tfAllThePrimitiveFields, benchmarkNestedObjectCount)
+	nestedObjects := make([]tfListOfNestedObject, benchmarkNestedObjectCount)
+	for i := range benchmarkNestedObjectCount {
+		primitives[i] = benchmarkTFAllThePrimitiveFields()
+		nestedObjects[i] = tfListOfNestedObject{
+			Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+				Field1: types.StringValue("n"),
+			}),
+		}
+	}
+
+	return tfBenchmarkListOfNestedObjects{
+		Field1: types.StringValue("field1"),
+		Field2: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, primitives),
+		Field3: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, nestedObjects),
+	}
+}
+
+func benchmarkAWSSliceOfNestedObjects() awsBenchmarkSliceOfNestedObjects {
+	primitives := make([]awsAllThePrimitiveFields, benchmarkNestedObjectCount)
+	nestedObjects := make([]awsNestedObjectPointer, benchmarkNestedObjectCount)
+	for i := range benchmarkNestedObjectCount {
+		primitives[i] = benchmarkAWSAllThePrimitiveFields()
+		nestedObjects[i] = awsNestedObjectPointer{
+			Field1: &awsSingleStringValue{
+				Field1: "n",
+			},
+		}
+	}
+
+	return awsBenchmarkSliceOfNestedObjects{
+		Field1: aws.String("field1"),
+		Field2: primitives,
+		Field3: nestedObjects,
+	}
+}
+
+func BenchmarkAutoFlexExpandPrimitives(b *testing.B) {
+	ctx := context.Background()
+	from := benchmarkTFAllThePrimitiveFields()
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		var to awsAllThePrimitiveFields
+		if diags := Expand(ctx, from, &to); diags.HasError() {
+			b.Fatal(diags)
+		}
+	}
+}
+
+func BenchmarkAutoFlexFlattenPrimitives(b *testing.B) {
+	ctx := context.Background()
+	from := benchmarkAWSAllThePrimitiveFields()
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		var to tfAllThePrimitiveFields
+		if diags := Flatten(ctx, from, &to); diags.HasError() {
+			b.Fatal(diags)
+		}
+	}
+}
+
+func BenchmarkAutoFlexExpandComplex(b *testing.B) {
+	ctx := context.Background()
+	from := benchmarkTFComplexValue(ctx)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		var to awsComplexValue
+		if diags := Expand(ctx, from, &to); diags.HasError() {
+			b.Fatal(diags)
+		}
+	}
+}
+
+func BenchmarkAutoFlexFlattenComplex(b *testing.B) {
+	ctx := context.Background()
+	from := benchmarkAWSComplexValue()
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		var to tfComplexValue
+		if diags := Flatten(ctx, from, &to); diags.HasError() {
+			b.Fatal(diags)
+		}
+	}
+}
+
+func BenchmarkAutoFlexExpandListOfNestedObjects(b *testing.B) {
+	ctx := context.Background()
+	from := benchmarkTFListOfNestedObjects(ctx)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		var to awsBenchmarkSliceOfNestedObjects
+		if diags := Expand(ctx, from, &to); diags.HasError() {
+			b.Fatal(diags)
+		}
+	}
+}
+
+func BenchmarkAutoFlexFlattenListOfNestedObjects(b *testing.B) {
+	ctx := context.Background()
+	from := benchmarkAWSSliceOfNestedObjects()
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		var to tfBenchmarkListOfNestedObjects
+		if diags := Flatten(ctx, from, &to); diags.HasError() {
+			b.Fatal(diags)
+		}
+	}
+}
+
+func BenchmarkAutoFlexExpandFuzzyFieldNames(b *testing.B) {
+	ctx := context.Background()
+	from := tfSpecialPluralization{
+		City:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("paris")}),
+		Coach:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("guardiola")}),
+		Tomato:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("brandywine")}),
+		Vertex:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ab")}),
+		Criterion: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("votes")}),
+		Datum:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("d1")}),
+		Hive:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Cegieme")}),
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		var to awsSpecialPluralization
+		if diags := Expand(ctx, from, &to); diags.HasError() {
+			b.Fatal(diags)
+		}
+	}
+}
+
+func BenchmarkAutoFlexFlattenFuzzyFieldNames(b *testing.B) {
+	ctx := context.Background()
+	from := awsSpecialPluralization{
+		Cities:   aws.StringSlice([]string{"paris"}),
+		Coaches:  aws.StringSlice([]string{"guardiola"}),
+		Tomatoes: aws.StringSlice([]string{"brandywine"}),
+		Vertices: aws.StringSlice([]string{"ab"}),
+		Criteria: aws.StringSlice([]string{"votes"}),
+		Data:     aws.StringSlice([]string{"d1"}),
+		Hives:    aws.StringSlice([]string{"Cegieme"}),
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		var to tfSpecialPluralization
+		if diags := Flatten(ctx, from, &to); diags.HasError() {
+			b.Fatal(diags)
+		}
+	}
+}
@@ -436,6 +436,10 @@ type tfMapBlockElementNoKey struct {
 	Attr2 types.String `tfsdk:"attr2"`
 }
 
+type tfMapNestedObject struct {
+	MapBlock fwtypes.MapNestedObjectValueOf[tfMapBlockElementNoKey] `tfsdk:"map_block"`
+}
+
 var _ smithyjson.JSONStringer = (*testJSONDocument)(nil)
 var _ smithydocument.Marshaler = (*testJSONDocument)(nil)
 
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fieldSkipReason records why a source field does not take part in a conversion.
+type fieldSkipReason int
+
+const (
+	fieldSkipReasonNone fieldSkipReason = iota
+	fieldSkipReasonIgnoredSource
+	fieldSkipReasonMapBlockKey
+	fieldSkipReasonNoCorrespondingField
+	fieldSkipReasonIgnoredTarget
+	fieldSkipReasonNoFlatten
+)
+
+// fieldMapping is the pre-computed correspondence between a source struct field and a target struct field.
+type fieldMapping struct {
+	fromIndex  int
+	fromName   string
+	fromOpts   tagOptions
+	skipReason fieldSkipReason
+	toIndex    []int
+	toName     string
+	toOpts     tagOptions
+}
+
+// fieldMappingsKey identifies a cached set of field mappings.
+// Field matching depends on the source and target types and on the field name options in effect.
+type fieldMappingsKey struct {
+	typeFrom          reflect.Type
+	typeTo            reflect.Type
+	fieldNamePrefix   string
+	fieldNameSuffix   string
+	ignoredFieldNames string
+}
+
+var (
+	// fieldMappingsCache maps fieldMappingsKey to []fieldMapping.
+	fieldMappingsCache sync.Map
+)
+
+// fieldMappingsFor returns the field mappings, in source field order, for the exported fields of struct type `typeFrom`.
+// Results are cached as they depend only on the struct types and flexer options.
+func fieldMappingsFor(ctx context.Context, typeFrom, typeTo reflect.Type, flexer autoFlexer) []fieldMapping {
+	opts := flexer.getOptions()
+	key := fieldMappingsKey{
+		typeFrom:          typeFrom,
+		typeTo:            typeTo,
+		fieldNamePrefix:   opts.fieldNamePrefix,
+		fieldNameSuffix:   opts.fieldNameSuffix,
+		ignoredFieldNames: strings.Join(opts.ignoredFieldNames, ","),
+	}
+
+	if v, ok := fieldMappingsCache.Load(key); ok {
+		return v.([]fieldMapping)
+	}
+
+	mappings := buildFieldMappings(ctx, typeFrom, typeTo, flexer)
+	v, _ := fieldMappingsCache.LoadOrStore(key, mappings)
+
+	return v.([]fieldMapping)
+}
+
+func buildFieldMappings(ctx context.Context, typeFrom, typeTo reflect.Type, flexer autoFlexer) []fieldMapping {
+	var mappings []fieldMapping
+
+	opts := flexer.getOptions()
+	for i := 0; i < typeFrom.NumField(); i++ {
+		fromField := typeFrom.Field(i)
+		if fromField.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		fromNameOverride, fromOpts := autoflexTags(fromField)
+		mapping := fieldMapping{
+			fromIndex: i,
+			fromName:  fromField.Name,
+			fromOpts:  fromOpts,
+		}
+
+		switch {
+		case opts.isIgnoredField(mapping.fromName):
+			mapping.skipReason = fieldSkipReasonIgnoredSource
+		// TODO: this only applies when Expanding
+		case fromNameOverride == "-":
+			mapping.skipReason = fieldSkipReasonIgnoredSource
+		case mapping.fromName == mapBlockKeyFieldName:
+			mapping.skipReason = fieldSkipReasonMapBlockKey
+		default:
+			toField, ok := findFieldFuzzy(ctx, mapping.fromName, typeFrom, typeTo, flexer)
+			if !ok {
+				mapping.skipReason = fieldSkipReasonNoCorrespondingField
+				break
+			}
+			// TODO: this only applies when Flattening
+			toNameOverride, toOpts := autoflexTags(toField)
+			mapping.toIndex = toField.Index
+			mapping.toName = toField.Name
+			mapping.toOpts = toOpts
+			if toNameOverride == "-" {
+				mapping.skipReason = fieldSkipReasonIgnoredTarget
+			} else if toOpts.NoFlatten() {
+				mapping.skipReason = fieldSkipReasonNoFlatten
+			}
+		}
+
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFieldMappingsFor(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		options  []AutoFlexOptionsFunc
+		typeFrom reflect.Type
+		typeTo   reflect.Type
+		expected []fieldMapping
+	}
+	tests := map[string]testCase{
+		"no prefix": {
+			typeFrom: reflect.TypeFor[tfFieldNamePrefix](),
+			typeTo:   reflect.TypeFor[awsFieldNamePrefix](),
+			expected: []fieldMapping{
+				{fromIndex: 0, fromName: "Name", skipReason: fieldSkipReasonNoCorrespondingField},
+			},
+		},
+		"prefix": {
+			options: []AutoFlexOptionsFunc{
+				WithFieldNamePrefix("Intent"),
+			},
+			typeFrom: reflect.TypeFor[tfFieldNamePrefix](),
+			typeTo:   reflect.TypeFor[awsFieldNamePrefix](),
+			expected: []fieldMapping{
+				{fromIndex: 0, fromName: "Name", toIndex: []int{0}, toName: "IntentName"},
+			},
+		},
+		"ignored field": {
+			options: []AutoFlexOptionsFunc{
+				WithIgnoredFieldNames([]string{"Field1"}),
+			},
+			typeFrom: reflect.TypeFor[tfSingleStringField](),
+			typeTo:   reflect.TypeFor[awsSingleStringValue](),
+			expected: []fieldMapping{
+				{fromIndex: 0, fromName: "Field1", skipReason: fieldSkipReasonIgnoredSource},
+			},
+		},
+		"matched field": {
+			typeFrom: reflect.TypeFor[tfSingleStringField](),
+			typeTo:   reflect.TypeFor[awsSingleStringValue](),
+			expected: []fieldMapping{
+				{fromIndex: 0, fromName: "Field1", toIndex: []int{0}, toName: "Field1"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			flexer := newAutoExpander(test.options)
+
+			// The second call is served from the cache.
+			for range 2 {
+				got := fieldMappingsFor(ctx, test.typeFrom, test.typeTo, flexer)
+
+				if diff := cmp.Diff(got, test.expected, cmp.AllowUnexported(fieldMapping{})); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
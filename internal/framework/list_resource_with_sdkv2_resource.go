@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithSDKv2Resource is a structure to be embedded within a ListResource that lists a Plugin SDK resource.
+// The listed resource's schema and identity schema are served as raw protocol version 5 schemas.
+type ListResourceWithSDKv2Resource struct {
+	withMeta
+	resourceSchema *schema.Resource
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined ListResource type.
+func (l *ListResourceWithSDKv2Resource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
+
+// SetResourceSchema sets the listed Plugin SDK resource.
+// The resource must be the fully initialized resource registered with the provider.
+func (l *ListResourceWithSDKv2Resource) SetResourceSchema(r *schema.Resource) {
+	l.resourceSchema = r
+}
+
+// RawV5Schemas returns the listed resource's schema and identity schema.
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = l.resourceSchema.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = l.resourceSchema.ProtoIdentitySchema(ctx)()
+}
+
+// SetResult sets the identity of the resource with the specified ID in the list result.
+// If the request includes the resource's state, the resource is read and the resulting state is also set.
+// Returns false if the resource no longer exists, in which case the result should not be sent.
+func (l *ListResourceWithSDKv2Resource) SetResult(ctx context.Context, request list.ListRequest, id string, result *list.ListResult) bool {
+	state := &terraform.InstanceState{
+		ID:         id,
+		Attributes: make(map[string]string),
+	}
+
+	// The resource is read in the Region in Context.
+	if _, ok := l.resourceSchema.SchemaMap()[names.AttrRegion]; ok {
+		state.Attributes[names.AttrRegion] = l.Meta().Region(ctx)
+	}
+
+	var rd *schema.ResourceData
+
+	if request.IncludeResource {
+		newState, diags := l.resourceSchema.RefreshWithoutUpgrade(ctx, state, l.Meta())
+		result.Diagnostics.Append(fromSDKDiagnostics(diags)...)
+		if result.Diagnostics.HasError() {
+			return true
+		}
+
+		// Resource not found.
+		if newState == nil {
+			return false
+		}
+
+		rd = l.resourceSchema.Data(newState)
+	} else {
+		rd = l.resourceSchema.Data(state)
+
+		identity, err := rd.Identity()
+		if err != nil {
+			result.Diagnostics.AddError("getting resource identity", err.Error())
+			return true
+		}
+
+		// The identity attribute's value is the resource's ID.
+		for k := range l.resourceSchema.Identity.SchemaMap() {
+			var v string
+
+			switch k {
+			case names.AttrAccountID:
+				v = l.Meta().AccountID(ctx)
+			case names.AttrRegion:
+				v = l.Meta().Region(ctx)
+			default:
+				v = id
+			}
+
+			if err := identity.Set(k, v); err != nil {
+				result.Diagnostics.AddError("setting resource identity", err.Error())
+				return true
+			}
+		}
+	}
+
+	v, err := rd.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("converting resource identity", err.Error())
+		return true
+	}
+	result.Identity.Raw = *v
+
+	if request.IncludeResource {
+		v, err := rd.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("converting resource state", err.Error())
+			return true
+		}
+		result.Resource.Raw = *v
+	}
+
+	return true
+}
+
+// fromSDKDiagnostics converts Plugin SDK diagnostics to Plugin Framework diagnostics.
+func fromSDKDiagnostics(diags sdkdiag.Diagnostics) diag.Diagnostics {
+	var fwdiags diag.Diagnostics
+
+	for _, v := range diags {
+		switch v.Severity {
+		case sdkdiag.Error:
+			fwdiags.AddError(v.Summary, v.Detail)
+		case sdkdiag.Warning:
+			fwdiags.AddWarning(v.Summary, v.Detail)
+		}
+	}
+
+	return fwdiags
+}
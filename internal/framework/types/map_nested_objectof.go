@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+var (
+	_ basetypes.MapTypable  = (*mapNestedObjectTypeOf[struct{}])(nil)
+	_ NestedObjectMapType   = (*mapNestedObjectTypeOf[struct{}])(nil)
+	_ basetypes.MapValuable = (*MapNestedObjectValueOf[struct{}])(nil)
+	_ NestedObjectMapValue  = (*MapNestedObjectValueOf[struct{}])(nil)
+)
+
+// mapNestedObjectTypeOf is the attribute type of a MapNestedObjectValueOf.
+type mapNestedObjectTypeOf[T any] struct {
+	basetypes.MapType
+}
+
+func NewMapNestedObjectTypeOf[T any](ctx context.Context) mapNestedObjectTypeOf[T] {
+	return mapNestedObjectTypeOf[T]{basetypes.MapType{ElemType: NewObjectTypeOf[T](ctx)}}
+}
+
+func (t mapNestedObjectTypeOf[T]) Equal(o attr.Type) bool {
+	other, ok := o.(mapNestedObjectTypeOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.MapType.Equal(other.MapType)
+}
+
+func (t mapNestedObjectTypeOf[T]) String() string {
+	var zero T
+	return fmt.Sprintf("MapNestedObjectTypeOf[%T]", zero)
+}
+
+func (t mapNestedObjectTypeOf[T]) ValueFromMap(ctx context.Context, in basetypes.MapValue) (basetypes.MapValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return NewMapNestedObjectValueOfNull[T](ctx), diags
+	}
+	if in.IsUnknown() {
+		return NewMapNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	typ, d := newObjectTypeOf[T](ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewMapNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	v, d := basetypes.NewMapValue(typ, in.Elements())
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewMapNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	return MapNestedObjectValueOf[T]{MapValue: v}, diags
+}
+
+func (t mapNestedObjectTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.MapType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mapValue, ok := attrValue.(basetypes.MapValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	mapValuable, diags := t.ValueFromMap(ctx, mapValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting MapValue to MapValuable: %v", diags)
+	}
+
+	return mapValuable, nil
+}
+
+func (t mapNestedObjectTypeOf[T]) ValueType(ctx context.Context) attr.Value {
+	return MapNestedObjectValueOf[T]{}
+}
+
+func (t mapNestedObjectTypeOf[T]) NewObjectPtr(ctx context.Context) (any, diag.Diagnostics) {
+	return objectTypeNewObjectPtr[T](ctx)
+}
+
+func (t mapNestedObjectTypeOf[T]) NewObjectMap(ctx context.Context, size int) (any, diag.Diagnostics) {
+	return nestedObjectTypeNewObjectMap[T](ctx, size)
+}
+
+func (t mapNestedObjectTypeOf[T]) NullValue(ctx context.Context) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	return NewMapNestedObjectValueOfNull[T](ctx), diags
+}
+
+func (t mapNestedObjectTypeOf[T]) ValueFromObjectMap(ctx context.Context, m any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v, ok := m.(map[string]*T); ok {
+		v, d := NewMapNestedObjectValueOfMap(ctx, v)
+		diags.Append(d...)
+		return v, d
+	}
+
+	diags.Append(diag.NewErrorDiagnostic("Invalid map value", fmt.Sprintf("incorrect type: want %T, got %T", (map[string]*T)(nil), m)))
+	return nil, diags
+}
+
+func nestedObjectTypeNewObjectMap[T any](_ context.Context, size int) (map[string]*T, diag.Diagnostics) { //nolint:unparam
+	var diags diag.Diagnostics
+
+	return make(map[string]*T, size), diags
+}
+
+// MapNestedObjectValueOf represents a Terraform Plugin Framework Map value whose elements are of type `ObjectTypeOf[T]`.
+type MapNestedObjectValueOf[T any] struct {
+	basetypes.MapValue
+}
+
+func (v MapNestedObjectValueOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(MapNestedObjectValueOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.MapValue.Equal(other.MapValue)
+}
+
+func (v MapNestedObjectValueOf[T]) Type(ctx context.Context) attr.Type {
+	return NewMapNestedObjectTypeOf[T](ctx)
+}
+
+func (v MapNestedObjectValueOf[T]) ToObjectMap(ctx context.Context) (any, diag.Diagnostics) {
+	return v.ToMap(ctx)
+}
+
+// ToMap returns a map of pointers to the elements of a MapNestedObject.
+func (v MapNestedObjectValueOf[T]) ToMap(ctx context.Context) (map[string]*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := v.MapValue.Elements()
+	m := make(map[string]*T, len(elements))
+	for key, element := range elements {
+		ptr, d := objectValueObjectPtr[T](ctx, element)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		m[key] = ptr
+	}
+
+	return m, diags
+}
+
+func NewMapNestedObjectValueOfNull[T any](ctx context.Context) MapNestedObjectValueOf[T] {
+	return MapNestedObjectValueOf[T]{MapValue: basetypes.NewMapNull(NewObjectTypeOf[T](ctx))}
+}
+
+func NewMapNestedObjectValueOfUnknown[T any](ctx context.Context) MapNestedObjectValueOf[T] {
+	return MapNestedObjectValueOf[T]{MapValue: basetypes.NewMapUnknown(NewObjectTypeOf[T](ctx))}
+}
+
+func NewMapNestedObjectValueOfMap[T any](ctx context.Context, m map[string]*T) (MapNestedObjectValueOf[T], diag.Diagnostics) {
+	return newMapNestedObjectValueOf[T](ctx, m)
+}
+
+func NewMapNestedObjectValueOfMapMust[T any](ctx context.Context, m map[string]*T) MapNestedObjectValueOf[T] {
+	return fwdiag.Must(NewMapNestedObjectValueOfMap(ctx, m))
+}
+
+func NewMapNestedObjectValueOfValueMap[T any](ctx context.Context, m map[string]T) (MapNestedObjectValueOf[T], diag.Diagnostics) {
+	return newMapNestedObjectValueOf[T](ctx, m)
+}
+
+func NewMapNestedObjectValueOfValueMapMust[T any](ctx context.Context, m map[string]T) MapNestedObjectValueOf[T] {
+	return fwdiag.Must(NewMapNestedObjectValueOfValueMap(ctx, m))
+}
+
+func newMapNestedObjectValueOf[T any](ctx context.Context, elements any) (MapNestedObjectValueOf[T], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	typ, d := newObjectTypeOf[T](ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewMapNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	v, d := basetypes.NewMapValueFrom(ctx, typ, elements)
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewMapNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	return MapNestedObjectValueOf[T]{MapValue: v}, diags
+}
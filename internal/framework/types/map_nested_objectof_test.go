@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestMapNestedObjectTypeOfEqual(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		other attr.Type
+		want  bool
+	}{
+		"string type": {
+			other: types.StringType,
+		},
+		"equal type": {
+			other: fwtypes.NewMapNestedObjectTypeOf[ObjectA](ctx),
+			want:  true,
+		},
+		"other struct type": {
+			other: fwtypes.NewMapNestedObjectTypeOf[ObjectB](ctx),
+		},
+		"list type": {
+			other: fwtypes.NewListNestedObjectTypeOf[ObjectA](ctx),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtypes.NewMapNestedObjectTypeOf[ObjectA](ctx).Equal(testCase.other)
+
+			if got != testCase.want {
+				t.Errorf("got = %v, want = %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestMapNestedObjectTypeOfValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	objectA := ObjectA{
+		Name: types.StringValue("test"),
+	}
+	objectAType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	objectAMapType := tftypes.Map{ElementType: objectAType}
+	objectAValue := tftypes.NewValue(objectAType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+	})
+	objectAMapValue := tftypes.NewValue(objectAMapType, map[string]tftypes.Value{"key": objectAValue})
+	objectBType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"length": tftypes.Number,
+		},
+	}
+	objectBValue := tftypes.NewValue(objectBType, map[string]tftypes.Value{
+		"length": tftypes.NewValue(tftypes.Number, 42),
+	})
+	objectBMapValue := tftypes.NewValue(tftypes.Map{ElementType: objectBType}, map[string]tftypes.Value{"key": objectBValue})
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		tfVal   tftypes.Value
+		wantVal attr.Value
+		wantErr bool
+	}{
+		"null value": {
+			tfVal:   tftypes.NewValue(objectAMapType, nil),
+			wantVal: fwtypes.NewMapNestedObjectValueOfNull[ObjectA](ctx),
+		},
+		"unknown value": {
+			tfVal:   tftypes.NewValue(objectAMapType, tftypes.UnknownValue),
+			wantVal: fwtypes.NewMapNestedObjectValueOfUnknown[ObjectA](ctx),
+		},
+		"valid value": {
+			tfVal:   objectAMapValue,
+			wantVal: fwtypes.NewMapNestedObjectValueOfMapMust(ctx, map[string]*ObjectA{"key": &objectA}),
+		},
+		"invalid Terraform value": {
+			tfVal:   objectBMapValue,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotVal, err := fwtypes.NewMapNestedObjectTypeOf[ObjectA](ctx).ValueFromTerraform(ctx, testCase.tfVal)
+			gotErr := err != nil
+
+			if gotErr != testCase.wantErr {
+				t.Errorf("gotErr = %v, wantErr = %v", gotErr, testCase.wantErr)
+			}
+
+			if gotErr {
+				if !testCase.wantErr {
+					t.Errorf("err = %q", err)
+				}
+			} else if diff := cmp.Diff(gotVal, testCase.wantVal); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapNestedObjectValueOfEqual(t *testing.T) {
+	t.Parallel()
+
+	objectA := ObjectA{
+		Name: types.StringValue("test"),
+	}
+	objectB := ObjectB{
+		Length: types.Int64Value(42),
+	}
+	objectA2 := ObjectA{
+		Name: types.StringValue("test2"),
+	}
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		other attr.Value
+		want  bool
+	}{
+		"string value": {
+			other: types.StringValue("test"),
+		},
+		"equal value": {
+			other: fwtypes.NewMapNestedObjectValueOfMapMust(ctx, map[string]*ObjectA{"key": &objectA}),
+			want:  true,
+		},
+		"key not equal value": {
+			other: fwtypes.NewMapNestedObjectValueOfMapMust(ctx, map[string]*ObjectA{"other": &objectA}),
+		},
+		"struct not equal value": {
+			other: fwtypes.NewMapNestedObjectValueOfMapMust(ctx, map[string]*ObjectA{"key": &objectA2}),
+		},
+		"other struct value": {
+			other: fwtypes.NewMapNestedObjectValueOfMapMust(ctx, map[string]*ObjectB{"key": &objectB}),
+		},
+		"null value": {
+			other: fwtypes.NewMapNestedObjectValueOfNull[ObjectA](ctx),
+		},
+		"unknown value": {
+			other: fwtypes.NewMapNestedObjectValueOfUnknown[ObjectA](ctx),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtypes.NewMapNestedObjectValueOfMapMust(ctx, map[string]*ObjectA{"key": &objectA}).Equal(testCase.other)
+
+			if got != testCase.want {
+				t.Errorf("got = %v, want = %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestMapNestedObjectValueOfToMap(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		val  fwtypes.MapNestedObjectValueOf[ObjectA]
+		want map[string]*ObjectA
+	}{
+		"null value": {
+			val:  fwtypes.NewMapNestedObjectValueOfNull[ObjectA](ctx),
+			want: map[string]*ObjectA{},
+		},
+		"valid value": {
+			val: fwtypes.NewMapNestedObjectValueOfValueMapMust(ctx, map[string]ObjectA{
+				"key1": {Name: types.StringValue("test1")},
+				"key2": {Name: types.StringValue("test2")},
+			}),
+			want: map[string]*ObjectA{
+				"key1": {Name: types.StringValue("test1")},
+				"key2": {Name: types.StringValue("test2")},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.val.ToMap(ctx)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
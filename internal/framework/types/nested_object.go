@@ -58,6 +58,34 @@ type NestedObjectCollectionValue interface {
 	ToObjectSlice(context.Context) (any, diag.Diagnostics)
 }
 
+// NestedObjectMapType is the interface implemented by types that represent maps of nested Objects.
+// It doesn't extend NestedObjectType as a map can't be converted to or from a single object.
+// It isn't generic on the Go struct type as it's referenced within AutoFlEx.
+type NestedObjectMapType interface {
+	attr.Type
+
+	// NewObjectPtr returns a new, empty value as an object pointer (Go *struct).
+	NewObjectPtr(context.Context) (any, diag.Diagnostics)
+
+	// NewObjectMap returns a new value as an object map (Go map[string]*struct).
+	NewObjectMap(context.Context, int) (any, diag.Diagnostics)
+
+	// NullValue returns a Null Value.
+	NullValue(context.Context) (attr.Value, diag.Diagnostics)
+
+	// ValueFromObjectMap returns a Value given an object map (Go map[string]*struct).
+	ValueFromObjectMap(context.Context, any) (attr.Value, diag.Diagnostics)
+}
+
+// NestedObjectMapValue is the interface implemented by values that represent maps of nested Objects.
+// It isn't generic on the Go struct type as it's referenced within AutoFlEx.
+type NestedObjectMapValue interface {
+	attr.Value
+
+	// ToObjectMap returns the value as an object map (Go map[string]*struct).
+	ToObjectMap(context.Context) (any, diag.Diagnostics)
+}
+
 // valueWithElements extends the Value interface for values that have an Elements method.
 type valueWithElements interface {
 	attr.Value
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WithRegionModel is intended to be embedded in the models of data sources and resources
+// that have opted in to per-resource Region override via the @Region annotation.
+type WithRegionModel struct {
+	Region types.String `tfsdk:"region"`
+}
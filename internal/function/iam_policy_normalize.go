@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document. " +
+			"Policy documents that are semantically equivalent normalize to the same compact JSON, with the `Version` element first.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document (JSON) to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validIAMPolicyJSON(arg, "policy"); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := normalizeIAMPolicy(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// canonicalIAMPolicyDocument is the canonical form of an IAM policy document.
+// It mirrors the model used by github.com/hashicorp/awspolicyequivalence to compare policies,
+// so that policies that are equivalent according to iam_policy_equivalent normalize to the same result:
+//   - A single statement and a one-element statement array are equivalent. Statements are sorted.
+//   - A string and a one-element string array are equivalent. Multi-element arrays are sorted.
+//   - Boolean and numeric values are equivalent to their string representations.
+//   - Effect is compared case-insensitively.
+//   - An account ID principal is equivalent to the account's root user ARN.
+type canonicalIAMPolicyDocument struct {
+	Version    string                         `json:",omitempty"`
+	ID         string                         `json:"Id,omitempty"`
+	Statements []*canonicalIAMPolicyStatement `json:"Statement,omitempty"`
+}
+
+type canonicalIAMPolicyStatement struct {
+	Actions       any                       `json:"Action,omitempty"`
+	Conditions    map[string]map[string]any `json:"Condition,omitempty"`
+	Effect        string                    `json:",omitempty"`
+	NotActions    any                       `json:"NotAction,omitempty"`
+	NotPrincipals any                       `json:"NotPrincipal,omitempty"`
+	NotResources  any                       `json:"NotResource,omitempty"`
+	Principals    any                       `json:"Principal,omitempty"`
+	Resources     any                       `json:"Resource,omitempty"`
+	Sid           string                    `json:",omitempty"`
+}
+
+func normalizeIAMPolicy(policy string) (string, error) {
+	var intermediate struct {
+		Version    string
+		ID         string          `json:"Id"`
+		Statements json.RawMessage `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &intermediate); err != nil {
+		return "", fmt.Errorf("unmarshaling policy: %w", err)
+	}
+
+	var statements []map[string]any
+
+	if v := bytes.TrimSpace(intermediate.Statements); len(v) > 0 && !bytes.Equal(v, []byte("null")) {
+		if v[0] != '[' {
+			v = slices.Concat([]byte("["), v, []byte("]"))
+		}
+
+		if err := json.Unmarshal(v, &statements); err != nil {
+			return "", fmt.Errorf("unmarshaling policy statements: %w", err)
+		}
+	}
+
+	doc := &canonicalIAMPolicyDocument{
+		Version: intermediate.Version,
+		ID:      intermediate.ID,
+	}
+
+	keys := make(map[*canonicalIAMPolicyStatement]string)
+	for _, v := range statements {
+		statement := canonicalizeIAMPolicyStatement(v)
+
+		key, err := json.Marshal(statement)
+		if err != nil {
+			return "", err
+		}
+
+		keys[statement] = string(key)
+		doc.Statements = append(doc.Statements, statement)
+	}
+
+	slices.SortStableFunc(doc.Statements, func(a, b *canonicalIAMPolicyStatement) int {
+		return strings.Compare(keys[a], keys[b])
+	})
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(doc); err != nil {
+		return "", fmt.Errorf("marshaling policy: %w", err)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func canonicalizeIAMPolicyStatement(m map[string]any) *canonicalIAMPolicyStatement {
+	statement := &canonicalIAMPolicyStatement{
+		Actions:       canonicalIAMPolicyStringSet(m["Action"]),
+		NotActions:    canonicalIAMPolicyStringSet(m["NotAction"]),
+		NotPrincipals: canonicalIAMPolicyPrincipals(m["NotPrincipal"]),
+		NotResources:  canonicalIAMPolicyStringSet(m["NotResource"]),
+		Principals:    canonicalIAMPolicyPrincipals(m["Principal"]),
+		Resources:     canonicalIAMPolicyStringSet(m["Resource"]),
+	}
+
+	if v, ok := m["Sid"].(string); ok {
+		statement.Sid = v
+	}
+
+	if v, ok := m["Effect"].(string); ok {
+		switch {
+		case strings.EqualFold(v, "Allow"):
+			statement.Effect = "Allow"
+		case strings.EqualFold(v, "Deny"):
+			statement.Effect = "Deny"
+		default:
+			statement.Effect = v
+		}
+	}
+
+	if v, ok := m["Condition"].(map[string]any); ok && len(v) > 0 {
+		statement.Conditions = make(map[string]map[string]any)
+
+		for operator, v := range v {
+			block := make(map[string]any)
+
+			if v, ok := v.(map[string]any); ok {
+				for key, v := range v {
+					if v := canonicalIAMPolicyStringSet(v); v != nil {
+						block[key] = v
+					} else {
+						block[key] = []string{}
+					}
+				}
+			}
+
+			statement.Conditions[operator] = block
+		}
+	}
+
+	return statement
+}
+
+// canonicalIAMPolicyPrincipals returns the canonical form of a Principal or NotPrincipal element.
+func canonicalIAMPolicyPrincipals(v any) any {
+	switch v := v.(type) {
+	case string:
+		return canonicalIAMPolicyPrincipal(v)
+	case map[string]any:
+		principals := make(map[string]any)
+
+		for typ, v := range v {
+			values := iamPolicyStrings(v)
+			if len(values) == 0 {
+				continue
+			}
+
+			for i, v := range values {
+				values[i] = canonicalIAMPolicyPrincipal(v)
+			}
+
+			principals[typ] = canonicalIAMPolicyStrings(values)
+		}
+
+		if len(principals) == 0 {
+			return nil
+		}
+
+		return principals
+	default:
+		return nil
+	}
+}
+
+// canonicalIAMPolicyPrincipal returns an account's root user ARN principal as the account ID.
+func canonicalIAMPolicyPrincipal(principal string) string {
+	if v, err := arn.Parse(principal); err == nil && v.Service == "iam" && v.Resource == "root" && regexache.MustCompile(`^[0-9]{12}$`).MatchString(v.AccountID) {
+		return v.AccountID
+	}
+
+	return principal
+}
+
+// canonicalIAMPolicyStringSet returns the canonical form of an element that is a string or an array of strings.
+func canonicalIAMPolicyStringSet(v any) any {
+	values := iamPolicyStrings(v)
+	if len(values) == 0 {
+		return nil
+	}
+
+	return canonicalIAMPolicyStrings(values)
+}
+
+func canonicalIAMPolicyStrings(values []string) any {
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	slices.Sort(values)
+
+	return values
+}
+
+func iamPolicyStrings(v any) []string {
+	switch v := v.(type) {
+	case []any:
+		var values []string
+
+		for _, v := range v {
+			values = append(values, iamPolicyStrings(v)...)
+		}
+
+		return values
+	case string:
+		return []string{v}
+	case bool:
+		return []string{strconv.FormatBool(v)}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyNormalizeFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig(`{
+  "Statement": [
+    {
+      "Resource": "*",
+      "Effect": "Allow",
+      "Action": "s3:GetObject"
+    }
+  ],
+  "Version": "2012-10-17"
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_equivalent(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig_equivalent(
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":["*"],"Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Condition":{"Bool":{"aws:SecureTransport":true}}}]}`,
+					`{"Statement":{"Resource":"*","Action":["s3:GetObject","s3:PutObject"],"Effect":"Allow","Principal":{"AWS":"123456789012"},"Condition":{"Bool":{"aws:SecureTransport":["true"]}}},"Version":"2012-10-17"}`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test1", `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Condition":{"Bool":{"aws:SecureTransport":"true"}},"Effect":"Allow","Principal":{"AWS":"123456789012"},"Resource":"*"}]}`),
+					resource.TestCheckOutput("test2", `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Condition":{"Bool":{"aws:SecureTransport":"true"}},"Effect":"Allow","Principal":{"AWS":"123456789012"},"Resource":"*"}]}`),
+					resource.TestCheckOutput("equivalent", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyNormalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyNormalizeFunctionConfig(`{"Version":"2012-10-17",`),
+				ExpectError: regexache.MustCompile("contains an invalid JSON"),
+			},
+		},
+	})
+}
+
+func testIAMPolicyNormalizeFunctionConfig(policy string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_normalize(%[1]q)
+}
+`, policy)
+}
+
+func testIAMPolicyNormalizeFunctionConfig_equivalent(policy1, policy2 string) string {
+	return fmt.Sprintf(`
+output "test1" {
+  value = provider::aws::iam_policy_normalize(%[1]q)
+}
+
+output "test2" {
+  value = provider::aws::iam_policy_normalize(%[2]q)
+}
+
+output "equivalent" {
+  value = provider::aws::iam_policy_equivalent(%[1]q, %[2]q)
+}
+`, policy1, policy2)
+}
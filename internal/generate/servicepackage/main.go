@@ -16,6 +16,7 @@ import (
 	"go/token"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -58,10 +59,12 @@ func main() {
 		v := &visitor{
 			g: g,
 
+			actions:              make([]ResourceDatum, 0),
 			ephemeralResources:   make([]ResourceDatum, 0),
 			frameworkDataSources: make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
+			sdkListResources:     make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
 
@@ -77,10 +80,12 @@ func main() {
 			GoV2Package:          l.GoV2Package(),
 			ProviderPackage:      p,
 			ProviderNameUpper:    l.ProviderNameUpper(),
+			Actions:              v.actions,
 			EphemeralResources:   v.ephemeralResources,
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
 			SDKDataSources:       v.sdkDataSources,
+			SDKListResources:     v.sdkListResources,
 			SDKResources:         v.sdkResources,
 		}
 
@@ -122,6 +127,9 @@ func main() {
 type ResourceDatum struct {
 	FactoryName             string
 	Name                    string // Friendly name (without service name), e.g. "Topic", not "SNS Topic"
+	IdentityAttribute       string
+	IsGlobalResource        bool
+	RegionOverrideEnabled   bool
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
@@ -133,10 +141,12 @@ type ServiceDatum struct {
 	GoV2Package          string // AWS SDK for Go v2 package name
 	ProviderPackage      string
 	ProviderNameUpper    string
+	Actions              []ResourceDatum
 	EphemeralResources   []ResourceDatum
 	FrameworkDataSources []ResourceDatum
 	FrameworkResources   []ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
+	SDKListResources     map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
 }
 
@@ -159,10 +169,12 @@ type visitor struct {
 	functionName string
 	packageName  string
 
+	actions              []ResourceDatum
 	ephemeralResources   []ResourceDatum
 	frameworkDataSources []ResourceDatum
 	frameworkResources   []ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
+	sdkListResources     map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
 
@@ -228,6 +240,37 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IdentityAttribute" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no IdentityAttribute attribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.IdentityAttribute = namesgen.ConstOrQuote(args.Positional[0])
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid IdentityAttribute global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.IsGlobalResource = b
+				}
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Region" {
+			args := common.ParseArgs(m[3])
+
+			if attr, ok := args.Keyword["overrideEnabled"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.RegionOverrideEnabled = b
+				}
+			}
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -243,6 +286,12 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			}
 
 			switch annotationName := m[1]; annotationName {
+			case "Action":
+				if slices.ContainsFunc(v.actions, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.errs = append(v.errs, fmt.Errorf("duplicate Action: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.actions = append(v.actions, d)
+				}
 			case "EphemeralResource":
 				if slices.ContainsFunc(v.ephemeralResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -274,6 +323,19 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkDataSources[typeName] = d
 				}
+			case "SDKListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if _, ok := v.sdkListResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate SDK List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.sdkListResources[typeName] = d
+				}
 			case "SDKResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -287,7 +349,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "IdentityAttribute", "Region", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// identityResourceInterceptor records the resource's identity after each successful Create, Read or Update.
+type identityResourceInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == After {
+		diags = r.setIdentity(ctx, &response.State, response.Identity, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == After {
+		diags = r.setIdentity(ctx, &response.State, response.Identity, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == After {
+		diags = r.setIdentity(ctx, &response.State, response.Identity, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) setIdentity(ctx context.Context, state *tfsdk.State, identity *tfsdk.ResourceIdentity, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	// Resource not found.
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	var id fwtypes.String
+	diags.Append(state.GetAttribute(ctx, path.Root(r.identity.IdentityAttribute), &id)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), meta.AccountID(ctx))...)
+	if !r.identity.IsGlobalResource {
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+	diags.Append(identity.SetAttribute(ctx, path.Root(r.identity.IdentityAttribute), id)...)
+
+	return diags
+}
+
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource that supports resource identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+	identity              *types.ServicePackageResourceIdentity
+	regionOverrideEnabled bool
+}
+
+func newWrappedResourceWithIdentity(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, identity *types.ServicePackageResourceIdentity, regionOverrideEnabled bool) resource.ResourceWithConfigure {
+	return &wrappedResourceWithIdentity{
+		wrappedResource: &wrappedResource{
+			bootstrapContext: bootstrapContext,
+			inner:            inner,
+			interceptors:     append(interceptors, identityResourceInterceptor{identity: identity}),
+		},
+		identity:              identity,
+		regionOverrideEnabled: regionOverrideEnabled,
+	}
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	attributes := map[string]identityschema.Attribute{
+		names.AttrAccountID: identityschema.StringAttribute{
+			OptionalForImport: true,
+		},
+		w.identity.IdentityAttribute: identityschema.StringAttribute{
+			RequiredForImport: true,
+		},
+	}
+
+	if !w.identity.IsGlobalResource {
+		attributes[names.AttrRegion] = identityschema.StringAttribute{
+			OptionalForImport: true,
+		}
+	}
+
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// ImportState sets the import identifier from the resource's identity when imported by identity
+// and then calls the resource's own ImportState method.
+func (w *wrappedResourceWithIdentity) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if request.ID == "" && request.Identity != nil && w.meta != nil {
+		var accountID, region, id fwtypes.String
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrAccountID), &accountID)...)
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(w.identity.IdentityAttribute), &id)...)
+		if !w.identity.IsGlobalResource {
+			response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+		}
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if v := accountID.ValueString(); v != "" && v != w.meta.AccountID(ctx) {
+			response.Diagnostics.AddAttributeError(
+				path.Root(names.AttrAccountID),
+				"Invalid Identity Value",
+				fmt.Sprintf("Identity %s (%s) does not match the provider's configured account ID (%s).", names.AttrAccountID, v, w.meta.AccountID(ctx)),
+			)
+
+			return
+		}
+
+		if v := region.ValueString(); v != "" {
+			if w.regionOverrideEnabled {
+				response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), v)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+			} else if v != w.meta.Region(ctx) {
+				response.Diagnostics.AddAttributeError(
+					path.Root(names.AttrRegion),
+					"Invalid Identity Value",
+					fmt.Sprintf("Identity %s (%s) does not match the provider's configured Region (%s).", names.AttrRegion, v, w.meta.Region(ctx)),
+				)
+
+				return
+			}
+		}
+
+		request.ID = id.ValueString()
+	}
+
+	w.wrappedResource.ImportState(ctx, request, response)
+}
@@ -8,9 +8,11 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -27,6 +29,31 @@ import (
 
 type interceptorFunc[Request, Response any] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
+// An action interceptor is functionality invoked during the action's request lifecycle.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+// In other cases all interceptors in the chain are run.
+type actionInterceptor interface {
+	// invoke is invoked for an Invoke call.
+	invoke(context.Context, action.InvokeRequest, *action.InvokeResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
+type actionInterceptors []actionInterceptor
+
+type actionInterceptorInvokeFunc interceptorFunc[action.InvokeRequest, action.InvokeResponse]
+
+// invoke returns a slice of interceptors that run on action Invoke.
+func (s actionInterceptors) invoke() []actionInterceptorInvokeFunc {
+	return slices.ApplyToAll(s, func(e actionInterceptor) actionInterceptorInvokeFunc {
+		return e.invoke
+	})
+}
+
+// An action schema interceptor modifies the action's schema.
+type actionSchemaInterceptor interface {
+	schema(context.Context, action.SchemaRequest, *action.SchemaResponse)
+}
+
 // A data source interceptor is functionality invoked during the data source's CRUD request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
 // no further interceptors in the chain are run and neither is the schema's method.
@@ -38,6 +65,11 @@ type dataSourceInterceptor interface {
 
 type dataSourceInterceptors []dataSourceInterceptor
 
+// A data source schema interceptor modifies the data source's schema.
+type dataSourceSchemaInterceptor interface {
+	schema(context.Context, datasource.SchemaRequest, *datasource.SchemaResponse)
+}
+
 type dataSourceInterceptorReadFunc interceptorFunc[datasource.ReadRequest, datasource.ReadResponse]
 
 // read returns a slice of interceptors that run on data source Read.
@@ -47,12 +79,56 @@ func (s dataSourceInterceptors) read() []dataSourceInterceptorReadFunc {
 	})
 }
 
+// An ephemeral resource interceptor is functionality invoked during the ephemeral resource's request lifecycle.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+// In other cases all interceptors in the chain are run.
 type ephemeralResourceInterceptor interface {
-	// TODO implement me
+	// open is invoked for an Open call.
+	open(context.Context, ephemeral.OpenRequest, *ephemeral.OpenResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
 type ephemeralResourceInterceptors []ephemeralResourceInterceptor
 
+type ephemeralResourceInterceptorOpenFunc interceptorFunc[ephemeral.OpenRequest, ephemeral.OpenResponse]
+
+// open returns a slice of interceptors that run on ephemeral resource Open.
+func (s ephemeralResourceInterceptors) open() []ephemeralResourceInterceptorOpenFunc {
+	return slices.ApplyToAll(s, func(e ephemeralResourceInterceptor) ephemeralResourceInterceptorOpenFunc {
+		return e.open
+	})
+}
+
+// An ephemeral resource schema interceptor modifies the ephemeral resource's schema.
+type ephemeralResourceSchemaInterceptor interface {
+	schema(context.Context, ephemeral.SchemaRequest, *ephemeral.SchemaResponse)
+}
+
+// A list resource interceptor is functionality invoked during the list resource's request lifecycle.
+// List results are streamed after the list resource's List method returns so only Before interceptors are run.
+// If a Before interceptor returns Diagnostics indicating an error occurred then
+// no further interceptors in the chain are run and neither is the schema's method.
+type listResourceInterceptor interface {
+	// list is invoked for a List call.
+	list(context.Context, list.ListRequest, *list.ListResultsStream, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
+type listResourceInterceptors []listResourceInterceptor
+
+type listResourceInterceptorListFunc interceptorFunc[list.ListRequest, list.ListResultsStream]
+
+// list returns a slice of interceptors that run on list resource List.
+func (s listResourceInterceptors) list() []listResourceInterceptorListFunc {
+	return slices.ApplyToAll(s, func(e listResourceInterceptor) listResourceInterceptorListFunc {
+		return e.list
+	})
+}
+
+// A list resource schema interceptor modifies the list resource's configuration schema.
+type listResourceSchemaInterceptor interface {
+	schema(context.Context, list.ListResourceSchemaRequest, *list.ListResourceSchemaResponse)
+}
+
 type resourceCRUDRequest interface {
 	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest
 }
@@ -77,6 +153,17 @@ type resourceInterceptor interface {
 
 type resourceInterceptors []resourceInterceptor
 
+// A resource schema interceptor modifies the resource's schema.
+type resourceSchemaInterceptor interface {
+	schema(context.Context, resource.SchemaRequest, *resource.SchemaResponse)
+}
+
+// A resource plan interceptor is invoked before the resource's ModifyPlan method.
+// If it returns Diagnostics indicating an error occurred then the resource's method is not called.
+type resourceModifyPlanInterceptor interface {
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] interceptorFunc[Request, Response]
 
 // create returns a slice of interceptors that run on resource Create.
@@ -159,6 +246,104 @@ func interceptedDataSourceReadHandler(interceptors []dataSourceInterceptorReadFu
 	}
 }
 
+// interceptedActionInvokeHandler returns a handler that invokes the specified action Invoke handler, running any interceptors.
+func interceptedActionInvokeHandler(interceptors []actionInterceptorInvokeFunc, f func(context.Context, action.InvokeRequest, *action.InvokeResponse) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, action.InvokeRequest, *action.InvokeResponse) diag.Diagnostics {
+	return func(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) diag.Diagnostics {
+		var diags diag.Diagnostics
+		// Before interceptors are run first to last.
+		forward := interceptors
+
+		when := Before
+		for _, v := range forward {
+			ctx, diags = v(ctx, request, response, meta, when, diags)
+
+			// Short circuit if any Before interceptor errors.
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		// All other interceptors are run last to first.
+		reverse := slices.Reverse(forward)
+		diags = f(ctx, request, response)
+
+		if diags.HasError() {
+			when = OnError
+		} else {
+			when = After
+		}
+		for _, v := range reverse {
+			ctx, diags = v(ctx, request, response, meta, when, diags)
+		}
+
+		when = Finally
+		for _, v := range reverse {
+			ctx, diags = v(ctx, request, response, meta, when, diags)
+		}
+
+		return diags
+	}
+}
+
+// interceptedEphemeralResourceOpenHandler returns a handler that invokes the specified ephemeral resource Open handler, running any interceptors.
+func interceptedEphemeralResourceOpenHandler(interceptors []ephemeralResourceInterceptorOpenFunc, f func(context.Context, ephemeral.OpenRequest, *ephemeral.OpenResponse) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, ephemeral.OpenRequest, *ephemeral.OpenResponse) diag.Diagnostics {
+	return func(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) diag.Diagnostics {
+		var diags diag.Diagnostics
+		// Before interceptors are run first to last.
+		forward := interceptors
+
+		when := Before
+		for _, v := range forward {
+			ctx, diags = v(ctx, request, response, meta, when, diags)
+
+			// Short circuit if any Before interceptor errors.
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		// All other interceptors are run last to first.
+		reverse := slices.Reverse(forward)
+		diags = f(ctx, request, response)
+
+		if diags.HasError() {
+			when = OnError
+		} else {
+			when = After
+		}
+		for _, v := range reverse {
+			ctx, diags = v(ctx, request, response, meta, when, diags)
+		}
+
+		when = Finally
+		for _, v := range reverse {
+			ctx, diags = v(ctx, request, response, meta, when, diags)
+		}
+
+		return diags
+	}
+}
+
+// interceptedListResourceListHandler returns a handler that invokes the specified list resource List handler, running any interceptors.
+func interceptedListResourceListHandler(interceptors []listResourceInterceptorListFunc, f func(context.Context, list.ListRequest, *list.ListResultsStream), meta *conns.AWSClient) func(context.Context, list.ListRequest, *list.ListResultsStream) {
+	return func(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+		var diags diag.Diagnostics
+		// Before interceptors are run first to last.
+		when := Before
+		for _, v := range interceptors {
+			ctx, diags = v(ctx, request, stream, meta, when, diags)
+
+			// Short circuit if any Before interceptor errors.
+			if diags.HasError() {
+				stream.Results = list.ListResultsStreamDiagnostics(diags)
+				return
+			}
+		}
+
+		f(ctx, request, stream)
+	}
+}
+
 // interceptedResourceHandler returns a handler that invokes the specified resource CRUD handler, running any interceptors.
 func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCRUDResponse](interceptors []resourceInterceptorFunc[Request, Response], f func(context.Context, Request, *Response) diag.Diagnostics, meta *conns.AWSClient) func(context.Context, Request, *Response) diag.Diagnostics {
 	return func(ctx context.Context, request Request, response *Response) diag.Diagnostics {
@@ -226,6 +411,12 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(dataSourceSchemaInterceptor); ok {
+			v.schema(ctx, request, response)
+		}
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -362,6 +553,166 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 	return ctx, diags
 }
 
+// wrappedAction represents an interceptor dispatcher for a Plugin Framework action.
+type wrappedAction struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            action.ActionWithConfigure
+	meta             *conns.AWSClient
+	interceptors     actionInterceptors
+}
+
+func newWrappedAction(bootstrapContext contextFunc, inner action.ActionWithConfigure, interceptors actionInterceptors) action.ActionWithConfigure {
+	return &wrappedAction{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+		interceptors:     interceptors,
+	}
+}
+
+func (w *wrappedAction) Metadata(ctx context.Context, request action.MetadataRequest, response *action.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(actionSchemaInterceptor); ok {
+			v.schema(ctx, request, response)
+		}
+	}
+}
+
+func (w *wrappedAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	f := func(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) diag.Diagnostics {
+		w.inner.Invoke(ctx, request, response)
+		return response.Diagnostics
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	diags := interceptedActionInvokeHandler(w.interceptors.invoke(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
+}
+
+func (w *wrappedAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedAction) ModifyPlan(ctx context.Context, request action.ModifyPlanRequest, response *action.ModifyPlanResponse) {
+	if v, ok := w.inner.(action.ActionWithModifyPlan); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ModifyPlan(ctx, request, response)
+	}
+}
+
+func (w *wrappedAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	if v, ok := w.inner.(action.ActionWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedAction) ValidateConfig(ctx context.Context, request action.ValidateConfigRequest, response *action.ValidateConfigResponse) {
+	if v, ok := w.inner.(action.ActionWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
+// wrappedListResource represents an interceptor dispatcher for a Plugin Framework list resource.
+type wrappedListResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            list.ListResourceWithConfigure
+	meta             *conns.AWSClient
+	interceptors     listResourceInterceptors
+}
+
+func newWrappedListResource(bootstrapContext contextFunc, inner list.ListResourceWithConfigure, interceptors listResourceInterceptors) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+		interceptors:     interceptors,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.ListResourceConfigSchema(ctx, request, response)
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(listResourceSchemaInterceptor); ok {
+			v.schema(ctx, request, response)
+		}
+	}
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	interceptedListResourceListHandler(w.interceptors.list(), w.inner.List, w.meta)(ctx, request, stream)
+
+	// Stop sending results once the requested number of results has been sent.
+	if limit, results := request.Limit, stream.Results; limit > 0 && results != nil {
+		stream.Results = func(yield func(list.ListResult) bool) {
+			var n int64
+			for v := range results {
+				if !yield(v) {
+					return
+				}
+
+				if n++; n >= limit {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	if v, ok := w.inner.(list.ListResourceWithRawV5Schemas); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.RawV5Schemas(ctx, request, response)
+	}
+}
+
+func (w *wrappedListResource) ListResourceConfigValidators(ctx context.Context) []list.ConfigValidator {
+	if v, ok := w.inner.(list.ListResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ListResourceConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedListResource) ValidateListResourceConfig(ctx context.Context, request list.ValidateConfigRequest, response *list.ValidateConfigResponse) {
+	if v, ok := w.inner.(list.ListResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateListResourceConfig(ctx, request, response)
+	}
+}
+
+// wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
 type wrappedEphemeralResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
@@ -378,11 +729,22 @@ func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemer
 func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(ephemeralResourceSchemaInterceptor); ok {
+			v.schema(ctx, request, response)
+		}
+	}
 }
 
 func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	f := func(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) diag.Diagnostics {
+		w.inner.Open(ctx, request, response)
+		return response.Diagnostics
+	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Open(ctx, request, response)
+	diags := interceptedEphemeralResourceOpenHandler(w.interceptors.open(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
 }
 
 func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
@@ -456,6 +818,12 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourceSchemaInterceptor); ok {
+			v.schema(ctx, request, response)
+		}
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -521,8 +889,20 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourceModifyPlanInterceptor); ok {
+			ctx, response.Diagnostics = v.modifyPlan(ctx, request, response, w.meta, response.Diagnostics)
+
+			// Short circuit if any interceptor errors.
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 	}
 }
@@ -9,15 +9,18 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -30,17 +33,19 @@ import (
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithActions = &fwprovider{}
+var _ provider.ProviderWithListResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
-func New(primary interface{ Meta() interface{} }) provider.Provider {
+func New(primary *sdkschema.Provider) provider.Provider {
 	return &fwprovider{
 		Primary: primary,
 	}
 }
 
 type fwprovider struct {
-	Primary interface{ Meta() interface{} }
+	Primary *sdkschema.Provider
 }
 
 func (p *fwprovider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -260,6 +265,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_autoscaling_group`, to which no default tags are applied.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -267,6 +277,29 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"resource_type_override": schema.ListNestedBlock{
+							Description: "Configuration blocks with overrides of the default tags for individual resource types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Default tag keys that are not applied to the resource type.",
+									},
+									"resource_type": schema.StringAttribute{
+										Required:    true,
+										Description: "The resource type, e.g. `aws_autoscaling_group`.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tags merged on to the default tags for the resource type.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -292,6 +325,29 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"service_retries": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to override the retry configuration for individual AWS services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request to the service is being executed. Overrides `max_retries`.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how retries are attempted for the service. Valid values are `standard` and `adaptive`. Overrides `retry_mode`.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, e.g. `ec2`. Any of the service names accepted in the `endpoints` block can be used.",
+						},
+						"token_bucket_rate_limiter_capacity": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The capacity of the AWS SDK's token bucket rate limiter for the service. Overrides `token_bucket_rate_limiter_capacity`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -305,6 +361,79 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ActionData = v
+	response.ListResourceData = v
+}
+
+// Actions returns a slice of functions to instantiate each Action
+// implementation.
+//
+// The action type name is determined by the Action implementing
+// the Metadata method. All actions must have unique names.
+func (p *fwprovider) Actions(ctx context.Context) []func() action.Action {
+	var errs []error
+	var actions []func() action.Action
+
+	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		if data, ok := sp.(conns.ServicePackageWithActions); ok {
+			servicePackageName := data.ServicePackageName()
+
+			for _, v := range data.Actions(ctx) {
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					tflog.Warn(ctx, "creating action", map[string]interface{}{
+						"service_package_name": n,
+						"error":                err.Error(),
+					})
+
+					continue
+				}
+
+				// bootstrapContext is run on all wrapped methods before any interceptors.
+				bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+					ctx = conns.NewActionContext(ctx, servicePackageName, v.Name)
+					if meta != nil {
+						ctx = meta.RegisterLogger(ctx)
+						ctx = flex.RegisterLogger(ctx)
+					}
+					return ctx
+				}
+
+				interceptors := actionInterceptors{}
+
+				if v.Region != nil && v.Region.IsOverrideEnabled {
+					// The action has opted in to per-resource Region override.
+					// Ensure that the schema look OK.
+					metadataResponse := action.MetadataResponse{}
+					inner.Metadata(ctx, action.MetadataRequest{}, &metadataResponse)
+					typeName := metadataResponse.TypeName
+
+					schemaResponse := action.SchemaResponse{}
+					inner.Schema(ctx, action.SchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute cannot be defined in schema: %s", names.AttrRegion, typeName))
+						continue
+					}
+
+					interceptors = append(interceptors, regionActionInterceptor{})
+				}
+
+				actions = append(actions, func() action.Action {
+					return newWrappedAction(bootstrapContext, inner, interceptors)
+				})
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering actions", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return actions
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -348,6 +477,20 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 			interceptors := dataSourceInterceptors{}
 
+			if v.Region != nil && v.Region.IsOverrideEnabled {
+				// The data source has opted in to per-resource Region override.
+				// Ensure that the schema look OK.
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute cannot be defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, regionDataSourceInterceptor{})
+			}
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -408,7 +551,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -418,6 +561,22 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
+			identity := v.Identity
+			regionOverrideEnabled := v.Region != nil && v.Region.IsOverrideEnabled
+
+			if regionOverrideEnabled {
+				// The resource has opted in to per-resource Region override.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+					errs = append(errs, fmt.Errorf("`%s` attribute cannot be defined in schema: %s", names.AttrRegion, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, regionResourceInterceptor{})
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -447,6 +606,24 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if identity != nil {
+				// The resource has opted in to resource identity.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[identity.IdentityAttribute]; !ok {
+					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", identity.IdentityAttribute, typeName))
+					continue
+				}
+
+				resources = append(resources, func() resource.Resource {
+					return newWrappedResourceWithIdentity(bootstrapContext, inner, interceptors, identity, regionOverrideEnabled)
+				})
+
+				continue
+			}
+
 			resources = append(resources, func() resource.Resource {
 				return newWrappedResource(bootstrapContext, inner, interceptors)
 			})
@@ -498,8 +675,28 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					return ctx
 				}
 
+				interceptors := ephemeralResourceInterceptors{}
+
+				if v.Region != nil && v.Region.IsOverrideEnabled {
+					// The ephemeral resource has opted in to per-resource Region override.
+					// Ensure that the schema look OK.
+					metadataResponse := ephemeral.MetadataResponse{}
+					inner.Metadata(ctx, ephemeral.MetadataRequest{}, &metadataResponse)
+					typeName := metadataResponse.TypeName
+
+					schemaResponse := ephemeral.SchemaResponse{}
+					inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute cannot be defined in schema: %s", names.AttrRegion, typeName))
+						continue
+					}
+
+					interceptors = append(interceptors, regionEphemeralResourceInterceptor{})
+				}
+
 				ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
-					return newWrappedEphemeralResource(bootstrapContext, inner, nil)
+					return newWrappedEphemeralResource(bootstrapContext, inner, interceptors)
 				})
 			}
 		}
@@ -514,6 +711,84 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 	return ephemeralResources
 }
 
+// ListResources returns a slice of functions to instantiate each List Resource
+// implementation.
+//
+// The resource type name is determined by the List Resource implementing
+// the Metadata method. All list resources must have unique names and must
+// correspond to a managed resource that supports resource identity.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		if data, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			servicePackageName := data.ServicePackageName()
+
+			for _, v := range data.SDKListResources(ctx) {
+				typeName := v.TypeName
+				inner := v.Factory()
+
+				// The list resource's managed resource must support resource identity.
+				r, ok := p.Primary.ResourcesMap[typeName]
+				if !ok {
+					errs = append(errs, fmt.Errorf("no resource defined for list resource: %s", typeName))
+					continue
+				}
+				if r.Identity == nil {
+					errs = append(errs, fmt.Errorf("resource identity not supported for list resource: %s", typeName))
+					continue
+				}
+
+				if v, ok := inner.(interface{ SetResourceSchema(*sdkschema.Resource) }); ok {
+					v.SetResourceSchema(r)
+				} else {
+					errs = append(errs, fmt.Errorf("resource schema cannot be set for list resource: %s", typeName))
+					continue
+				}
+
+				// bootstrapContext is run on all wrapped methods before any interceptors.
+				bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+					ctx = conns.NewListResourceContext(ctx, servicePackageName, v.Name)
+					if meta != nil {
+						ctx = meta.RegisterLogger(ctx)
+						ctx = flex.RegisterLogger(ctx)
+					}
+					return ctx
+				}
+
+				interceptors := listResourceInterceptors{}
+
+				if v.Region != nil && v.Region.IsOverrideEnabled {
+					// The list resource has opted in to per-resource Region override.
+					// Ensure that the schema look OK.
+					schemaResponse := list.ListResourceSchemaResponse{}
+					inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+
+					if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; ok {
+						errs = append(errs, fmt.Errorf("`%s` attribute cannot be defined in schema: %s", names.AttrRegion, typeName))
+						continue
+					}
+
+					interceptors = append(interceptors, regionListResourceInterceptor{})
+				}
+
+				listResources = append(listResources, func() list.ListResource {
+					return newWrappedListResource(bootstrapContext, inner, interceptors)
+				})
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -523,6 +798,8 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -1202,6 +1202,13 @@ func endpointsBlock() schema.SetNestedBlock {
 					Description: "Use this to override the default service endpoint URL",
 				},
 
+				// mailmanager
+
+				"mailmanager": schema.StringAttribute{
+					Optional:    true,
+					Description: "Use this to override the default service endpoint URL",
+				},
+
 				// mediaconnect
 
 				"mediaconnect": schema.StringAttribute{
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// setRegionInContext places any non-empty Region value at the specified attribute into Context
+// and validates that the Region is in the configured partition.
+func setRegionInContext(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	var region types.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if v := region.ValueString(); v != "" {
+		inContext.OverrideRegion = v
+
+		if meta != nil {
+			if err := meta.ValidateInContextRegionInPartition(ctx); err != nil {
+				diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// setRegionInState records the effective Region in state.
+func setRegionInState(ctx context.Context, state *tfsdk.State, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	// Resource not found.
+	if state.Raw.IsNull() {
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+
+	return diags
+}
+
+// regionActionInterceptor implements per-resource Region override for actions.
+type regionActionInterceptor struct{}
+
+func (r regionActionInterceptor) schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]actionschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = actionschema.StringAttribute{
+		Optional:    true,
+		Description: "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func (r regionActionInterceptor) invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx, diags = setRegionInContext(ctx, request.Config.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]datasourceschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, diags = setRegionInContext(ctx, request.Config.GetAttribute, meta, diags)
+	case After:
+		diags = setRegionInState(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// regionListResourceInterceptor implements per-resource Region override for list resources.
+type regionListResourceInterceptor struct{}
+
+func (r regionListResourceInterceptor) schema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]listschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = listschema.StringAttribute{
+		Optional:    true,
+		Description: "The AWS Region in which to list resources. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func (r regionListResourceInterceptor) list(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx, diags = setRegionInContext(ctx, request.Config.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]resourceschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = resourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// modifyPlan plans the provider's Region if no Region is configured.
+// Any change in the effective Region, including a change to the provider's Region, forces resource replacement.
+func (r regionResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	// Destroy or provider not yet configured.
+	if request.Plan.Raw.IsNull() || meta == nil {
+		return ctx, diags
+	}
+
+	var configRegion types.String
+	diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if configRegion.IsNull() {
+		diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+	}
+
+	var planRegion, stateRegion types.String
+	diags.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &planRegion)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if !request.State.Raw.IsNull() {
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if !stateRegion.IsNull() && !planRegion.IsUnknown() && !planRegion.Equal(stateRegion) {
+			response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+		}
+	}
+
+	// Any subsequent plan modification makes API calls in the planned Region.
+	return setRegionInContext(ctx, response.Plan.GetAttribute, meta, diags)
+}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, diags = setRegionInContext(ctx, request.Plan.GetAttribute, meta, diags)
+	case After:
+		diags = setRegionInState(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, diags = setRegionInContext(ctx, request.State.GetAttribute, meta, diags)
+	case After:
+		diags = setRegionInState(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, diags = setRegionInContext(ctx, request.Plan.GetAttribute, meta, diags)
+	case After:
+		diags = setRegionInState(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		ctx, diags = setRegionInContext(ctx, request.State.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// regionEphemeralResourceInterceptor implements per-resource Region override for ephemeral resources.
+type regionEphemeralResourceInterceptor struct{}
+
+func (r regionEphemeralResourceInterceptor) schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]ephemeralschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = ephemeralschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func (r regionEphemeralResourceInterceptor) open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, diags = setRegionInContext(ctx, request.Config.GetAttribute, meta, diags)
+	case After:
+		diags.Append(response.Result.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+
+	return ctx, diags
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// resourceIdentity returns the identity schema for a resource with the specified identity.
+// The identity consists of the AWS account ID, the Region (for Regional resources) and the resource's identity attribute.
+func resourceIdentity(identity *types.ServicePackageResourceIdentity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{
+				names.AttrAccountID: {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				},
+				identity.IdentityAttribute: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				},
+			}
+
+			if !identity.IsGlobalResource {
+				s[names.AttrRegion] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+				}
+			}
+
+			return s
+		},
+	}
+}
+
+// identityAttributeValue returns the value of the resource's identity attribute.
+func identityAttributeValue(d schemaResourceData, attribute string) string {
+	if attribute == names.AttrID {
+		return d.Id()
+	}
+
+	v, _ := d.Get(attribute).(string)
+
+	return v
+}
+
+// identityInterceptor records the resource's identity after each successful Create, Read or Update.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != After {
+		return ctx, diags
+	}
+
+	switch why {
+	case Create, Read, Update:
+		// Resource not found.
+		if d.Id() == "" {
+			return ctx, diags
+		}
+
+		rd, ok := d.(interface {
+			Identity() (*schema.IdentityData, error)
+		})
+		if !ok {
+			return ctx, diags
+		}
+
+		identity, err := rd.Identity()
+		if err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "getting resource identity: %s", err)
+		}
+
+		c := meta.(*conns.AWSClient)
+
+		if err := identity.Set(names.AttrAccountID, c.AccountID(ctx)); err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrAccountID, err)
+		}
+
+		if !r.identity.IsGlobalResource {
+			if err := identity.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrRegion, err)
+			}
+		}
+
+		if err := identity.Set(r.identity.IdentityAttribute, identityAttributeValue(d, r.identity.IdentityAttribute)); err != nil {
+			return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", r.identity.IdentityAttribute, err)
+		}
+	}
+
+	return ctx, diags
+}
+
+// importByIdentity returns an importer that sets the resource's ID from its identity
+// when imported by identity and then calls the resource's own importer.
+// regionOverrideEnabled indicates whether the resource supports per-resource Region override.
+func importByIdentity(identity *types.ServicePackageResourceIdentity, regionOverrideEnabled bool, f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		// Imported by ID.
+		if d.Id() != "" {
+			return f(ctx, d, meta)
+		}
+
+		v, err := d.Identity()
+		if err != nil {
+			return nil, fmt.Errorf("getting resource identity: %w", err)
+		}
+
+		c := meta.(*conns.AWSClient)
+
+		if accountID, ok := v.GetOk(names.AttrAccountID); ok {
+			if accountID := accountID.(string); accountID != c.AccountID(ctx) {
+				return nil, fmt.Errorf("identity %s (%s) does not match the provider's configured account ID (%s)", names.AttrAccountID, accountID, c.AccountID(ctx))
+			}
+		}
+
+		if !identity.IsGlobalResource {
+			if region, ok := v.GetOk(names.AttrRegion); ok {
+				region := region.(string)
+
+				if regionOverrideEnabled {
+					if err := d.Set(names.AttrRegion, region); err != nil {
+						return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+					}
+				} else if region != c.Region(ctx) {
+					return nil, fmt.Errorf("identity %s (%s) does not match the provider's configured Region (%s)", names.AttrRegion, region, c.Region(ctx))
+				}
+			}
+		}
+
+		id, ok := v.GetOk(identity.IdentityAttribute)
+		if !ok {
+			return nil, fmt.Errorf("identity attribute %s is required", identity.IdentityAttribute)
+		}
+
+		d.SetId(id.(string))
+
+		if identity.IdentityAttribute != names.AttrID {
+			if err := d.Set(identity.IdentityAttribute, id); err != nil {
+				return nil, fmt.Errorf("setting %s: %w", identity.IdentityAttribute, err)
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -68,6 +69,38 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_autoscaling_group`, to which no default tags are applied.",
+						},
+						"resource_type_override": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with overrides of the default tags for individual resource types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Default tag keys that are not applied to the resource type.",
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The resource type, e.g. `aws_autoscaling_group`.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags merged on to the default tags for the resource type.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -191,6 +224,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_retries": serviceRetriesSchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -303,6 +337,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			if isRegionOverrideEnabled(servicePackageName, r) {
+				addRegionAttribute(r, regionDataSourceSchema())
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -369,7 +413,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -378,6 +422,43 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				return ctx
 			}
 			interceptors := interceptorItems{}
+			identity := v.Identity
+			regionOverrideEnabled := isRegionOverrideEnabled(servicePackageName, r)
+
+			if regionOverrideEnabled {
+				addRegionAttribute(r, regionResourceSchema())
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(setRegionInPlan, v)
+				} else {
+					r.CustomizeDiff = setRegionInPlan
+				}
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
+			if identity != nil {
+				// The resource has opted in to resource identity.
+				// Ensure that the schema look OK.
+				if identity.IdentityAttribute != names.AttrID {
+					if _, ok := r.SchemaMap()[identity.IdentityAttribute]; !ok {
+						errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", identity.IdentityAttribute, typeName))
+						continue
+					}
+				}
+
+				r.Identity = resourceIdentity(identity)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: identityInterceptor{identity: identity},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -433,6 +514,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
+					if identity != nil {
+						v = importByIdentity(identity, regionOverrideEnabled, v)
+					}
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
@@ -602,6 +686,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_retries"); ok && len(v.([]interface{})) > 0 {
+		serviceRetries, dx := expandServiceRetries(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceRetries = serviceRetries
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -634,6 +727,38 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+func serviceRetriesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to override the retry configuration for individual AWS services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The maximum number of times an AWS API request to the service is being executed. Overrides `max_retries`.",
+				},
+				"retry_mode": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies how retries are attempted for the service. Valid values are `standard` and `adaptive`. Overrides `retry_mode`.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service, e.g. `ec2`. Any of the service names accepted in the `endpoints` block can be used.",
+				},
+				"token_bucket_rate_limiter_capacity": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The capacity of the AWS SDK's token bucket rate limiter for the service. Overrides `token_bucket_rate_limiter_capacity`.",
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -885,15 +1010,115 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		}
 	}
 
-	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+	var excludeResourceTypes []string
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+		excludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	resourceTypeOverrides := make(map[string]tftags.ResourceTypeOverride)
+	if v, ok := tfMap["resource_type_override"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			typeName := tfMap["resource_type"].(string)
+			// Multiple overrides for the same resource type are combined.
+			override := resourceTypeOverrides[typeName]
+
+			if v, ok := tfMap["exclude_keys"].(*schema.Set); ok && v.Len() > 0 {
+				override.ExcludeKeys = override.ExcludeKeys.Merge(tftags.New(ctx, v.List()))
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+				override.Tags = override.Tags.Merge(tftags.New(ctx, v))
+			}
+
+			resourceTypeOverrides[typeName] = override
+		}
+	}
+
+	if len(tags) > 0 || len(resourceTypeOverrides) > 0 {
+		config := &tftags.DefaultConfig{
+			ExcludeResourceTypes: excludeResourceTypes,
+		}
+		if len(tags) > 0 {
+			config.Tags = tftags.New(ctx, tags)
 		}
+		if len(resourceTypeOverrides) > 0 {
+			config.ResourceTypeOverrides = resourceTypeOverrides
+		}
+
+		return config
 	}
 
 	return nil
 }
 
+func expandServiceRetries(_ context.Context, tfList []interface{}) (map[string]conns.ServiceRetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceRetriesPath := cty.GetAttrPath("service_retries")
+	serviceRetries := make(map[string]conns.ServiceRetryConfig)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		elementPath := serviceRetriesPath.IndexInt(i)
+
+		service := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Unsupported service %q.", service),
+			))
+			continue
+		}
+
+		if _, ok := serviceRetries[pkg]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				elementPath.GetAttr("service"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Retry configuration for service %q is specified more than once.", service),
+			))
+			continue
+		}
+
+		var v conns.ServiceRetryConfig
+
+		if n, ok := tfMap["max_attempts"].(int); ok {
+			v.MaxAttempts = n
+		}
+
+		if s, ok := tfMap["retry_mode"].(string); ok && s != "" {
+			mode, err := aws.ParseRetryMode(s)
+			if err != nil {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					elementPath.GetAttr("retry_mode"),
+					"Invalid Attribute Value",
+					err.Error(),
+				))
+				continue
+			}
+			v.RetryMode = mode
+		}
+
+		if n, ok := tfMap["token_bucket_rate_limiter_capacity"].(int); ok {
+			v.TokenBucketRateLimiterCapacity = n
+		}
+
+		serviceRetries[pkg] = v
+	}
+
+	return serviceRetries, diags
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
 
@@ -9,10 +9,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -307,6 +311,66 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDefaultTagsResourceTypes(t *testing.T) { //nolint:paralleltest
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	ctx := context.Background()
+	results := expandDefaultTags(ctx, map[string]interface{}{
+		"exclude_resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_autoscaling_group"}),
+		"resource_type_override": []interface{}{
+			map[string]interface{}{
+				"exclude_keys":  schema.NewSet(schema.HashString, []interface{}{"Name"}),
+				"resource_type": "aws_instance",
+				"tags":          map[string]interface{}{},
+			},
+			map[string]interface{}{
+				"exclude_keys":  schema.NewSet(schema.HashString, []interface{}{}),
+				"resource_type": "aws_instance",
+				"tags": map[string]interface{}{
+					"Owner": "instance-team",
+				},
+			},
+		},
+		"tags": map[string]interface{}{
+			"Name":  "default",
+			"Owner": "my-team",
+		},
+	})
+
+	testcases := map[string]struct {
+		typeName string
+		expected map[string]string
+	}{
+		"no override": {
+			typeName: "aws_vpc",
+			expected: map[string]string{
+				"Name":  "default",
+				"Owner": "my-team",
+			},
+		},
+		"excluded": {
+			typeName: "aws_autoscaling_group",
+		},
+		"overridden": {
+			typeName: "aws_instance",
+			expected: map[string]string{
+				"Owner": "instance-team",
+			},
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			got := results.ForResourceType(testcase.typeName).GetTags().Map()
+
+			if diff := cmp.Diff(testcase.expected, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected default tags diff: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
@@ -422,6 +486,139 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfList        []interface{}
+		expected      map[string]conns.ServiceRetryConfig
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfList:   []interface{}{},
+			expected: map[string]conns.ServiceRetryConfig{},
+		},
+		"single": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"max_attempts":                       10,
+					"retry_mode":                         "",
+					"service":                            "ec2",
+					"token_bucket_rate_limiter_capacity": 0,
+				},
+			},
+			expected: map[string]conns.ServiceRetryConfig{
+				names.EC2: {
+					MaxAttempts: 10,
+				},
+			},
+		},
+		"multiple": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"max_attempts":                       10,
+					"retry_mode":                         "adaptive",
+					"service":                            "ec2",
+					"token_bucket_rate_limiter_capacity": 0,
+				},
+				map[string]interface{}{
+					"max_attempts":                       0,
+					"retry_mode":                         "",
+					"service":                            "s3",
+					"token_bucket_rate_limiter_capacity": 1000,
+				},
+			},
+			expected: map[string]conns.ServiceRetryConfig{
+				names.EC2: {
+					MaxAttempts: 10,
+					RetryMode:   aws.RetryModeAdaptive,
+				},
+				names.S3: {
+					TokenBucketRateLimiterCapacity: 1000,
+				},
+			},
+		},
+		"alias": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"max_attempts":                       5,
+					"retry_mode":                         "",
+					"service":                            "cloudwatchlogs",
+					"token_bucket_rate_limiter_capacity": 0,
+				},
+			},
+			expected: map[string]conns.ServiceRetryConfig{
+				names.Logs: {
+					MaxAttempts: 5,
+				},
+			},
+		},
+		"unsupported service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"max_attempts":                       5,
+					"retry_mode":                         "",
+					"service":                            "notaservice",
+					"token_bucket_rate_limiter_capacity": 0,
+				},
+			},
+			expected: map[string]conns.ServiceRetryConfig{},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("service_retries").IndexInt(0).GetAttr("service"),
+					"Invalid Attribute Value",
+					`Unsupported service "notaservice".`,
+				),
+			},
+		},
+		"duplicate service": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"max_attempts":                       5,
+					"retry_mode":                         "",
+					"service":                            "ec2",
+					"token_bucket_rate_limiter_capacity": 0,
+				},
+				map[string]interface{}{
+					"max_attempts":                       10,
+					"retry_mode":                         "",
+					"service":                            "ec2",
+					"token_bucket_rate_limiter_capacity": 0,
+				},
+			},
+			expected: map[string]conns.ServiceRetryConfig{
+				names.EC2: {
+					MaxAttempts: 5,
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("service_retries").IndexInt(1).GetAttr("service"),
+					"Invalid Attribute Value",
+					`Retry configuration for service "ec2" is specified more than once.`,
+				),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandServiceRetries(ctx, testcase.tfList)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testcase.expected, results); diff != "" {
+				t.Errorf("Unexpected service_retries diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// isRegionOverrideEnabled returns whether per-resource Region override is supported
+// for the specified service package and resource schema.
+func isRegionOverrideEnabled(servicePackageName string, r *schema.Resource) bool {
+	// Resources of global services are not Regional.
+	if names.IsGlobal(servicePackageName) {
+		return false
+	}
+
+	// Some resources already define a `region` attribute with a different meaning.
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	return true
+}
+
+// addRegionAttribute adds the per-resource Region override attribute to the resource's schema.
+func addRegionAttribute(r *schema.Resource, attr *schema.Schema) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			s[names.AttrRegion] = attr
+			return s
+		}
+	} else {
+		r.Schema[names.AttrRegion] = attr
+	}
+}
+
+// regionDataSourceSchema returns the schema for the per-resource Region override data source attribute.
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceSchema returns the schema for the per-resource Region override resource attribute.
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// setRegionInPlan is a CustomizeDiff function that plans the provider's Region if no Region is configured.
+// Any change in the effective Region, including a change to the provider's Region, forces resource replacement.
+func setRegionInPlan(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsKnown() && !rawConfig.IsNull() {
+		if v := rawConfig.GetAttr(names.AttrRegion); v.IsKnown() && v.IsNull() {
+			if region := meta.(*conns.AWSClient).Region(ctx); d.Get(names.AttrRegion).(string) != region {
+				if err := d.SetNew(names.AttrRegion, region); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Any subsequent CustomizeDiff functions make API calls in the planned Region.
+	if inContext, ok := conns.FromContext(ctx); ok {
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			inContext.OverrideRegion = v
+		}
+	}
+
+	return nil
+}
+
+// regionInterceptor implements per-resource Region override for data sources and resources.
+// The configured (or previously recorded) Region is placed in Context before each operation
+// so that API clients are constructed for that Region, and the effective Region is recorded
+// in state after each successful Create, Read or Update.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	c := meta.(*conns.AWSClient)
+
+	switch when {
+	case Before:
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			inContext.OverrideRegion = v
+
+			if err := c.ValidateInContextRegionInPartition(ctx); err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Resource not found.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
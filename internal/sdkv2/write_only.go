@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// RawConfigGetter exposes the interface for reading values from a resource's raw configuration.
+// Implementations:
+// * schema.ResourceData
+type RawConfigGetter interface {
+	GetRawConfigAt(cty.Path) (cty.Value, diag.Diagnostics)
+}
+
+// GetWriteOnlyValue returns the value of the write-only attribute at the specified path.
+// Write-only attribute values are never persisted to plan or state and can only be read from configuration.
+func GetWriteOnlyValue(d RawConfigGetter, path cty.Path, attrType cty.Type) (cty.Value, diag.Diagnostics) {
+	v, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return cty.NilVal, diags
+	}
+
+	if !v.Type().Equals(attrType) {
+		return cty.NilVal, append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid write-only attribute type",
+			Detail:        "The write-only attribute has type " + v.Type().FriendlyName() + ", expected " + attrType.FriendlyName() + ".",
+			AttributePath: path,
+		})
+	}
+
+	return v, diags
+}
+
+// GetWriteOnlyStringValue returns the value of the string write-only attribute at the specified path.
+// An empty string is returned if the attribute is not configured.
+func GetWriteOnlyStringValue(d RawConfigGetter, path cty.Path) (string, diag.Diagnostics) {
+	v, diags := GetWriteOnlyValue(d, path, cty.String)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if v.IsNull() || !v.IsKnown() {
+		return "", diags
+	}
+
+	return v.AsString(), diags
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type mockRawConfigGetter struct {
+	config cty.Value
+}
+
+func (m mockRawConfigGetter) GetRawConfigAt(path cty.Path) (cty.Value, diag.Diagnostics) {
+	v, err := path.Apply(m.config)
+	if err != nil {
+		return cty.DynamicVal, diag.FromErr(err)
+	}
+
+	return v, nil
+}
+
+func TestGetWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config      cty.Value
+		expected    string
+		expectError bool
+	}{
+		"configured": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.StringVal("secret"),
+			}),
+			expected: "secret",
+		},
+		"null": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.NullVal(cty.String),
+			}),
+			expected: "",
+		},
+		"unknown": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.UnknownVal(cty.String),
+			}),
+			expected: "",
+		},
+		"wrong type": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.NumberIntVal(42),
+			}),
+			expectError: true,
+		},
+		"missing": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password": cty.StringVal("secret"),
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := GetWriteOnlyStringValue(mockRawConfigGetter{config: testCase.config}, cty.GetAttrPath("password_wo"))
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("got error %t, expected %t: %v", got, want, diags)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -22,8 +22,9 @@ func TestAccAccount_serial(t *testing.T) {
 			acctest.CtBasic: testAccPrimaryContact_basic,
 		},
 		"Region": {
-			acctest.CtBasic: testAccRegion_basic,
-			"AccountID":     testAccRegion_accountID,
+			acctest.CtBasic:    testAccRegion_basic,
+			"AccountID":        testAccRegion_accountID,
+			"EnabledByDefault": testAccRegion_enabledByDefault,
 		},
 	}
 
@@ -5,6 +5,7 @@ package account
 
 import (
 	"context"
+	"log"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -73,11 +75,11 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).AccountClient(ctx)
 
 	var id string
-	var err error
 	region := d.Get("region_name").(string)
 	accountID := ""
 	if v, ok := d.GetOk(names.AttrAccountID); ok {
 		accountID = v.(string)
+		var err error
 		id, err = flex.FlattenResourceId([]string{accountID, region}, regionResourceIDPartCount, false)
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -91,7 +93,29 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		timeout = d.Timeout(schema.TimeoutUpdate)
 	}
 
-	if v := d.Get(names.AttrEnabled).(bool); v {
+	output, err := findRegionOptStatus(ctx, conn, accountID, region)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Account Region (%s): %s", id, err)
+	}
+
+	// A previous enable or disable may still be in progress; let it settle before changing direction.
+	switch output.RegionOptStatus {
+	case types.RegionOptStatusEnabling:
+		output, err = waitRegionEnabled(ctx, conn, accountID, region, timeout)
+	case types.RegionOptStatusDisabling:
+		output, err = waitRegionDisabled(ctx, conn, accountID, region, timeout)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) opt status: %s", id, err)
+	}
+
+	status, enabled := output.RegionOptStatus, d.Get(names.AttrEnabled).(bool)
+	switch {
+	case enabled && (status == types.RegionOptStatusEnabled || status == types.RegionOptStatusEnabledByDefault):
+		// Already enabled.
+	case enabled:
 		input := account.EnableRegionInput{
 			RegionName: aws.String(region),
 		}
@@ -106,9 +130,13 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		if _, err := waitRegionEnabled(ctx, conn, accountID, region, timeout); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) enable: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) enable: %s", id, err)
 		}
-	} else {
+	case status == types.RegionOptStatusEnabledByDefault:
+		return sdkdiag.AppendErrorf(diags, "disabling Account Region (%s): Region is enabled by default and cannot be disabled", id)
+	case status == types.RegionOptStatusDisabled:
+		// Already disabled.
+	default:
 		input := account.DisableRegionInput{
 			RegionName: aws.String(region),
 		}
@@ -119,11 +147,11 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		_, err := conn.DisableRegion(ctx, &input)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "enabling Account Region (%s): %s", id, err)
+			return sdkdiag.AppendErrorf(diags, "disabling Account Region (%s): %s", id, err)
 		}
 
 		if _, err := waitRegionDisabled(ctx, conn, accountID, region, timeout); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) disable: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) disable: %s", id, err)
 		}
 	}
 
@@ -153,6 +181,12 @@ func resourceRegionRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	output, err := findRegionOptStatus(ctx, conn, accountID, region)
 
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Account Region %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Account Region (%s): %s", d.Id(), err)
 	}
@@ -175,6 +209,13 @@ func findRegionOptStatus(ctx context.Context, conn *account.Client, accountID, r
 
 	output, err := conn.GetRegionOptStatus(ctx, &input)
 
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
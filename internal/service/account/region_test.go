@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/account/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -102,6 +103,36 @@ func testAccRegion_accountID(t *testing.T) { // nosemgrep:ci.account-in-func-nam
 	})
 }
 
+func testAccRegion_enabledByDefault(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_account_region.test"
+	regionName := endpoints.UsEast1RegionID
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartition(t, endpoints.AwsPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccountServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionConfig_basic(regionName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", string(types.RegionOptStatusEnabledByDefault)),
+					resource.TestCheckResourceAttr(resourceName, "region_name", regionName),
+				),
+			},
+			{
+				Config:      testAccRegionConfig_basic(regionName, false),
+				ExpectError: regexache.MustCompile(`Region is enabled by default and cannot be disabled`),
+			},
+		},
+	})
+}
+
 func testAccPreCheckRegionDisabled(ctx context.Context, t *testing.T, region string) {
 	t.Helper()
 
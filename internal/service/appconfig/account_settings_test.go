@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappconfig "github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppConfigAccountSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic: testAccAccountSettings_basic,
+		"update":        testAccAccountSettings_update,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAccountSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appconfig_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AppConfigEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection.0.protection_period_in_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccountSettings_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appconfig_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AppConfigEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_protectionPeriod(true, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection.0.protection_period_in_minutes", "30"),
+				),
+			},
+			{
+				Config: testAccAccountSettingsConfig_protectionPeriod(false, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection.0.enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection.0.protection_period_in_minutes", "120"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
+
+		output, err := tfappconfig.FindAccountSettings(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if aws.ToBool(output.DeletionProtection.Enabled) {
+			return errors.New("AppConfig Account Settings deletion protection still enabled")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccountSettingsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
+
+		_, err := tfappconfig.FindAccountSettings(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccAccountSettingsConfig_basic() string {
+	return `
+resource "aws_appconfig_account_settings" "test" {
+  deletion_protection {
+    enabled = true
+  }
+}
+`
+}
+
+func testAccAccountSettingsConfig_protectionPeriod(enabled bool, period int) string {
+	return fmt.Sprintf(`
+resource "aws_appconfig_account_settings" "test" {
+  deletion_protection {
+    enabled                      = %[1]t
+    protection_period_in_minutes = %[2]d
+  }
+}
+`, enabled, period)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_appconfig_extension_associations", name="Extension Associations")
+func DataSourceExtensionAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceExtensionAssociationsRead,
+		Schema: map[string]*schema.Schema{
+			"extension_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"extension_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"extension_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"extension_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"extension_arn"},
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrResourceARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+const (
+	DSNameExtensionAssociations = "Extension Associations Data Source"
+)
+
+func dataSourceExtensionAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	input := &appconfig.ListExtensionAssociationsInput{}
+
+	if v, ok := d.GetOk("extension_arn"); ok {
+		input.ExtensionIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("extension_version"); ok {
+		input.ExtensionVersionNumber = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk(names.AttrResourceARN); ok {
+		input.ResourceIdentifier = aws.String(v.(string))
+	}
+
+	out, err := findExtensionAssociations(ctx, conn, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.AppConfig, create.ErrActionReading, DSNameExtensionAssociations, "", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+
+	var ids []string
+	var associations []interface{}
+	for _, v := range out {
+		ids = append(ids, aws.ToString(v.Id))
+		associations = append(associations, map[string]interface{}{
+			"extension_arn":       aws.ToString(v.ExtensionArn),
+			names.AttrID:          aws.ToString(v.Id),
+			names.AttrResourceARN: aws.ToString(v.ResourceArn),
+		})
+	}
+	if err := d.Set("extension_associations", associations); err != nil {
+		return create.AppendDiagError(diags, names.AppConfig, create.ErrActionSetting, DSNameExtensionAssociations, d.Id(), err)
+	}
+	d.Set(names.AttrIDs, ids)
+
+	return diags
+}
+
+func findExtensionAssociations(ctx context.Context, conn *appconfig.Client, input *appconfig.ListExtensionAssociationsInput) ([]awstypes.ExtensionAssociationSummary, error) {
+	var outputs []awstypes.ExtensionAssociationSummary
+
+	pages := appconfig.NewListExtensionAssociationsPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, page.Items...)
+	}
+
+	return outputs, nil
+}
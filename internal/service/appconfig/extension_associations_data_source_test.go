@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppConfigExtensionAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_appconfig_extension_associations.test"
+	resourceName := "aws_appconfig_extension_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AppConfigEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExtensionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionAssociationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "extension_associations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "extension_associations.0.extension_arn", resourceName, "extension_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "extension_associations.0.id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "extension_associations.0.resource_arn", resourceName, names.AttrResourceARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccExtensionAssociationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccExtensionAssociationConfig_name(rName),
+		`
+data "aws_appconfig_extension_associations" "test" {
+  resource_arn = aws_appconfig_extension_association.test.resource_arn
+}
+`)
+}
@@ -5,6 +5,7 @@ package appconfig
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -52,10 +53,11 @@ func ResourceHostedConfigurationVersion() *schema.Resource {
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9a-z]{4,7}`), ""),
 			},
 			names.AttrContent: {
-				Type:      schema.TypeString,
-				Required:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressEquivalentFeatureFlagsContent,
 			},
 			names.AttrContentType: {
 				Type:         schema.TypeString,
@@ -199,3 +201,60 @@ func HostedConfigurationVersionParseID(id string) (string, string, int32, error)
 
 	return parts[0], parts[1], int32(version), nil
 }
+
+// AppConfig adds "_createdAt" and "_updatedAt" metadata to each flag, flag value and
+// flag variant in AWS.AppConfig.FeatureFlags content.
+var featureFlagsContentMetadataKeys = []string{"_createdAt", "_updatedAt"}
+
+func suppressEquivalentFeatureFlagsContent(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get(names.AttrContentType).(string) != "application/json" {
+		return false
+	}
+
+	oldContent, err := normalizeFeatureFlagsContent(old)
+	if err != nil {
+		return false
+	}
+
+	newContent, err := normalizeFeatureFlagsContent(new)
+	if err != nil {
+		return false
+	}
+
+	return oldContent == newContent
+}
+
+// normalizeFeatureFlagsContent returns the canonical JSON form of the specified content
+// with all service-managed feature flag metadata removed.
+func normalizeFeatureFlagsContent(content string) (string, error) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(content), &v); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(removeFeatureFlagsContentMetadata(v))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func removeFeatureFlagsContentMetadata(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, key := range featureFlagsContentMetadataKeys {
+			delete(v, key)
+		}
+		for key, value := range v {
+			v[key] = removeFeatureFlagsContentMetadata(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = removeFeatureFlagsContentMetadata(value)
+		}
+	}
+
+	return v
+}
@@ -22,6 +22,57 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestNormalizeFeatureFlagsContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configured string
+		returned   string
+		equivalent bool
+	}{
+		"not feature flags": {
+			configured: `{"foo":"bar"}`,
+			returned:   `{"foo":"bar"}`,
+			equivalent: true,
+		},
+		"single variant metadata": {
+			configured: `{"flags":{"a":{"name":"a"}},"values":{"a":{"enabled":true}},"version":"1"}`,
+			returned:   `{"flags":{"a":{"name":"a","_createdAt":"2024-11-01T00:00:00Z","_updatedAt":"2024-11-01T00:00:00Z"}},"values":{"a":{"enabled":true,"_createdAt":"2024-11-01T00:00:00Z","_updatedAt":"2024-11-01T00:00:00Z"}},"version":"1"}`,
+			equivalent: true,
+		},
+		"multi-variant metadata": {
+			configured: `{"flags":{"a":{"name":"a"}},"values":{"a":{"_variants":[{"name":"v1","enabled":true,"rule":"(eq $x 1)"},{"name":"default","enabled":false}]}},"version":"1"}`,
+			returned:   `{"flags":{"a":{"name":"a","_createdAt":"2024-11-01T00:00:00Z"}},"values":{"a":{"_variants":[{"name":"v1","enabled":true,"rule":"(eq $x 1)","_updatedAt":"2024-11-01T00:00:00Z"},{"name":"default","enabled":false}]}},"version":"1"}`,
+			equivalent: true,
+		},
+		"variant changed": {
+			configured: `{"values":{"a":{"_variants":[{"name":"v1","enabled":true}]}}}`,
+			returned:   `{"values":{"a":{"_variants":[{"name":"v1","enabled":false,"_createdAt":"2024-11-01T00:00:00Z"}]}}}`,
+			equivalent: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			configured, err := tfappconfig.NormalizeFeatureFlagsContent(testCase.configured)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			returned, err := tfappconfig.NormalizeFeatureFlagsContent(testCase.returned)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := configured == returned, testCase.equivalent; got != want {
+				t.Errorf("equivalent = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
 func TestAccAppConfigHostedConfigurationVersion_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -55,6 +106,40 @@ func TestAccAppConfigHostedConfigurationVersion_basic(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigHostedConfigurationVersion_featureFlagsMultiVariant(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_hosted_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedConfigurationVersionConfig_featureFlagsMultiVariant(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHostedConfigurationVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrContentType, "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				Config:   testAccHostedConfigurationVersionConfig_featureFlagsMultiVariant(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The service adds flag metadata to the returned content.
+				ImportStateVerifyIgnore: []string{names.AttrContent},
+			},
+		},
+	})
+}
+
 func TestAccAppConfigHostedConfigurationVersion_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -172,3 +257,63 @@ resource "aws_appconfig_hosted_configuration_version" "test" {
 }
 `, rName))
 }
+
+func testAccHostedConfigurationVersionConfig_featureFlagsMultiVariant(rName string) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_name(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+  type           = "AWS.AppConfig.FeatureFlags"
+}
+
+resource "aws_appconfig_hosted_configuration_version" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  content_type             = "application/json"
+
+  content = jsonencode({
+    flags = {
+      checkout = {
+        name = "checkout"
+        attributes = {
+          color = {
+            constraints = {
+              type     = "string"
+              required = true
+              enum     = ["blue", "green"]
+            }
+          }
+        }
+      }
+    }
+    values = {
+      checkout = {
+        _variants = [
+          {
+            name    = "beta"
+            enabled = true
+            rule    = "(eq $tier \"beta\")"
+            attributeValues = {
+              color = "green"
+            }
+          },
+          {
+            name    = "default"
+            enabled = false
+            attributeValues = {
+              color = "blue"
+            }
+          },
+        ]
+      }
+    }
+    version = "1"
+  })
+
+  description = %[1]q
+}
+`, rName))
+}
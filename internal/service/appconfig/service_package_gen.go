@@ -20,6 +20,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAccountSettingsResource,
+			Name:    "Account Settings",
+		},
 		{
 			Factory: newResourceEnvironment,
 			Name:    "Environment",
@@ -58,6 +62,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_appconfig_environments",
 			Name:     "Environments",
 		},
+		{
+			Factory:  DataSourceExtensionAssociations,
+			TypeName: "aws_appconfig_extension_associations",
+			Name:     "Extension Associations",
+		},
 	}
 }
 
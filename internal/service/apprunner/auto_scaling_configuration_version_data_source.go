@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_apprunner_auto_scaling_configuration_version", name="AutoScaling Configuration Version")
+// @Tags(identifierAttribute="arn")
+func newAutoScalingConfigurationVersionDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &autoScalingConfigurationVersionDataSource{}, nil
+}
+
+type autoScalingConfigurationVersionDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*autoScalingConfigurationVersionDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_apprunner_auto_scaling_configuration_version"
+}
+
+func (d *autoScalingConfigurationVersionDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"auto_scaling_configuration_name": schema.StringAttribute{
+				Required: true,
+			},
+			"auto_scaling_configuration_revision": schema.Int64Attribute{
+				Computed: true,
+			},
+			"has_associated_service": schema.BoolAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"is_default": schema.BoolAttribute{
+				Computed: true,
+			},
+			"latest": schema.BoolAttribute{
+				Computed: true,
+			},
+			"max_concurrency": schema.Int64Attribute{
+				Computed: true,
+			},
+			"max_size": schema.Int64Attribute{
+				Computed: true,
+			},
+			"min_size": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *autoScalingConfigurationVersionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data autoScalingConfigurationVersionDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AppRunnerClient(ctx)
+
+	name := data.AutoScalingConfigurationName.ValueString()
+	config, err := findLatestAutoScalingConfigurationByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading App Runner AutoScaling Configuration Version (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, config, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, config.AutoScalingConfigurationArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findLatestAutoScalingConfigurationByName returns the latest active revision of the named auto scaling configuration.
+func findLatestAutoScalingConfigurationByName(ctx context.Context, conn *apprunner.Client, name string) (*awstypes.AutoScalingConfiguration, error) {
+	input := &apprunner.ListAutoScalingConfigurationsInput{
+		AutoScalingConfigurationName: aws.String(name),
+		LatestOnly:                   true,
+	}
+
+	summary, err := findAutoScalingConfigurationSummary(ctx, conn, input, func(v *awstypes.AutoScalingConfigurationSummary) bool {
+		return string(v.Status) == autoScalingConfigurationStatusActive
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findAutoScalingConfigurationByARN(ctx, conn, aws.ToString(summary.AutoScalingConfigurationArn))
+}
+
+type autoScalingConfigurationVersionDataSourceModel struct {
+	AutoScalingConfigurationARN      types.String `tfsdk:"arn"`
+	AutoScalingConfigurationName     types.String `tfsdk:"auto_scaling_configuration_name"`
+	AutoScalingConfigurationRevision types.Int64  `tfsdk:"auto_scaling_configuration_revision"`
+	HasAssociatedService             types.Bool   `tfsdk:"has_associated_service"`
+	ID                               types.String `tfsdk:"id"`
+	IsDefault                        types.Bool   `tfsdk:"is_default"`
+	Latest                           types.Bool   `tfsdk:"latest"`
+	MaxConcurrency                   types.Int64  `tfsdk:"max_concurrency"`
+	MaxSize                          types.Int64  `tfsdk:"max_size"`
+	MinSize                          types.Int64  `tfsdk:"min_size"`
+	Status                           types.String `tfsdk:"status"`
+	Tags                             tftags.Map   `tfsdk:"tags"`
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppRunnerAutoScalingConfigurationVersionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_apprunner_auto_scaling_configuration_version.test"
+	resourceName := "aws_apprunner_auto_scaling_configuration_version.other"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppRunnerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutoScalingConfigurationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoScalingConfigurationVersionDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_configuration_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_configuration_revision", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "has_associated_service", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "is_default", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "latest", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_concurrency", resourceName, "max_concurrency"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_size", resourceName, "max_size"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "min_size", resourceName, "min_size"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "active"),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(dataSourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutoScalingConfigurationVersionDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_name = %[1]q
+}
+
+resource "aws_apprunner_auto_scaling_configuration_version" "other" {
+  auto_scaling_configuration_name = aws_apprunner_auto_scaling_configuration_version.test.auto_scaling_configuration_name
+
+  max_concurrency = 125
+  max_size        = 20
+
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_apprunner_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_name = aws_apprunner_auto_scaling_configuration_version.other.auto_scaling_configuration_name
+
+  depends_on = [aws_apprunner_auto_scaling_configuration_version.other]
+}
+`, rName)
+}
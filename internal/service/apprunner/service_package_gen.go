@@ -16,6 +16,13 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newAutoScalingConfigurationVersionDataSource,
+			Name:    "AutoScaling Configuration Version",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory: newHostedZoneIDDataSource,
 			Name:    "Hosted Zone ID",
@@ -38,3 +38,15 @@ func reportSettingTemplate_Values() []string {
 		reportSettingTemplateRestoreJobReport,
 	}
 }
+
+const (
+	indexActionResourceTypeEBS = "EBS"
+	indexActionResourceTypeS3  = "S3"
+)
+
+func indexActionResourceType_Values() []string {
+	return []string{
+		indexActionResourceTypeEBS,
+		indexActionResourceTypeS3,
+	}
+}
@@ -145,6 +145,35 @@ func TestAccBackupLogicallyAirGappedVault_tags(t *testing.T) {
 	})
 }
 
+func TestAccBackupLogicallyAirGappedVault_ramResourceShare(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v backup.DescribeBackupVaultOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_logically_air_gapped_vault.test"
+	associationResourceName := "aws_ram_resource_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BackupEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogicallyAirGappedVaultDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogicallyAirGappedVaultConfig_ramResourceShare(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogicallyAirGappedVaultExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(associationResourceName, names.AttrResourceARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(associationResourceName, "resource_share_arn", "aws_ram_resource_share.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLogicallyAirGappedVaultDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupClient(ctx)
@@ -230,3 +259,23 @@ resource "aws_backup_logically_air_gapped_vault" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccLogicallyAirGappedVaultConfig_ramResourceShare(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_logically_air_gapped_vault" "test" {
+  name               = %[1]q
+  max_retention_days = 7
+  min_retention_days = 7
+}
+
+resource "aws_ram_resource_share" "test" {
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_resource_association" "test" {
+  resource_arn       = aws_backup_logically_air_gapped_vault.test.arn
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+`, rName)
+}
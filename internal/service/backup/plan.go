@@ -133,6 +133,24 @@ func resourcePlan() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"index_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_types": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(indexActionResourceType_Values(), false),
+										},
+									},
+								},
+							},
+						},
 						"lifecycle": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -351,6 +369,9 @@ func expandBackupRuleInputs(ctx context.Context, tfList []interface{}) []awstype
 		if v, ok := tfMap["enable_continuous_backup"].(bool); ok {
 			apiObject.EnableContinuousBackup = aws.Bool(v)
 		}
+		if v, ok := tfMap["index_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.IndexActions = expandIndexActions(v)
+		}
 		if v, ok := tfMap["lifecycle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 			apiObject.Lifecycle = expandLifecycle(v[0].(map[string]interface{}))
 		}
@@ -430,6 +451,27 @@ func expandCopyActions(tfList []interface{}) []awstypes.CopyAction {
 	return apiObjects
 }
 
+func expandIndexActions(tfList []interface{}) []awstypes.IndexAction {
+	apiObjects := []awstypes.IndexAction{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.IndexAction{}
+
+		if v, ok := tfMap["resource_types"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ResourceTypes = flex.ExpandStringValueList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandLifecycle(tfMap map[string]interface{}) *awstypes.Lifecycle {
 	if tfMap == nil {
 		return nil
@@ -470,6 +512,10 @@ func flattenBackupRules(ctx context.Context, apiObjects []awstypes.BackupRule) [
 			tfMap["copy_action"] = flattenCopyActions(v)
 		}
 
+		if v := apiObject.IndexActions; len(v) > 0 {
+			tfMap["index_action"] = flattenIndexActions(v)
+		}
+
 		if v := apiObject.Lifecycle; v != nil {
 			tfMap["lifecycle"] = flattenLifecycle(v)
 		}
@@ -521,6 +567,24 @@ func flattenCopyActions(apiObjects []awstypes.CopyAction) []interface{} {
 	return tfList
 }
 
+func flattenIndexActions(apiObjects []awstypes.IndexAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"resource_types": apiObject.ResourceTypes,
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenLifecycle(apiObject *awstypes.Lifecycle) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
@@ -622,6 +622,51 @@ func TestAccBackupPlan_enableContinuousBackup(t *testing.T) {
 	})
 }
 
+func TestAccBackupPlan_indexAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	var plan backup.GetBackupPlanOutput
+	resourceName := "aws_backup_plan.test"
+	rName := fmt.Sprintf("tf-testacc-backup-%s", sdkacctest.RandString(14))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_indexAction(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &plan),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"rule_name":                       rName,
+						"index_action.#":                  "1",
+						"index_action.0.resource_types.#": "1",
+						"index_action.0.resource_types.0": "EBS",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlanConfig_enableContinuous(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName, &plan),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"rule_name":      rName,
+						"index_action.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBackupPlan_upgradeScheduleExpressionTimezone(t *testing.T) {
 	ctx := acctest.Context(t)
 	var plan backup.GetBackupPlanOutput
@@ -1191,6 +1236,32 @@ resource "aws_backup_plan" "test" {
 `, rName)
 }
 
+func testAccPlanConfig_indexAction(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_plan" "test" {
+  name = %[1]q
+
+  rule {
+    rule_name         = %[1]q
+    target_vault_name = aws_backup_vault.test.name
+    schedule          = "cron(0 12 * * ? *)"
+
+    index_action {
+      resource_types = ["EBS"]
+    }
+
+    lifecycle {
+      delete_after = 35
+    }
+  }
+}
+`, rName)
+}
+
 func testAccPlanConfig_scheduleExpressionTimezone(rName, scheduleExpressionTimezone string) string {
 	return fmt.Sprintf(`
 resource "aws_backup_vault" "test" {
@@ -291,6 +291,7 @@ func (r *restoreTestingSelectionResource) Update(ctx context.Context, request re
 	conn := r.Meta().BackupClient(ctx)
 
 	if !old.IAMRoleARN.Equal(new.IAMRoleARN) ||
+		!old.ProtectedResourceARNs.Equal(new.ProtectedResourceARNs) ||
 		!old.ProtectedResourceConditions.Equal(new.ProtectedResourceConditions) ||
 		!old.RestoreMetadataOverrides.Equal(new.RestoreMetadataOverrides) ||
 		!old.ValidationWindowHours.Equal(new.ValidationWindowHours) {
@@ -5,12 +5,15 @@ package backup
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,6 +28,7 @@ func resourceVaultLockConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultLockConfigurationCreate,
 		ReadWithoutTimeout:   resourceVaultLockConfigurationRead,
+		UpdateWithoutTimeout: schema.NoopContext,
 		DeleteWithoutTimeout: resourceVaultLockConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -43,10 +47,22 @@ func resourceVaultLockConfiguration() *schema.Resource {
 				Computed: true,
 			},
 			"changeable_for_days": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IntAtLeast(3),
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateVaultLockChangeableForDays,
+			},
+			"confirm_compliance_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"lock_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
 			},
 			"max_retention_days": {
 				Type:     schema.TypeInt,
@@ -59,6 +75,8 @@ func resourceVaultLockConfiguration() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: resourceVaultLockConfigurationCustomizeDiff,
 	}
 }
 
@@ -112,6 +130,12 @@ func resourceVaultLockConfigurationRead(ctx context.Context, d *schema.ResourceD
 
 	d.Set("backup_vault_arn", output.BackupVaultArn)
 	d.Set("backup_vault_name", output.BackupVaultName)
+	if output.LockDate != nil {
+		d.Set("lock_date", aws.ToTime(output.LockDate).Format(time.RFC3339))
+	} else {
+		d.Set("lock_date", nil)
+	}
+	d.Set("locked", output.Locked)
 	d.Set("max_retention_days", output.MaxRetentionDays)
 	d.Set("min_retention_days", output.MinRetentionDays)
 
@@ -137,3 +161,37 @@ func resourceVaultLockConfigurationDelete(ctx context.Context, d *schema.Resourc
 
 	return diags
 }
+
+func resourceVaultLockConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Setting changeable_for_days puts the vault lock into compliance mode.
+	// Once the grace time has passed the lock can never be changed or removed,
+	// so require an explicit opt-in whenever a new compliance-mode lock is planned.
+	if d.Id() != "" && !d.HasChange("changeable_for_days") {
+		return nil
+	}
+
+	if d.GetRawConfig().GetAttr("changeable_for_days").IsNull() {
+		return nil
+	}
+
+	if !d.Get("confirm_compliance_mode").(bool) {
+		return fmt.Errorf("setting changeable_for_days applies a compliance-mode vault lock to Backup Vault (%s) that becomes immutable once the grace time has elapsed; set confirm_compliance_mode to true to proceed", d.Get("backup_vault_name").(string))
+	}
+
+	return nil
+}
+
+func validateVaultLockChangeableForDays(v interface{}, path cty.Path) diag.Diagnostics {
+	diags := validation.ToDiagFunc(validation.IntAtLeast(3))(v, path)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       "Backup Vault Lock in compliance mode",
+		Detail:        fmt.Sprintf("Setting changeable_for_days applies the vault lock in compliance mode. After %d days the lock configuration can no longer be changed or deleted by any user or by AWS, and recovery points cannot be deleted before their retention period expires.", v.(int)),
+		AttributePath: path,
+	})
+}
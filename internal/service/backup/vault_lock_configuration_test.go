@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -36,6 +37,9 @@ func TestAccBackupVaultLockConfiguration_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVaultLockConfigurationExists(ctx, resourceName, &vault),
 					resource.TestCheckResourceAttr(resourceName, "changeable_for_days", "3"),
+					resource.TestCheckResourceAttr(resourceName, "confirm_compliance_mode", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "lock_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "locked"),
 					resource.TestCheckResourceAttr(resourceName, "max_retention_days", "1200"),
 					resource.TestCheckResourceAttr(resourceName, "min_retention_days", "7"),
 				),
@@ -45,7 +49,57 @@ func TestAccBackupVaultLockConfiguration_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// These are not returned by the API
-				ImportStateVerifyIgnore: []string{"changeable_for_days"},
+				ImportStateVerifyIgnore: []string{"changeable_for_days", "confirm_compliance_mode"},
+			},
+		},
+	})
+}
+
+func TestAccBackupVaultLockConfiguration_governanceMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vault backup.DescribeBackupVaultOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_backup_vault_lock_configuration.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultLockConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultLockConfigurationConfig_governanceMode(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockConfigurationExists(ctx, resourceName, &vault),
+					resource.TestCheckNoResourceAttr(resourceName, "changeable_for_days"),
+					resource.TestCheckResourceAttr(resourceName, "lock_date", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "locked"),
+					resource.TestCheckResourceAttr(resourceName, "max_retention_days", "1200"),
+					resource.TestCheckResourceAttr(resourceName, "min_retention_days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBackupVaultLockConfiguration_complianceModeNotConfirmed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultLockConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVaultLockConfigurationConfig_complianceModeNotConfirmed(rName),
+				ExpectError: regexache.MustCompile(`set confirm_compliance_mode to true to proceed`),
 			},
 		},
 	})
@@ -127,6 +181,36 @@ resource "aws_backup_vault" "test" {
   name = %[1]q
 }
 
+resource "aws_backup_vault_lock_configuration" "test" {
+  backup_vault_name       = aws_backup_vault.test.name
+  changeable_for_days     = 3
+  confirm_compliance_mode = true
+  max_retention_days      = 1200
+  min_retention_days      = 7
+}
+`, rName)
+}
+
+func testAccVaultLockConfigurationConfig_governanceMode(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_backup_vault_lock_configuration" "test" {
+  backup_vault_name  = aws_backup_vault.test.name
+  max_retention_days = 1200
+  min_retention_days = 7
+}
+`, rName)
+}
+
+func testAccVaultLockConfigurationConfig_complianceModeNotConfirmed(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
 resource "aws_backup_vault_lock_configuration" "test" {
   backup_vault_name   = aws_backup_vault.test.name
   changeable_for_days = 3
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_analysis_template")
+// @Tags(identifierAttribute="arn")
+func ResourceAnalysisTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnalysisTemplateCreate,
+		ReadWithoutTimeout:   resourceAnalysisTemplateRead,
+		UpdateWithoutTimeout: resourceAnalysisTemplateUpdate,
+		DeleteWithoutTimeout: resourceAnalysisTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_parameter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDefaultValue: {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.ParameterType](),
+						},
+					},
+				},
+			},
+			"analysis_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collaboration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrFormat: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          string(types.AnalysisFormatSql),
+				ValidateDiagFunc: enum.Validate[types.AnalysisFormat](),
+			},
+			"membership_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"referenced_tables": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrSource: {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"text": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameAnalysisTemplate = "Analysis Template"
+
+	analysisTemplateIDPartCount = 2
+)
+
+func resourceAnalysisTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID := d.Get("membership_identifier").(string)
+	input := &cleanrooms.CreateAnalysisTemplateInput{
+		Format:               types.AnalysisFormat(d.Get(names.AttrFormat).(string)),
+		MembershipIdentifier: aws.String(membershipID),
+		Name:                 aws.String(d.Get(names.AttrName).(string)),
+		Source:               expandAnalysisSource(d.Get(names.AttrSource).([]interface{})),
+		Tags:                 getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("analysis_parameter"); ok {
+		input.AnalysisParameters = expandAnalysisParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateAnalysisTemplate(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, d.Get(names.AttrName).(string), err)
+	}
+
+	if out == nil || out.AnalysisTemplate == nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, d.Get(names.AttrName).(string), errors.New("empty output"))
+	}
+
+	id, err := flex.FlattenResourceId([]string{membershipID, aws.ToString(out.AnalysisTemplate.Id)}, analysisTemplateIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, d.Get(names.AttrName).(string), err)
+	}
+	d.SetId(id)
+
+	return append(diags, resourceAnalysisTemplateRead(ctx, d, meta)...)
+}
+
+func resourceAnalysisTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), analysisTemplateIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameAnalysisTemplate, d.Id(), err)
+	}
+	membershipID, analysisTemplateID := parts[0], parts[1]
+
+	out, err := findAnalysisTemplateByTwoPartKey(ctx, conn, membershipID, analysisTemplateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Analysis Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameAnalysisTemplate, d.Id(), err)
+	}
+
+	analysisTemplate := out.AnalysisTemplate
+	if err := d.Set("analysis_parameter", flattenAnalysisParameters(analysisTemplate.AnalysisParameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting analysis_parameter: %s", err)
+	}
+	d.Set("analysis_template_id", analysisTemplate.Id)
+	d.Set(names.AttrARN, analysisTemplate.Arn)
+	d.Set("collaboration_arn", analysisTemplate.CollaborationArn)
+	d.Set("collaboration_id", analysisTemplate.CollaborationId)
+	d.Set(names.AttrCreateTime, analysisTemplate.CreateTime.String())
+	d.Set(names.AttrDescription, analysisTemplate.Description)
+	d.Set(names.AttrFormat, analysisTemplate.Format)
+	d.Set("membership_arn", analysisTemplate.MembershipArn)
+	d.Set("membership_identifier", analysisTemplate.MembershipId)
+	d.Set(names.AttrName, analysisTemplate.Name)
+	if analysisTemplate.Schema != nil {
+		d.Set("referenced_tables", analysisTemplate.Schema.ReferencedTables)
+	} else {
+		d.Set("referenced_tables", nil)
+	}
+	if err := d.Set(names.AttrSource, flattenAnalysisSource(analysisTemplate.Source)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	d.Set("update_time", analysisTemplate.UpdateTime.String())
+
+	return diags
+}
+
+func resourceAnalysisTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		parts, err := flex.ExpandResourceId(d.Id(), analysisTemplateIDPartCount, false)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameAnalysisTemplate, d.Id(), err)
+		}
+
+		input := &cleanrooms.UpdateAnalysisTemplateInput{
+			AnalysisTemplateIdentifier: aws.String(parts[1]),
+			Description:                aws.String(d.Get(names.AttrDescription).(string)),
+			MembershipIdentifier:       aws.String(parts[0]),
+		}
+
+		_, err = conn.UpdateAnalysisTemplate(ctx, input)
+		if err != nil {
+			return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameAnalysisTemplate, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAnalysisTemplateRead(ctx, d, meta)...)
+}
+
+func resourceAnalysisTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), analysisTemplateIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameAnalysisTemplate, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Analysis Template %s", d.Id())
+	_, err = conn.DeleteAnalysisTemplate(ctx, &cleanrooms.DeleteAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(parts[1]),
+		MembershipIdentifier:       aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameAnalysisTemplate, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAnalysisTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, analysisTemplateID string) (*cleanrooms.GetAnalysisTemplateOutput, error) {
+	in := &cleanrooms.GetAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(analysisTemplateID),
+		MembershipIdentifier:       aws.String(membershipID),
+	}
+
+	out, err := conn.GetAnalysisTemplate(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandAnalysisSource(data []interface{}) types.AnalysisSource {
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	source := data[0].(map[string]interface{})
+	return &types.AnalysisSourceMemberText{
+		Value: source["text"].(string),
+	}
+}
+
+func expandAnalysisParameters(data []interface{}) []types.AnalysisParameter {
+	parameters := []types.AnalysisParameter{}
+	for _, v := range data {
+		parameterMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		parameter := types.AnalysisParameter{
+			Name: aws.String(parameterMap[names.AttrName].(string)),
+			Type: types.ParameterType(parameterMap[names.AttrType].(string)),
+		}
+
+		if v, ok := parameterMap[names.AttrDefaultValue].(string); ok && v != "" {
+			parameter.DefaultValue = aws.String(v)
+		}
+
+		parameters = append(parameters, parameter)
+	}
+	return parameters
+}
+
+func flattenAnalysisSource(source types.AnalysisSource) []interface{} {
+	switch v := source.(type) {
+	case *types.AnalysisSourceMemberText:
+		m := map[string]interface{}{
+			"text": v.Value,
+		}
+		return []interface{}{m}
+	default:
+		return nil
+	}
+}
+
+func flattenAnalysisParameters(parameters []types.AnalysisParameter) []interface{} {
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	tfList := []interface{}{}
+	for _, v := range parameters {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrDefaultValue: aws.ToString(v.DefaultValue),
+			names.AttrName:         aws.ToString(v.Name),
+			names.AttrType:         string(v.Type),
+		})
+	}
+	return tfList
+}
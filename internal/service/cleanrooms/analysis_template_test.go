@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsAnalysisTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	membershipID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_MEMBERSHIP_ID")
+
+	var analysisTemplate cleanrooms.GetAnalysisTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, membershipID, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &analysisTemplate),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.0.name", "limit"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.0.type", "INTEGER"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_parameter.0.default_value", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "analysis_template_id"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "collaboration_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "desc"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "SQL"),
+					resource.TestCheckResourceAttr(resourceName, "membership_identifier", membershipID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, membershipID, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &analysisTemplate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsAnalysisTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	membershipID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_MEMBERSHIP_ID")
+
+	var analysisTemplate cleanrooms.GetAnalysisTemplateOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_analysis_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnalysisTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisTemplateConfig_basic(rName, membershipID, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisTemplateExists(ctx, resourceName, &analysisTemplate),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceAnalysisTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAnalysisTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_analysis_template" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindAnalysisTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameAnalysisTemplate, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAnalysisTemplateExists(ctx context.Context, name string, analysisTemplate *cleanrooms.GetAnalysisTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, name, errors.New("not found"))
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := tfcleanrooms.FindAnalysisTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameAnalysisTemplate, rs.Primary.ID, err)
+		}
+
+		*analysisTemplate = *resp
+
+		return nil
+	}
+}
+
+func testAccAnalysisTemplateConfig_basic(rName, membershipID, description string) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_analysis_template" "test" {
+  name                  = %[1]q
+  description           = %[3]q
+  membership_identifier = %[2]q
+  format                = "SQL"
+
+  source {
+    text = "SELECT 1 LIMIT :limit"
+  }
+
+  analysis_parameter {
+    name          = "limit"
+    type          = "INTEGER"
+    default_value = "10"
+  }
+}
+`, rName, membershipID, description)
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cleanrooms_configured_table_association_analysis_rule")
+func ResourceConfiguredTableAssociationAnalysisRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfiguredTableAssociationAnalysisRuleCreate,
+		ReadWithoutTimeout:   resourceConfiguredTableAssociationAnalysisRuleRead,
+		UpdateWithoutTimeout: resourceConfiguredTableAssociationAnalysisRuleUpdate,
+		DeleteWithoutTimeout: resourceConfiguredTableAssociationAnalysisRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_additional_analyses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"allowed_result_receivers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"analysis_rule_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ConfiguredTableAssociationAnalysisRuleType](),
+			},
+			"configured_table_association_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configured_table_association_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrCreateTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"membership_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameConfiguredTableAssociationAnalysisRule = "Configured Table Association Analysis Rule"
+
+	configuredTableAssociationAnalysisRuleIDPartCount = 3
+)
+
+func resourceConfiguredTableAssociationAnalysisRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	membershipID := d.Get("membership_identifier").(string)
+	associationID := d.Get("configured_table_association_identifier").(string)
+	ruleType := types.ConfiguredTableAssociationAnalysisRuleType(d.Get("analysis_rule_type").(string))
+	id, err := flex.FlattenResourceId([]string{membershipID, associationID, string(ruleType)}, configuredTableAssociationAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAssociationAnalysisRule, associationID, err)
+	}
+
+	input := &cleanrooms.CreateConfiguredTableAssociationAnalysisRuleInput{
+		AnalysisRulePolicy:                   expandConfiguredTableAssociationAnalysisRulePolicy(d, ruleType),
+		AnalysisRuleType:                     ruleType,
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	_, err = conn.CreateConfiguredTableAssociationAnalysisRule(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionCreating, ResNameConfiguredTableAssociationAnalysisRule, id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceConfiguredTableAssociationAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAssociationAnalysisRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAssociationAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAssociationAnalysisRule, d.Id(), err)
+	}
+	membershipID, associationID, ruleType := parts[0], parts[1], types.ConfiguredTableAssociationAnalysisRuleType(parts[2])
+
+	out, err := findConfiguredTableAssociationAnalysisRuleByThreePartKey(ctx, conn, membershipID, associationID, ruleType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Configured Table Association Analysis Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionReading, ResNameConfiguredTableAssociationAnalysisRule, d.Id(), err)
+	}
+
+	analysisRule := out.AnalysisRule
+	allowedAdditionalAnalyses, allowedResultReceivers := flattenConfiguredTableAssociationAnalysisRulePolicy(analysisRule.Policy)
+	d.Set("allowed_additional_analyses", allowedAdditionalAnalyses)
+	d.Set("allowed_result_receivers", allowedResultReceivers)
+	d.Set("analysis_rule_type", analysisRule.Type)
+	d.Set("configured_table_association_arn", analysisRule.ConfiguredTableAssociationArn)
+	d.Set("configured_table_association_identifier", analysisRule.ConfiguredTableAssociationId)
+	d.Set(names.AttrCreateTime, analysisRule.CreateTime.String())
+	d.Set("membership_identifier", membershipID)
+	d.Set("update_time", analysisRule.UpdateTime.String())
+
+	return diags
+}
+
+func resourceConfiguredTableAssociationAnalysisRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAssociationAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAssociationAnalysisRule, d.Id(), err)
+	}
+	ruleType := types.ConfiguredTableAssociationAnalysisRuleType(parts[2])
+
+	input := &cleanrooms.UpdateConfiguredTableAssociationAnalysisRuleInput{
+		AnalysisRulePolicy:                   expandConfiguredTableAssociationAnalysisRulePolicy(d, ruleType),
+		AnalysisRuleType:                     ruleType,
+		ConfiguredTableAssociationIdentifier: aws.String(parts[1]),
+		MembershipIdentifier:                 aws.String(parts[0]),
+	}
+
+	_, err = conn.UpdateConfiguredTableAssociationAnalysisRule(ctx, input)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionUpdating, ResNameConfiguredTableAssociationAnalysisRule, d.Id(), err)
+	}
+
+	return append(diags, resourceConfiguredTableAssociationAnalysisRuleRead(ctx, d, meta)...)
+}
+
+func resourceConfiguredTableAssociationAnalysisRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CleanRoomsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configuredTableAssociationAnalysisRuleIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAssociationAnalysisRule, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Clean Rooms Configured Table Association Analysis Rule %s", d.Id())
+	_, err = conn.DeleteConfiguredTableAssociationAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAssociationAnalysisRuleInput{
+		AnalysisRuleType:                     types.ConfiguredTableAssociationAnalysisRuleType(parts[2]),
+		ConfiguredTableAssociationIdentifier: aws.String(parts[1]),
+		MembershipIdentifier:                 aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CleanRooms, create.ErrActionDeleting, ResNameConfiguredTableAssociationAnalysisRule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConfiguredTableAssociationAnalysisRuleByThreePartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, associationID string, ruleType types.ConfiguredTableAssociationAnalysisRuleType) (*cleanrooms.GetConfiguredTableAssociationAnalysisRuleOutput, error) {
+	in := &cleanrooms.GetConfiguredTableAssociationAnalysisRuleInput{
+		AnalysisRuleType:                     ruleType,
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	out, err := conn.GetConfiguredTableAssociationAnalysisRule(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandConfiguredTableAssociationAnalysisRulePolicy(d *schema.ResourceData, ruleType types.ConfiguredTableAssociationAnalysisRuleType) types.ConfiguredTableAssociationAnalysisRulePolicy {
+	allowedAdditionalAnalyses := flex.ExpandStringValueSet(d.Get("allowed_additional_analyses").(*schema.Set))
+	allowedResultReceivers := flex.ExpandStringValueSet(d.Get("allowed_result_receivers").(*schema.Set))
+
+	var policy types.ConfiguredTableAssociationAnalysisRulePolicyV1
+	switch ruleType {
+	case types.ConfiguredTableAssociationAnalysisRuleTypeAggregation:
+		policy = &types.ConfiguredTableAssociationAnalysisRulePolicyV1MemberAggregation{
+			Value: types.ConfiguredTableAssociationAnalysisRuleAggregation{
+				AllowedAdditionalAnalyses: allowedAdditionalAnalyses,
+				AllowedResultReceivers:    allowedResultReceivers,
+			},
+		}
+	case types.ConfiguredTableAssociationAnalysisRuleTypeCustom:
+		policy = &types.ConfiguredTableAssociationAnalysisRulePolicyV1MemberCustom{
+			Value: types.ConfiguredTableAssociationAnalysisRuleCustom{
+				AllowedAdditionalAnalyses: allowedAdditionalAnalyses,
+				AllowedResultReceivers:    allowedResultReceivers,
+			},
+		}
+	case types.ConfiguredTableAssociationAnalysisRuleTypeList:
+		policy = &types.ConfiguredTableAssociationAnalysisRulePolicyV1MemberList{
+			Value: types.ConfiguredTableAssociationAnalysisRuleList{
+				AllowedAdditionalAnalyses: allowedAdditionalAnalyses,
+				AllowedResultReceivers:    allowedResultReceivers,
+			},
+		}
+	default:
+		return nil
+	}
+
+	return &types.ConfiguredTableAssociationAnalysisRulePolicyMemberV1{
+		Value: policy,
+	}
+}
+
+func flattenConfiguredTableAssociationAnalysisRulePolicy(policy types.ConfiguredTableAssociationAnalysisRulePolicy) ([]string, []string) {
+	v1, ok := policy.(*types.ConfiguredTableAssociationAnalysisRulePolicyMemberV1)
+	if !ok {
+		return nil, nil
+	}
+
+	switch v := v1.Value.(type) {
+	case *types.ConfiguredTableAssociationAnalysisRulePolicyV1MemberAggregation:
+		return v.Value.AllowedAdditionalAnalyses, v.Value.AllowedResultReceivers
+	case *types.ConfiguredTableAssociationAnalysisRulePolicyV1MemberCustom:
+		return v.Value.AllowedAdditionalAnalyses, v.Value.AllowedResultReceivers
+	case *types.ConfiguredTableAssociationAnalysisRulePolicyV1MemberList:
+		return v.Value.AllowedAdditionalAnalyses, v.Value.AllowedResultReceivers
+	default:
+		return nil, nil
+	}
+}
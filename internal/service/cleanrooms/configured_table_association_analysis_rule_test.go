@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAssociationAnalysisRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	membershipID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_MEMBERSHIP_ID")
+	associationID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_CONFIGURED_TABLE_ASSOCIATION_ID")
+
+	var analysisRule cleanrooms.GetConfiguredTableAssociationAnalysisRuleOutput
+	resourceName := "aws_cleanrooms_configured_table_association_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationAnalysisRuleConfig_basic(membershipID, associationID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					resource.TestCheckResourceAttr(resourceName, "allowed_result_receivers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "CUSTOM"),
+					resource.TestCheckResourceAttrSet(resourceName, "configured_table_association_arn"),
+					resource.TestCheckResourceAttr(resourceName, "configured_table_association_identifier", associationID),
+					resource.TestCheckResourceAttr(resourceName, "membership_identifier", membershipID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAssociationAnalysisRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	membershipID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_MEMBERSHIP_ID")
+	associationID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_CONFIGURED_TABLE_ASSOCIATION_ID")
+
+	var analysisRule cleanrooms.GetConfiguredTableAssociationAnalysisRuleOutput
+	resourceName := "aws_cleanrooms_configured_table_association_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAssociationAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAssociationAnalysisRuleConfig_basic(membershipID, associationID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfiguredTableAssociationAnalysisRuleExists(ctx, resourceName, &analysisRule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAssociationAnalysisRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAssociationAnalysisRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_association_analysis_rule" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindConfiguredTableAssociationAnalysisRuleByThreePartKey(ctx, conn, parts[0], parts[1], types.ConfiguredTableAssociationAnalysisRuleType(parts[2]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNameConfiguredTableAssociationAnalysisRule, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAssociationAnalysisRuleExists(ctx context.Context, name string, analysisRule *cleanrooms.GetConfiguredTableAssociationAnalysisRuleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAssociationAnalysisRule, name, errors.New("not found"))
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := tfcleanrooms.FindConfiguredTableAssociationAnalysisRuleByThreePartKey(ctx, conn, parts[0], parts[1], types.ConfiguredTableAssociationAnalysisRuleType(parts[2]))
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNameConfiguredTableAssociationAnalysisRule, rs.Primary.ID, err)
+		}
+
+		*analysisRule = *resp
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAssociationAnalysisRuleConfig_basic(membershipID, associationID string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_cleanrooms_configured_table_association_analysis_rule" "test" {
+  membership_identifier                   = %[1]q
+  configured_table_association_identifier = %[2]q
+  analysis_rule_type                      = "CUSTOM"
+
+  allowed_result_receivers = [data.aws_caller_identity.current.account_id]
+}
+`, membershipID, associationID)
+}
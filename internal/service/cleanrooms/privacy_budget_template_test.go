@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	membershipID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_MEMBERSHIP_ID")
+
+	var privacyBudgetTemplate cleanrooms.GetPrivacyBudgetTemplateOutput
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(membershipID, 2, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &privacyBudgetTemplate),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "auto_refresh", "CALENDAR_MONTH"),
+					resource.TestCheckResourceAttr(resourceName, "membership_identifier", membershipID),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.epsilon", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.users_noise_per_query", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, "privacy_budget_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "privacy_budget_type", "DIFFERENTIAL_PRIVACY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(membershipID, 5, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &privacyBudgetTemplate),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.epsilon", "5"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.users_noise_per_query", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	membershipID := acctest.SkipIfEnvVarNotSet(t, "CLEANROOMS_MEMBERSHIP_ID")
+
+	var privacyBudgetTemplate cleanrooms.GetPrivacyBudgetTemplateOutput
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(membershipID, 2, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &privacyBudgetTemplate),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourcePrivacyBudgetTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPrivacyBudgetTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_privacy_budget_template" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.CleanRooms, create.ErrActionCheckingDestroyed, tfcleanrooms.ResNamePrivacyBudgetTemplate, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPrivacyBudgetTemplateExists(ctx context.Context, name string, privacyBudgetTemplate *cleanrooms.GetPrivacyBudgetTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNamePrivacyBudgetTemplate, name, errors.New("not found"))
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+		resp, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return create.Error(names.CleanRooms, create.ErrActionCheckingExistence, tfcleanrooms.ResNamePrivacyBudgetTemplate, rs.Primary.ID, err)
+		}
+
+		*privacyBudgetTemplate = *resp
+
+		return nil
+	}
+}
+
+func testAccPrivacyBudgetTemplateConfig_basic(membershipID string, epsilon, usersNoisePerQuery int) string {
+	return fmt.Sprintf(`
+resource "aws_cleanrooms_privacy_budget_template" "test" {
+  membership_identifier = %[1]q
+  auto_refresh          = "CALENDAR_MONTH"
+  privacy_budget_type   = "DIFFERENTIAL_PRIVACY"
+
+  parameters {
+    epsilon               = %[2]d
+    users_noise_per_query = %[3]d
+  }
+}
+`, membershipID, epsilon, usersNoisePerQuery)
+}
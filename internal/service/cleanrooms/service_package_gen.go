@@ -28,6 +28,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAnalysisTemplate,
+			TypeName: "aws_cleanrooms_analysis_template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceCollaboration,
 			TypeName: "aws_cleanrooms_collaboration",
@@ -42,6 +49,17 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceConfiguredTableAssociationAnalysisRule,
+			TypeName: "aws_cleanrooms_configured_table_association_analysis_rule",
+		},
+		{
+			Factory:  ResourcePrivacyBudgetTemplate,
+			TypeName: "aws_cleanrooms_privacy_budget_template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
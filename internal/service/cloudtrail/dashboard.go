@@ -0,0 +1,492 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudtrail
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cloudtrail_dashboard", name="Dashboard")
+// @Tags(identifierAttribute="arn")
+func newDashboardResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dashboardResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type dashboardResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*dashboardResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudtrail_dashboard"
+}
+
+func (r *dashboardResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9._\-]+$`), "must contain only letters, numbers, periods, underscores and hyphens"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"termination_protection_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"refresh_schedule": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardRefreshScheduleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrStatus: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.RefreshScheduleStatus](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"time_of_day": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9]{2}:[0-9]{2}$`), "must be in HH:MM format"),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"frequency": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardRefreshScheduleFrequencyModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrUnit: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.RefreshScheduleFrequencyUnit](),
+										Required:   true,
+									},
+									names.AttrValue: schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"widget": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardWidgetModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"query_parameters": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"query_statement": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 10000),
+							},
+						},
+						"view_properties": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *dashboardResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dashboardResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudTrailClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &cloudtrail.CreateDashboardInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.TagsList = getTagsIn(ctx)
+
+	output, err := conn.CreateDashboard(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudTrail Dashboard (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.DashboardARN = fwflex.StringToFramework(ctx, output.DashboardArn)
+	data.setID()
+
+	dashboard, err := waitDashboardCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudTrail Dashboard (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, dashboard)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dashboardResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dashboardResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().CloudTrailClient(ctx)
+
+	output, err := findDashboardByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudTrail Dashboard (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name, err := dashboardNameFromARN(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	data.Name = types.StringValue(name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dashboardResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new dashboardResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudTrailClient(ctx)
+
+	if !new.RefreshSchedule.Equal(old.RefreshSchedule) ||
+		!new.TerminationProtectionEnabled.Equal(old.TerminationProtectionEnabled) ||
+		!new.Widgets.Equal(old.Widgets) {
+		input := &cloudtrail.UpdateDashboardInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.DashboardId = fwflex.StringFromFramework(ctx, new.ID)
+
+		// Removing the refresh schedule disables it.
+		if new.RefreshSchedule.IsNull() && !old.RefreshSchedule.IsNull() {
+			input.RefreshSchedule = &awstypes.RefreshSchedule{
+				Status: awstypes.RefreshScheduleStatusDisabled,
+			}
+		}
+
+		_, err := conn.UpdateDashboard(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating CloudTrail Dashboard (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		dashboard, err := waitDashboardUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudTrail Dashboard (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, dashboard)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *dashboardResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dashboardResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudTrailClient(ctx)
+
+	_, err := conn.DeleteDashboard(ctx, &cloudtrail.DeleteDashboardInput{
+		DashboardId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudTrail Dashboard (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *dashboardResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findDashboardByARN(ctx context.Context, conn *cloudtrail.Client, arn string) (*cloudtrail.GetDashboardOutput, error) {
+	input := &cloudtrail.GetDashboardInput{
+		DashboardId: aws.String(arn),
+	}
+
+	output, err := conn.GetDashboard(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.DashboardStatusDeleting {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusDashboard(ctx context.Context, conn *cloudtrail.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDashboardByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDashboardCreated(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetDashboardOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DashboardStatusCreating),
+		Target:  enum.Slice(awstypes.DashboardStatusCreated, awstypes.DashboardStatusUpdated),
+		Refresh: statusDashboard(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetDashboardOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDashboardUpdated(ctx context.Context, conn *cloudtrail.Client, arn string, timeout time.Duration) (*cloudtrail.GetDashboardOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DashboardStatusUpdating),
+		Target:  enum.Slice(awstypes.DashboardStatusCreated, awstypes.DashboardStatusUpdated),
+		Refresh: statusDashboard(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudtrail.GetDashboardOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// dashboardNameFromARN returns the dashboard name from the specified ARN string.
+func dashboardNameFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	name, ok := strings.CutPrefix(v.Resource, "dashboard/")
+
+	if !ok || name == "" {
+		return "", fmt.Errorf("%q is not a CloudTrail Dashboard ARN", s)
+	}
+
+	return name, nil
+}
+
+type dashboardResourceModel struct {
+	DashboardARN                 types.String                                                   `tfsdk:"arn"`
+	ID                           types.String                                                   `tfsdk:"id"`
+	Name                         types.String                                                   `tfsdk:"name"`
+	RefreshSchedule              fwtypes.ListNestedObjectValueOf[dashboardRefreshScheduleModel] `tfsdk:"refresh_schedule"`
+	Tags                         tftags.Map                                                     `tfsdk:"tags"`
+	TagsAll                      tftags.Map                                                     `tfsdk:"tags_all"`
+	TerminationProtectionEnabled types.Bool                                                     `tfsdk:"termination_protection_enabled"`
+	Timeouts                     timeouts.Value                                                 `tfsdk:"timeouts"`
+	Widgets                      fwtypes.ListNestedObjectValueOf[dashboardWidgetModel]          `tfsdk:"widget"`
+}
+
+func (model *dashboardResourceModel) InitFromID() error {
+	model.DashboardARN = model.ID
+
+	return nil
+}
+
+func (model *dashboardResourceModel) setID() {
+	model.ID = model.DashboardARN
+}
+
+func (model *dashboardResourceModel) flatten(ctx context.Context, output *cloudtrail.GetDashboardOutput) diag.Diagnostics {
+	// A disabled refresh schedule without a frequency is the result of removing the schedule.
+	if v := output.RefreshSchedule; v != nil && v.Status == awstypes.RefreshScheduleStatusDisabled && v.Frequency == nil {
+		output.RefreshSchedule = nil
+	}
+
+	return fwflex.Flatten(ctx, output, model)
+}
+
+type dashboardRefreshScheduleModel struct {
+	Frequency fwtypes.ListNestedObjectValueOf[dashboardRefreshScheduleFrequencyModel] `tfsdk:"frequency"`
+	Status    fwtypes.StringEnum[awstypes.RefreshScheduleStatus]                      `tfsdk:"status"`
+	TimeOfDay types.String                                                            `tfsdk:"time_of_day"`
+}
+
+type dashboardRefreshScheduleFrequencyModel struct {
+	Unit  fwtypes.StringEnum[awstypes.RefreshScheduleFrequencyUnit] `tfsdk:"unit"`
+	Value types.Int64                                               `tfsdk:"value"`
+}
+
+type dashboardWidgetModel struct {
+	QueryParameters fwtypes.ListValueOf[types.String] `tfsdk:"query_parameters"`
+	QueryStatement  types.String                      `tfsdk:"query_statement"`
+	ViewProperties  fwtypes.MapValueOf[types.String]  `tfsdk:"view_properties"`
+}
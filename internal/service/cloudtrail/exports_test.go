@@ -5,10 +5,12 @@ package cloudtrail
 
 // Exports for use in tests only.
 var (
+	ResourceDashboard                         = newDashboardResource
 	ResourceEventDataStore                    = resourceEventDataStore
 	ResourceOrganizationDelegatedAdminAccount = newOrganizationDelegatedAdminAccountResource
 	ResourceTrail                             = resourceTrail
 
+	FindDashboardByARN         = findDashboardByARN
 	FindEventDataStoreByARN    = findEventDataStoreByARN
 	FindTrailByARN             = findTrailByARN
 	ServiceAccountPerRegionMap = serviceAccountPerRegionMap
@@ -32,6 +32,20 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"disappearsDomain":   testAccDomainPermissionsPolicy_Disappears_domain,
 			"ignoreEquivalent":   testAccDomainPermissionsPolicy_ignoreEquivalent,
 		},
+		"PackageGroup": {
+			acctest.CtBasic:       testAccPackageGroup_basic,
+			"description":         testAccPackageGroup_description,
+			acctest.CtDisappears:  testAccPackageGroup_disappears,
+			"originConfiguration": testAccPackageGroup_originConfiguration,
+			"tags":                testAccPackageGroup_tags,
+		},
+		"PackageGroupAllowedRepository": {
+			acctest.CtBasic:      testAccPackageGroupAllowedRepository_basic,
+			acctest.CtDisappears: testAccPackageGroupAllowedRepository_disappears,
+		},
+		"PackageOriginConfiguration": {
+			acctest.CtBasic: testAccPackageOriginConfiguration_basic,
+		},
 		"Repository": {
 			acctest.CtBasic:      testAccRepository_basic,
 			"description":        testAccRepository_description,
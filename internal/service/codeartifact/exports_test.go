@@ -5,13 +5,19 @@ package codeartifact
 
 // Exports for use in tests only.
 var (
-	ResourceDomain                      = resourceDomain
-	ResourceDomainPermissionsPolicy     = resourceDomainPermissionsPolicy
-	ResourceRepository                  = resourceRepository
-	ResourceRepositoryPermissionsPolicy = resourceRepositoryPermissionsPolicy
+	ResourceDomain                        = resourceDomain
+	ResourceDomainPermissionsPolicy       = resourceDomainPermissionsPolicy
+	ResourcePackageGroup                  = resourcePackageGroup
+	ResourcePackageGroupAllowedRepository = resourcePackageGroupAllowedRepository
+	ResourcePackageOriginConfiguration    = resourcePackageOriginConfiguration
+	ResourceRepository                    = resourceRepository
+	ResourceRepositoryPermissionsPolicy   = resourceRepositoryPermissionsPolicy
 
-	FindDomainByTwoPartKey                        = findDomainByTwoPartKey
-	FindDomainPermissionsPolicyByTwoPartKey       = findDomainPermissionsPolicyByTwoPartKey
-	FindRepositoryByThreePartKey                  = findRepositoryByThreePartKey
-	FindRepositoryPermissionsPolicyByThreePartKey = findRepositoryPermissionsPolicyByThreePartKey
+	FindDomainByTwoPartKey                         = findDomainByTwoPartKey
+	FindDomainPermissionsPolicyByTwoPartKey        = findDomainPermissionsPolicyByTwoPartKey
+	FindPackageGroupAllowedRepositoryByFivePartKey = findPackageGroupAllowedRepositoryByFivePartKey
+	FindPackageGroupByThreePartKey                 = findPackageGroupByThreePartKey
+	FindPackageOriginRestrictionsBySixPartKey      = findPackageOriginRestrictionsBySixPartKey
+	FindRepositoryByThreePartKey                   = findRepositoryByThreePartKey
+	FindRepositoryPermissionsPolicyByThreePartKey  = findRepositoryPermissionsPolicyByThreePartKey
 )
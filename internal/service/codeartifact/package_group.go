@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	packageGroupResourceIDPartCount = 3
+)
+
+// @SDKResource("aws_codeartifact_package_group", name="Package Group")
+// @Tags(identifierAttribute="arn")
+func resourcePackageGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageGroupCreate,
+		ReadWithoutTimeout:   resourcePackageGroupRead,
+		UpdateWithoutTimeout: resourcePackageGroupUpdate,
+		DeleteWithoutTimeout: resourcePackageGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_info": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"origin_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_upstream": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.PackageGroupOriginRestrictionMode](),
+						},
+						"internal_upstream": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.PackageGroupOriginRestrictionMode](),
+						},
+						"publish": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.PackageGroupOriginRestrictionMode](),
+						},
+					},
+				},
+			},
+			"parent_pattern": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 520),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePackageGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	pattern := d.Get("pattern").(string)
+	input := &codeartifact.CreatePackageGroupInput{
+		Domain:       aws.String(d.Get(names.AttrDomain).(string)),
+		PackageGroup: aws.String(pattern),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("contact_info"); ok {
+		input.ContactInfo = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain_owner"); ok {
+		input.DomainOwner = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePackageGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeArtifact Package Group (%s): %s", pattern, err)
+	}
+
+	packageGroup := output.PackageGroup
+	id, err := flex.FlattenResourceId([]string{aws.ToString(packageGroup.DomainOwner), aws.ToString(packageGroup.DomainName), aws.ToString(packageGroup.Pattern)}, packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if v, ok := d.GetOk("origin_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &codeartifact.UpdatePackageGroupOriginConfigurationInput{
+			Domain:       packageGroup.DomainName,
+			DomainOwner:  packageGroup.DomainOwner,
+			PackageGroup: packageGroup.Pattern,
+			Restrictions: expandPackageGroupOriginRestrictions(v.([]interface{})[0].(map[string]interface{})),
+		}
+
+		if len(input.Restrictions) > 0 {
+			_, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+	packageGroup, err := findPackageGroupByThreePartKey(ctx, conn, owner, domainName, pattern)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeArtifact Package Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, packageGroup.Arn)
+	d.Set("contact_info", packageGroup.ContactInfo)
+	d.Set(names.AttrDescription, packageGroup.Description)
+	d.Set(names.AttrDomain, packageGroup.DomainName)
+	d.Set("domain_owner", packageGroup.DomainOwner)
+	if err := d.Set("origin_configuration", flattenPackageGroupOriginConfiguration(packageGroup.OriginConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting origin_configuration: %s", err)
+	}
+	if packageGroup.Parent != nil {
+		d.Set("parent_pattern", packageGroup.Parent.Pattern)
+	} else {
+		d.Set("parent_pattern", nil)
+	}
+	d.Set("pattern", packageGroup.Pattern)
+
+	return diags
+}
+
+func resourcePackageGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+
+	if d.HasChanges("contact_info", names.AttrDescription) {
+		input := &codeartifact.UpdatePackageGroupInput{
+			ContactInfo:  aws.String(d.Get("contact_info").(string)),
+			Description:  aws.String(d.Get(names.AttrDescription).(string)),
+			Domain:       aws.String(domainName),
+			DomainOwner:  aws.String(owner),
+			PackageGroup: aws.String(pattern),
+		}
+
+		_, err := conn.UpdatePackageGroup(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("origin_configuration") {
+		if v, ok := d.GetOk("origin_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &codeartifact.UpdatePackageGroupOriginConfigurationInput{
+				Domain:       aws.String(domainName),
+				DomainOwner:  aws.String(owner),
+				PackageGroup: aws.String(pattern),
+				Restrictions: expandPackageGroupOriginRestrictions(v.([]interface{})[0].(map[string]interface{})),
+			}
+
+			if len(input.Restrictions) > 0 {
+				_, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+
+	log.Printf("[DEBUG] Deleting CodeArtifact Package Group: %s", d.Id())
+	_, err = conn.DeletePackageGroup(ctx, &codeartifact.DeletePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPackageGroupByThreePartKey(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern string) (*types.PackageGroupDescription, error) {
+	input := &codeartifact.DescribePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+	}
+
+	output, err := conn.DescribePackageGroup(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PackageGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PackageGroup, nil
+}
+
+var packageGroupOriginRestrictionTypes = map[string]types.PackageGroupOriginRestrictionType{
+	"external_upstream": types.PackageGroupOriginRestrictionTypeExternalUpstream,
+	"internal_upstream": types.PackageGroupOriginRestrictionTypeInternalUpstream,
+	"publish":           types.PackageGroupOriginRestrictionTypePublish,
+}
+
+func expandPackageGroupOriginRestrictions(tfMap map[string]interface{}) map[string]types.PackageGroupOriginRestrictionMode {
+	apiObject := map[string]types.PackageGroupOriginRestrictionMode{}
+
+	for k, restrictionType := range packageGroupOriginRestrictionTypes {
+		if v, ok := tfMap[k].(string); ok && v != "" {
+			apiObject[string(restrictionType)] = types.PackageGroupOriginRestrictionMode(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenPackageGroupOriginConfiguration(apiObject *types.PackageGroupOriginConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for k, restrictionType := range packageGroupOriginRestrictionTypes {
+		if v, ok := apiObject.Restrictions[string(restrictionType)]; ok {
+			tfMap[k] = v.Mode
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	packageGroupAllowedRepositoryResourceIDPartCount = 5
+)
+
+// @SDKResource("aws_codeartifact_package_group_allowed_repository", name="Package Group Allowed Repository")
+func resourcePackageGroupAllowedRepository() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageGroupAllowedRepositoryCreate,
+		ReadWithoutTimeout:   resourcePackageGroupAllowedRepositoryRead,
+		DeleteWithoutTimeout: resourcePackageGroupAllowedRepositoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"origin_restriction_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.PackageGroupOriginRestrictionType](),
+			},
+			"package_group": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrRepositoryName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePackageGroupAllowedRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	restrictionType := types.PackageGroupOriginRestrictionType(d.Get("origin_restriction_type").(string))
+	repositoryName := d.Get(names.AttrRepositoryName).(string)
+	input := &codeartifact.UpdatePackageGroupOriginConfigurationInput{
+		AddAllowedRepositories: []types.PackageGroupAllowedRepository{{
+			OriginRestrictionType: restrictionType,
+			RepositoryName:        aws.String(repositoryName),
+		}},
+		Domain:       aws.String(d.Get(names.AttrDomain).(string)),
+		PackageGroup: aws.String(d.Get("package_group").(string)),
+	}
+
+	if v, ok := d.GetOk("domain_owner"); ok {
+		input.DomainOwner = aws.String(v.(string))
+	}
+
+	output, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeArtifact Package Group Allowed Repository (%s): %s", repositoryName, err)
+	}
+
+	packageGroup := output.PackageGroup
+	id, err := flex.FlattenResourceId([]string{aws.ToString(packageGroup.DomainOwner), aws.ToString(packageGroup.DomainName), aws.ToString(packageGroup.Pattern), string(restrictionType), repositoryName}, packageGroupAllowedRepositoryResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourcePackageGroupAllowedRepositoryRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupAllowedRepositoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupAllowedRepositoryResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern, restrictionType, repositoryName := parts[0], parts[1], parts[2], parts[3], parts[4]
+	_, err = findPackageGroupAllowedRepositoryByFivePartKey(ctx, conn, owner, domainName, pattern, restrictionType, repositoryName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeArtifact Package Group Allowed Repository (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group Allowed Repository (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDomain, domainName)
+	d.Set("domain_owner", owner)
+	d.Set("origin_restriction_type", restrictionType)
+	d.Set("package_group", pattern)
+	d.Set(names.AttrRepositoryName, repositoryName)
+
+	return diags
+}
+
+func resourcePackageGroupAllowedRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupAllowedRepositoryResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern, restrictionType, repositoryName := parts[0], parts[1], parts[2], parts[3], parts[4]
+
+	log.Printf("[DEBUG] Deleting CodeArtifact Package Group Allowed Repository: %s", d.Id())
+	_, err = conn.UpdatePackageGroupOriginConfiguration(ctx, &codeartifact.UpdatePackageGroupOriginConfigurationInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+		RemoveAllowedRepositories: []types.PackageGroupAllowedRepository{{
+			OriginRestrictionType: types.PackageGroupOriginRestrictionType(restrictionType),
+			RepositoryName:        aws.String(repositoryName),
+		}},
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeArtifact Package Group Allowed Repository (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPackageGroupAllowedRepositoryByFivePartKey(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern, restrictionType, repositoryName string) (*string, error) {
+	input := &codeartifact.ListAllowedRepositoriesForGroupInput{
+		Domain:                aws.String(domainName),
+		DomainOwner:           aws.String(owner),
+		OriginRestrictionType: types.PackageGroupOriginRestrictionType(restrictionType),
+		PackageGroup:          aws.String(pattern),
+	}
+
+	repositoryNames, err := findAllowedRepositoriesForGroup(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(repositoryNames, repositoryName) {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return aws.String(repositoryName), nil
+}
+
+func findAllowedRepositoriesForGroup(ctx context.Context, conn *codeartifact.Client, input *codeartifact.ListAllowedRepositoriesForGroupInput) ([]string, error) {
+	var output []string
+
+	pages := codeartifact.NewListAllowedRepositoriesForGroupPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AllowedRepositories...)
+	}
+
+	return output, nil
+}
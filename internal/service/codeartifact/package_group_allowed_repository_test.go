@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeartifact "github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPackageGroupAllowedRepository_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group_allowed_repository.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupAllowedRepositoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupAllowedRepositoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupAllowedRepositoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomain, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_owner", "aws_codeartifact_domain.test", names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "origin_restriction_type", "PUBLISH"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_group", "aws_codeartifact_package_group.test", "pattern"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRepositoryName, "aws_codeartifact_repository.test", "repository"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPackageGroupAllowedRepository_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group_allowed_repository.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupAllowedRepositoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupAllowedRepositoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupAllowedRepositoryExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodeartifact.ResourcePackageGroupAllowedRepository(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPackageGroupAllowedRepositoryExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+		_, err := tfcodeartifact.FindPackageGroupAllowedRepositoryByFivePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["package_group"], rs.Primary.Attributes["origin_restriction_type"], rs.Primary.Attributes[names.AttrRepositoryName])
+
+		return err
+	}
+}
+
+func testAccCheckPackageGroupAllowedRepositoryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeartifact_package_group_allowed_repository" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+			_, err := tfcodeartifact.FindPackageGroupAllowedRepositoryByFivePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["package_group"], rs.Primary.Attributes["origin_restriction_type"], rs.Primary.Attributes[names.AttrRepositoryName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeArtifact Package Group Allowed Repository %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageGroupAllowedRepositoryConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/*"
+
+  origin_configuration {
+    publish = "ALLOW_SPECIFIC_REPOSITORIES"
+  }
+}
+
+resource "aws_codeartifact_package_group_allowed_repository" "test" {
+  domain                  = aws_codeartifact_package_group.test.domain
+  package_group           = aws_codeartifact_package_group.test.pattern
+  origin_restriction_type = "PUBLISH"
+  repository_name         = aws_codeartifact_repository.test.repository
+}
+`, rName))
+}
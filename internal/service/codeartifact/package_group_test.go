@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeartifact "github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPackageGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "contact_info", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomain, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_owner", "aws_codeartifact_domain.test", names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.external_upstream", "INHERIT"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.internal_upstream", "INHERIT"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.publish", "INHERIT"),
+					resource.TestCheckResourceAttr(resourceName, "parent_pattern", "/*"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "/npm/*"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodeartifact.ResourcePackageGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_description(rName, "desc1", "team1@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_info", "team1@example.com"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "desc1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_description(rName, "desc2", "team2@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_info", "team2@example.com"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "desc2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_originConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_originConfiguration(rName, "BLOCK", "ALLOW", "ALLOW_SPECIFIC_REPOSITORIES"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.external_upstream", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.internal_upstream", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.publish", "ALLOW_SPECIFIC_REPOSITORIES"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_originConfiguration(rName, "ALLOW", "INHERIT", "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.external_upstream", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.internal_upstream", "INHERIT"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.publish", "BLOCK"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPackageGroupConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageGroupExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+		_, err := tfcodeartifact.FindPackageGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["pattern"])
+
+		return err
+	}
+}
+
+func testAccCheckPackageGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeartifact_package_group" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+			_, err := tfcodeartifact.FindPackageGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["pattern"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeArtifact Package Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageGroupConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codeartifact_domain" "test" {
+  domain = %[1]q
+}
+`, rName)
+}
+
+func testAccPackageGroupConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), `
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/*"
+}
+`)
+}
+
+func testAccPackageGroupConfig_description(rName, description, contactInfo string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain       = aws_codeartifact_domain.test.domain
+  pattern      = "/npm/*"
+  description  = %[1]q
+  contact_info = %[2]q
+}
+`, description, contactInfo))
+}
+
+func testAccPackageGroupConfig_originConfiguration(rName, externalUpstream, internalUpstream, publish string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/*"
+
+  origin_configuration {
+    external_upstream = %[1]q
+    internal_upstream = %[2]q
+    publish           = %[3]q
+  }
+}
+`, externalUpstream, internalUpstream, publish))
+}
+
+func testAccPackageGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/*"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccPackageGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPackageGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/*"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
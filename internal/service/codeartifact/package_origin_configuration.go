@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	packageOriginConfigurationResourceIDPartCount = 6
+)
+
+// @SDKResource("aws_codeartifact_package_origin_configuration", name="Package Origin Configuration")
+func resourcePackageOriginConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageOriginConfigurationCreate,
+		ReadWithoutTimeout:   resourcePackageOriginConfigurationRead,
+		UpdateWithoutTimeout: resourcePackageOriginConfigurationUpdate,
+		DeleteWithoutTimeout: resourcePackageOriginConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrFormat: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.PackageFormat](),
+			},
+			names.AttrNamespace: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"package": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"restrictions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"publish": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.AllowPublish](),
+						},
+						"upstream": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.AllowUpstream](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePackageOriginConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	domainName := d.Get(names.AttrDomain).(string)
+	format := d.Get(names.AttrFormat).(string)
+	namespace := d.Get(names.AttrNamespace).(string)
+	packageName := d.Get("package").(string)
+	repositoryName := d.Get("repository").(string)
+	input := &codeartifact.PutPackageOriginConfigurationInput{
+		Domain:       aws.String(domainName),
+		Format:       types.PackageFormat(format),
+		Package:      aws.String(packageName),
+		Repository:   aws.String(repositoryName),
+		Restrictions: expandPackageOriginRestrictions(d.Get("restrictions").([]interface{})),
+	}
+
+	owner := d.Get("domain_owner").(string)
+	if owner != "" {
+		input.DomainOwner = aws.String(owner)
+	} else {
+		owner = meta.(*conns.AWSClient).AccountID(ctx)
+	}
+
+	if namespace != "" {
+		input.Namespace = aws.String(namespace)
+	}
+
+	id, err := flex.FlattenResourceId([]string{owner, domainName, repositoryName, format, namespace, packageName}, packageOriginConfigurationResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	_, err = conn.PutPackageOriginConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeArtifact Package Origin Configuration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourcePackageOriginConfigurationRead(ctx, d, meta)...)
+}
+
+func resourcePackageOriginConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageOriginConfigurationResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, repositoryName, format, namespace, packageName := parts[0], parts[1], parts[2], parts[3], parts[4], parts[5]
+	restrictions, err := findPackageOriginRestrictionsBySixPartKey(ctx, conn, owner, domainName, repositoryName, format, namespace, packageName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeArtifact Package Origin Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Origin Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrDomain, domainName)
+	d.Set("domain_owner", owner)
+	d.Set(names.AttrFormat, format)
+	d.Set(names.AttrNamespace, namespace)
+	d.Set("package", packageName)
+	d.Set("repository", repositoryName)
+	if err := d.Set("restrictions", flattenPackageOriginRestrictions(restrictions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting restrictions: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePackageOriginConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	input, err := packageOriginConfigurationInputFromID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input.Restrictions = expandPackageOriginRestrictions(d.Get("restrictions").([]interface{}))
+
+	_, err = conn.PutPackageOriginConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Origin Configuration (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePackageOriginConfigurationRead(ctx, d, meta)...)
+}
+
+func resourcePackageOriginConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	input, err := packageOriginConfigurationInputFromID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Package origin controls cannot be removed, so restore the service defaults.
+	input.Restrictions = &types.PackageOriginRestrictions{
+		Publish:  types.AllowPublishAllow,
+		Upstream: types.AllowUpstreamAllow,
+	}
+
+	log.Printf("[DEBUG] Deleting CodeArtifact Package Origin Configuration: %s", d.Id())
+	_, err = conn.PutPackageOriginConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeArtifact Package Origin Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func packageOriginConfigurationInputFromID(id string) (*codeartifact.PutPackageOriginConfigurationInput, error) {
+	parts, err := flex.ExpandResourceId(id, packageOriginConfigurationResourceIDPartCount, true)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &codeartifact.PutPackageOriginConfigurationInput{
+		Domain:      aws.String(parts[1]),
+		DomainOwner: aws.String(parts[0]),
+		Format:      types.PackageFormat(parts[3]),
+		Package:     aws.String(parts[5]),
+		Repository:  aws.String(parts[2]),
+	}
+
+	if v := parts[4]; v != "" {
+		input.Namespace = aws.String(v)
+	}
+
+	return input, nil
+}
+
+func findPackageOriginRestrictionsBySixPartKey(ctx context.Context, conn *codeartifact.Client, owner, domainName, repositoryName, format, namespace, packageName string) (*types.PackageOriginRestrictions, error) {
+	input := &codeartifact.DescribePackageInput{
+		Domain:      aws.String(domainName),
+		DomainOwner: aws.String(owner),
+		Format:      types.PackageFormat(format),
+		Package:     aws.String(packageName),
+		Repository:  aws.String(repositoryName),
+	}
+
+	if namespace != "" {
+		input.Namespace = aws.String(namespace)
+	}
+
+	output, err := conn.DescribePackage(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Package == nil || output.Package.OriginConfiguration == nil || output.Package.OriginConfiguration.Restrictions == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Package.OriginConfiguration.Restrictions, nil
+}
+
+func expandPackageOriginRestrictions(tfList []interface{}) *types.PackageOriginRestrictions {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.PackageOriginRestrictions{}
+
+	if v, ok := tfMap["publish"].(string); ok && v != "" {
+		apiObject.Publish = types.AllowPublish(v)
+	}
+
+	if v, ok := tfMap["upstream"].(string); ok && v != "" {
+		apiObject.Upstream = types.AllowUpstream(v)
+	}
+
+	return apiObject
+}
+
+func flattenPackageOriginRestrictions(apiObject *types.PackageOriginRestrictions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"publish":  apiObject.Publish,
+		"upstream": apiObject.Upstream,
+	}
+
+	return []interface{}{tfMap}
+}
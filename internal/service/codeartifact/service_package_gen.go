@@ -52,6 +52,24 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_codeartifact_domain_permissions_policy",
 			Name:     "Domain Permissions Policy",
 		},
+		{
+			Factory:  resourcePackageGroup,
+			TypeName: "aws_codeartifact_package_group",
+			Name:     "Package Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourcePackageGroupAllowedRepository,
+			TypeName: "aws_codeartifact_package_group_allowed_repository",
+			Name:     "Package Group Allowed Repository",
+		},
+		{
+			Factory:  resourcePackageOriginConfiguration,
+			TypeName: "aws_codeartifact_package_origin_configuration",
+			Name:     "Package Origin Configuration",
+		},
 		{
 			Factory:  resourceRepository,
 			TypeName: "aws_codeartifact_repository",
@@ -6,6 +6,7 @@ package codebuild
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/codebuild"
 	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -49,6 +51,36 @@ func resourceFleet() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"compute_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"machine_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[types.MachineType](),
+						},
+						"memory": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"vcpu": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 			"compute_type": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -190,7 +222,10 @@ func resourceFleet() *schema.Resource {
 				},
 			},
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceFleetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -211,6 +246,10 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Tags:            getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("compute_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ComputeConfiguration = expandComputeConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("fleet_service_role"); ok {
 		input.FleetServiceRole = aws.String(v.(string))
 	}
@@ -271,6 +310,13 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	d.Set(names.AttrARN, fleet.Arn)
 	d.Set("base_capacity", fleet.BaseCapacity)
+	if fleet.ComputeConfiguration != nil {
+		if err := d.Set("compute_configuration", []interface{}{flattenComputeConfiguration(fleet.ComputeConfiguration)}); err != nil {
+			return create.AppendDiagError(diags, names.CodeBuild, create.ErrActionSetting, resNameFleet, d.Id(), err)
+		}
+	} else {
+		d.Set("compute_configuration", nil)
+	}
 	d.Set("compute_type", fleet.ComputeType)
 	d.Set("created", aws.ToTime(fleet.Created).Format(time.RFC3339))
 	d.Set("environment_type", fleet.EnvironmentType)
@@ -315,6 +361,12 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.BaseCapacity = aws.Int32(int32(d.Get("base_capacity").(int)))
 	}
 
+	if d.HasChanges("compute_configuration", "compute_type") && types.ComputeType(d.Get("compute_type").(string)) == types.ComputeTypeAttributeBasedCompute {
+		if v, ok := d.GetOk("compute_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ComputeConfiguration = expandComputeConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	if d.HasChange("compute_type") {
 		input.ComputeType = types.ComputeType(d.Get("compute_type").(string))
 	}
@@ -395,6 +447,39 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+func resourceFleetCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	computeType := types.ComputeType(d.Get("compute_type").(string))
+	environmentType := types.EnvironmentType(d.Get("environment_type").(string))
+
+	if isLambdaComputeType(computeType) {
+		return fmt.Errorf("compute_type %s is not supported for reserved capacity fleets", computeType)
+	}
+
+	if isLambdaEnvironmentType(environmentType) {
+		return fmt.Errorf("environment_type %s is not supported for reserved capacity fleets", environmentType)
+	}
+
+	if computeType == "" {
+		return nil
+	}
+
+	// compute_configuration is Optional+Computed, so check the configuration rather than the planned value.
+	v := d.GetRawConfig().GetAttr("compute_configuration")
+	if !v.IsKnown() {
+		return nil
+	}
+	hasComputeConfiguration := !v.IsNull() && v.LengthInt() > 0
+
+	switch {
+	case computeType == types.ComputeTypeAttributeBasedCompute && !hasComputeConfiguration:
+		return fmt.Errorf("compute_configuration is required when compute_type is %s", computeType)
+	case computeType != types.ComputeTypeAttributeBasedCompute && hasComputeConfiguration:
+		return fmt.Errorf("compute_configuration is only supported when compute_type is %s", types.ComputeTypeAttributeBasedCompute)
+	}
+
+	return nil
+}
+
 func findFleetByARN(ctx context.Context, conn *codebuild.Client, arn string) (*types.Fleet, error) {
 	input := &codebuild.BatchGetFleetsInput{
 		Names: []string{arn},
@@ -521,6 +606,56 @@ func waitFleetDeleted(ctx context.Context, conn *codebuild.Client, arn string, t
 	return nil, err
 }
 
+func expandComputeConfiguration(tfMap map[string]interface{}) *types.ComputeConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ComputeConfiguration{}
+
+	if v, ok := tfMap["disk"].(int); ok && v != 0 {
+		apiObject.Disk = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["machine_type"].(string); ok && v != "" {
+		apiObject.MachineType = types.MachineType(v)
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["vcpu"].(int); ok && v != 0 {
+		apiObject.VCpu = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenComputeConfiguration(apiObject *types.ComputeConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"machine_type": apiObject.MachineType,
+	}
+
+	if v := apiObject.Disk; v != nil {
+		tfMap["disk"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.Memory; v != nil {
+		tfMap["memory"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.VCpu; v != nil {
+		tfMap["vcpu"] = aws.ToInt64(v)
+	}
+
+	return tfMap
+}
+
 func expandScalingConfiguration(tfMap map[string]interface{}) *types.ScalingConfigurationInput {
 	if tfMap == nil {
 		return nil
@@ -31,6 +31,30 @@ func dataSourceFleet() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"compute_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"machine_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"memory": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vcpu": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"compute_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -170,6 +194,11 @@ func dataSourceFleetRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.SetId(aws.ToString(fleet.Arn))
 	d.Set(names.AttrARN, fleet.Arn)
 	d.Set("base_capacity", fleet.BaseCapacity)
+	if fleet.ComputeConfiguration != nil {
+		if err := d.Set("compute_configuration", []interface{}{flattenComputeConfiguration(fleet.ComputeConfiguration)}); err != nil {
+			return create.AppendDiagError(diags, names.CodeBuild, create.ErrActionSetting, dsNameFleet, d.Id(), err)
+		}
+	}
 	d.Set("compute_type", fleet.ComputeType)
 	d.Set("created", aws.ToTime(fleet.Created).Format(time.RFC3339))
 	d.Set("environment_type", fleet.EnvironmentType)
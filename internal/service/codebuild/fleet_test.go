@@ -267,6 +267,72 @@ func TestAccCodeBuildFleet_scalingConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccCodeBuildFleet_computeConfiguration(t *testing.T) {
+	ctx := context.Background()
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codebuild_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_computeConfiguration(rName, 4, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.0.machine_type", "GENERAL"),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.0.memory", "8"),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.0.vcpu", "4"),
+					resource.TestCheckResourceAttr(resourceName, "compute_type", "ATTRIBUTE_BASED_COMPUTE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetConfig_computeConfiguration(rName, 8, 16),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.0.memory", "16"),
+					resource.TestCheckResourceAttr(resourceName, "compute_configuration.0.vcpu", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeBuildFleet_computeTypeValidation(t *testing.T) {
+	ctx := context.Background()
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_computeType(rName, types.ComputeTypeBuildLambda1gb),
+				ExpectError: regexache.MustCompile(`compute_type BUILD_LAMBDA_1GB is not supported for reserved capacity fleets`),
+			},
+			{
+				Config:      testAccFleetConfig_environmentType(rName, types.EnvironmentTypeLinuxLambdaContainer),
+				ExpectError: regexache.MustCompile(`environment_type LINUX_LAMBDA_CONTAINER is not supported for reserved capacity fleets`),
+			},
+			{
+				Config:      testAccFleetConfig_computeType(rName, types.ComputeTypeAttributeBasedCompute),
+				ExpectError: regexache.MustCompile(`compute_configuration is required when compute_type is ATTRIBUTE_BASED_COMPUTE`),
+			},
+		},
+	})
+}
+
 func TestAccCodeBuildFleet_vpcConfig(t *testing.T) {
 	ctx := context.Background()
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -386,6 +452,24 @@ resource "aws_codebuild_fleet" "test" {
 `, rName, string(computeType))
 }
 
+func testAccFleetConfig_computeConfiguration(rName string, vCPU, memory int) string {
+	return fmt.Sprintf(`
+resource "aws_codebuild_fleet" "test" {
+  base_capacity     = 1
+  compute_type      = "ATTRIBUTE_BASED_COMPUTE"
+  environment_type  = "LINUX_CONTAINER"
+  name              = %[1]q
+  overflow_behavior = "ON_DEMAND"
+
+  compute_configuration {
+    machine_type = "GENERAL"
+    memory       = %[3]d
+    vcpu         = %[2]d
+  }
+}
+`, rName, vCPU, memory)
+}
+
 func testAccFleetConfig_environmentType(rName string, environmentType types.EnvironmentType) string {
 	return fmt.Sprintf(`
 resource "aws_codebuild_fleet" "test" {
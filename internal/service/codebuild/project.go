@@ -721,11 +721,62 @@ func resourceProject() *schema.Resource {
 				}
 				return fmt.Errorf(`cache location is required when cache type is %q`, cacheType.(string))
 			},
+			resourceProjectLambdaComputeCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+func resourceProjectLambdaComputeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Plan time validation for AWS Lambda compute.
+	if !d.NewValueKnown("environment.0.compute_type") || !d.NewValueKnown("environment.0.type") {
+		return nil
+	}
+
+	computeType := types.ComputeType(d.Get("environment.0.compute_type").(string))
+	environmentType := types.EnvironmentType(d.Get("environment.0.type").(string))
+
+	if computeType == "" || environmentType == "" {
+		return nil
+	}
+
+	if isLambdaComputeType(computeType) != isLambdaEnvironmentType(environmentType) {
+		return fmt.Errorf(`environment compute type %q is not compatible with environment type %q`, computeType, environmentType)
+	}
+
+	if !isLambdaEnvironmentType(environmentType) {
+		return nil
+	}
+
+	if d.Get("environment.0.privileged_mode").(bool) {
+		return fmt.Errorf(`privileged mode is not supported when environment type is %q`, environmentType)
+	}
+
+	if types.CacheType(d.Get("cache.0.type").(string)) == types.CacheTypeLocal {
+		return fmt.Errorf(`cache type %q is not supported when environment type is %q`, types.CacheTypeLocal, environmentType)
+	}
+
+	return nil
+}
+
+func isLambdaComputeType(computeType types.ComputeType) bool {
+	switch computeType {
+	case types.ComputeTypeBuildLambda1gb, types.ComputeTypeBuildLambda2gb, types.ComputeTypeBuildLambda4gb, types.ComputeTypeBuildLambda8gb, types.ComputeTypeBuildLambda10gb:
+		return true
+	default:
+		return false
+	}
+}
+
+func isLambdaEnvironmentType(environmentType types.EnvironmentType) bool {
+	switch environmentType {
+	case types.EnvironmentTypeArmLambdaContainer, types.EnvironmentTypeLinuxLambdaContainer:
+		return true
+	default:
+		return false
+	}
+}
+
 func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CodeBuildClient(ctx)
@@ -1977,6 +1977,39 @@ func TestAccCodeBuildProject_linuxLambdaContainer(t *testing.T) {
 	})
 }
 
+func TestAccCodeBuildProject_lambdaComputeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectConfig_lambdaCompute(rName, "BUILD_GENERAL1_SMALL", "LINUX_LAMBDA_CONTAINER", false, "NO_CACHE"),
+				ExpectError: regexache.MustCompile(`environment compute type "BUILD_GENERAL1_SMALL" is not compatible with environment type "LINUX_LAMBDA_CONTAINER"`),
+			},
+			{
+				Config:      testAccProjectConfig_lambdaCompute(rName, "BUILD_LAMBDA_1GB", "LINUX_CONTAINER", false, "NO_CACHE"),
+				ExpectError: regexache.MustCompile(`environment compute type "BUILD_LAMBDA_1GB" is not compatible with environment type "LINUX_CONTAINER"`),
+			},
+			{
+				Config:      testAccProjectConfig_lambdaCompute(rName, "BUILD_LAMBDA_1GB", "LINUX_LAMBDA_CONTAINER", true, "NO_CACHE"),
+				ExpectError: regexache.MustCompile(`privileged mode is not supported when environment type is "LINUX_LAMBDA_CONTAINER"`),
+			},
+			{
+				Config:      testAccProjectConfig_lambdaCompute(rName, "BUILD_LAMBDA_1GB", "LINUX_LAMBDA_CONTAINER", false, "LOCAL"),
+				ExpectError: regexache.MustCompile(`cache type "LOCAL" is not supported when environment type is "LINUX_LAMBDA_CONTAINER"`),
+			},
+		},
+	})
+}
+
 func TestAccCodeBuildProject_Artifacts_artifactIdentifier(t *testing.T) {
 	ctx := acctest.Context(t)
 	var project types.Project
@@ -4781,6 +4814,36 @@ resource "aws_codebuild_project" "test" {
 `, rName, testAccGitHubSourceLocationFromEnv()))
 }
 
+func testAccProjectConfig_lambdaCompute(rName, computeType, environmentType string, privilegedMode bool, cacheType string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_baseServiceRole(rName), fmt.Sprintf(`
+resource "aws_codebuild_project" "test" {
+  name         = %[1]q
+  service_role = aws_iam_role.test.arn
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  cache {
+    type  = %[5]q
+    modes = %[5]q == "LOCAL" ? ["LOCAL_SOURCE_CACHE"] : []
+  }
+
+  environment {
+    compute_type    = %[2]q
+    image           = "aws/codebuild/amazonlinux-x86_64-lambda-standard:go1.21"
+    privileged_mode = %[4]t
+    type            = %[3]q
+  }
+
+  source {
+    type      = "NO_SOURCE"
+    buildspec = "version: 0.2"
+  }
+}
+`, rName, computeType, environmentType, privilegedMode, cacheType))
+}
+
 func testAccProjectConfig_artifactsArtifactIdentifier(rName string, artifactIdentifier string) string {
 	return acctest.ConfigCompose(
 		testAccProjectConfig_baseServiceRole(rName),
@@ -239,10 +239,16 @@ func (r *hostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	if !new.ProviderEndpoint.Equal(old.ProviderEndpoint) ||
 		!new.VPCConfiguration.Equal(old.VPCConfiguration) {
-		input := codeconnections.UpdateHostInput{
-			HostArn: new.HostArn.ValueStringPointer(),
+		var input codeconnections.UpdateHostInput
+
+		resp.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Host"))...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 
+		// Additional fields.
+		input.HostArn = new.ID.ValueStringPointer()
+
 		out, err := conn.UpdateHost(ctx, &input)
 		if err != nil {
 			resp.Diagnostics.AddError(
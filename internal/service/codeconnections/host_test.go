@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -135,6 +136,44 @@ func TestAccCodeConnectionsHost_vpc(t *testing.T) {
 	})
 }
 
+func TestAccCodeConnectionsHost_providerEndpoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 types.Host
+	resourceName := "aws_codeconnections_host.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostConfig_providerEndpoint(rName, "https://example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "provider_endpoint", "https://example.com"),
+				),
+			},
+			{
+				Config: testAccHostConfig_providerEndpoint(rName, "https://example.net"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckHostExists(ctx, resourceName, &v2),
+					testAccCheckHostNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "provider_endpoint", "https://example.net"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCodeConnectionsHost_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.Host
@@ -204,6 +243,16 @@ func testAccCheckHostExists(ctx context.Context, n string, v *types.Host) resour
 	}
 }
 
+func testAccCheckHostNotRecreated(before, after *types.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.HostArn), aws.ToString(after.HostArn); before != after {
+			return fmt.Errorf("CodeConnections Host (%s) recreated", before)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckHostDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
@@ -287,6 +336,16 @@ resource "aws_codeconnections_host" "test" {
 `, rName)
 }
 
+func testAccHostConfig_providerEndpoint(rName, providerEndpoint string) string {
+	return fmt.Sprintf(`
+resource "aws_codeconnections_host" "test" {
+  name              = %[1]q
+  provider_endpoint = %[2]q
+  provider_type     = "GitHubEnterpriseServer"
+}
+`, rName, providerEndpoint)
+}
+
 func testAccHostConfig_vpcNoCertificate(rName string) string {
 	return acctest.ConfigCompose(testAccHostVPCBaseConfig(rName), fmt.Sprintf(`
 resource "aws_codeconnections_host" "test" {
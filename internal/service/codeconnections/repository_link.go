@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for resource registration to the Provider. DO NOT EDIT.
+// @FrameworkResource(name="Repository Link")
+// @Tags(identifierAttribute="arn")
+func newRepositoryLinkResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &repositoryLinkResource{}, nil
+}
+
+const (
+	ResNameRepositoryLink = "Repository Link"
+)
+
+type repositoryLinkResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *repositoryLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_codeconnections_repository_link"
+}
+
+func (r *repositoryLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connection_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrOwnerID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProviderType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrRepositoryName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *repositoryLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	var data repositoryLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var input codeconnections.CreateRepositoryLinkInput
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateRepositoryLink(ctx, &input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeConnections, create.ErrActionCreating, ResNameRepositoryLink, data.RepositoryName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	repositoryLink := output.RepositoryLinkInfo
+	data.ID = fwflex.StringToFramework(ctx, repositoryLink.RepositoryLinkId)
+	data.ProviderType = fwtypes.StringEnumValue(repositoryLink.ProviderType)
+	data.RepositoryLinkArn = fwflex.StringToFramework(ctx, repositoryLink.RepositoryLinkArn)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *repositoryLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	var data repositoryLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findRepositoryLinkByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeConnections, create.ErrActionSetting, ResNameRepositoryLink, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *repositoryLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var new, old repositoryLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &new)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &old)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	if !new.ConnectionArn.Equal(old.ConnectionArn) ||
+		!new.EncryptionKeyArn.Equal(old.EncryptionKeyArn) {
+		input := codeconnections.UpdateRepositoryLinkInput{
+			ConnectionArn:    new.ConnectionArn.ValueStringPointer(),
+			EncryptionKeyArn: new.EncryptionKeyArn.ValueStringPointer(),
+			RepositoryLinkId: new.ID.ValueStringPointer(),
+		}
+
+		_, err := conn.UpdateRepositoryLink(ctx, &input)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CodeConnections, create.ErrActionUpdating, ResNameRepositoryLink, new.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+}
+
+func (r *repositoryLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	var data repositoryLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := codeconnections.DeleteRepositoryLinkInput{
+		RepositoryLinkId: data.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteRepositoryLink(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeConnections, create.ErrActionDeleting, ResNameRepositoryLink, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *repositoryLinkResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *repositoryLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func findRepositoryLinkByID(ctx context.Context, conn *codeconnections.Client, id string) (*awstypes.RepositoryLinkInfo, error) {
+	input := &codeconnections.GetRepositoryLinkInput{
+		RepositoryLinkId: aws.String(id),
+	}
+
+	output, err := conn.GetRepositoryLink(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RepositoryLinkInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RepositoryLinkInfo, nil
+}
+
+type repositoryLinkResourceModel struct {
+	ConnectionArn     fwtypes.ARN                               `tfsdk:"connection_arn"`
+	EncryptionKeyArn  fwtypes.ARN                               `tfsdk:"encryption_key_arn"`
+	ID                types.String                              `tfsdk:"id"`
+	OwnerId           types.String                              `tfsdk:"owner_id"`
+	ProviderType      fwtypes.StringEnum[awstypes.ProviderType] `tfsdk:"provider_type"`
+	RepositoryLinkArn types.String                              `tfsdk:"arn"`
+	RepositoryName    types.String                              `tfsdk:"repository_name"`
+	Tags              tftags.Map                                `tfsdk:"tags"`
+	TagsAll           tftags.Map                                `tfsdk:"tags_all"`
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codeconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Repository links require a connection that has been authorized with the third-party provider.
+func TestAccCodeConnectionsRepositoryLink_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.RepositoryLinkInfo
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_CONNECTION_ARN")
+	ownerID := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_OWNER")
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_NAME")
+	resourceName := "aws_codeconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, ownerID, repositoryName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "codeconnections", regexache.MustCompile("repository-link/.+")),
+					resource.TestCheckResourceAttr(resourceName, "connection_arn", connectionARN),
+					resource.TestCheckNoResourceAttr(resourceName, "encryption_key_arn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwnerID, ownerID),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_type"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRepositoryName, repositoryName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsRepositoryLink_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.RepositoryLinkInfo
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_CONNECTION_ARN")
+	ownerID := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_OWNER")
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_NAME")
+	resourceName := "aws_codeconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, ownerID, repositoryName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcodeconnections.ResourceRepositoryLink, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsRepositoryLink_encryptionKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.RepositoryLinkInfo
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_CONNECTION_ARN")
+	ownerID := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_OWNER")
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_NAME")
+	resourceName := "aws_codeconnections_repository_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryLinkConfig_basic(connectionARN, ownerID, repositoryName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "encryption_key_arn"),
+				),
+			},
+			{
+				Config: testAccRepositoryLinkConfig_encryptionKey(connectionARN, ownerID, repositoryName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRepositoryLinkExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key_arn", "aws_kms_key.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRepositoryLinkExists(ctx context.Context, n string, v *types.RepositoryLinkInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		output, err := tfcodeconnections.FindRepositoryLinkByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRepositoryLinkDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeconnections_repository_link" {
+				continue
+			}
+
+			_, err := tfcodeconnections.FindRepositoryLinkByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeConnections Repository Link %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRepositoryLinkConfig_basic(connectionARN, ownerID, repositoryName string) string {
+	return fmt.Sprintf(`
+resource "aws_codeconnections_repository_link" "test" {
+  connection_arn  = %[1]q
+  owner_id        = %[2]q
+  repository_name = %[3]q
+}
+`, connectionARN, ownerID, repositoryName)
+}
+
+func testAccRepositoryLinkConfig_encryptionKey(connectionARN, ownerID, repositoryName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_codeconnections_repository_link" "test" {
+  connection_arn     = %[1]q
+  encryption_key_arn = aws_kms_key.test.arn
+  owner_id           = %[2]q
+  repository_name    = %[3]q
+}
+`, connectionARN, ownerID, repositoryName)
+}
@@ -34,6 +34,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newRepositoryLinkResource,
+			Name:    "Repository Link",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newSyncConfigurationResource,
+			Name:    "Sync Configuration",
+		},
 	}
 }
 
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for resource registration to the Provider. DO NOT EDIT.
+// @FrameworkResource(name="Sync Configuration")
+func newSyncConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &syncConfigurationResource{}, nil
+}
+
+const (
+	ResNameSyncConfiguration = "Sync Configuration"
+
+	syncConfigurationResourceIDPartCount = 2
+)
+
+type syncConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *syncConfigurationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_codeconnections_sync_configuration"
+}
+
+func (r *syncConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"branch": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"config_file": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrOwnerID: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ProviderType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"publish_deployment_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PublishDeploymentStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pull_request_comment": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PullRequestComment](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_link_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrRepositoryName: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"sync_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SyncConfigurationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger_resource_update_on": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TriggerResourceUpdateOn](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *syncConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	var data syncConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var input codeconnections.CreateSyncConfigurationInput
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateSyncConfiguration(ctx, &input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeConnections, create.ErrActionCreating, ResNameSyncConfiguration, data.ResourceName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, output.SyncConfiguration, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := data.setID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeConnections, create.ErrActionFlatteningResourceId, ResNameSyncConfiguration, data.ResourceName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *syncConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	var data syncConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeConnections, create.ErrActionExpandingResourceId, ResNameSyncConfiguration, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findSyncConfigurationByTwoPartKey(ctx, conn, data.ResourceName.ValueString(), data.SyncType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeConnections, create.ErrActionSetting, ResNameSyncConfiguration, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *syncConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	var data syncConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var input codeconnections.UpdateSyncConfigurationInput
+
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateSyncConfiguration(ctx, &input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeConnections, create.ErrActionUpdating, ResNameSyncConfiguration, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, output.SyncConfiguration, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *syncConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().CodeConnectionsClient(ctx)
+
+	var data syncConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := codeconnections.DeleteSyncConfigurationInput{
+		ResourceName: data.ResourceName.ValueStringPointer(),
+		SyncType:     data.SyncType.ValueEnum(),
+	}
+
+	_, err := conn.DeleteSyncConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CodeConnections, create.ErrActionDeleting, ResNameSyncConfiguration, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *syncConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func findSyncConfigurationByTwoPartKey(ctx context.Context, conn *codeconnections.Client, resourceName string, syncType awstypes.SyncConfigurationType) (*awstypes.SyncConfiguration, error) {
+	input := &codeconnections.GetSyncConfigurationInput{
+		ResourceName: aws.String(resourceName),
+		SyncType:     syncType,
+	}
+
+	output, err := conn.GetSyncConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SyncConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SyncConfiguration, nil
+}
+
+type syncConfigurationResourceModel struct {
+	Branch                  types.String                                         `tfsdk:"branch"`
+	ConfigFile              types.String                                         `tfsdk:"config_file"`
+	ID                      types.String                                         `tfsdk:"id"`
+	OwnerId                 types.String                                         `tfsdk:"owner_id"`
+	ProviderType            fwtypes.StringEnum[awstypes.ProviderType]            `tfsdk:"provider_type"`
+	PublishDeploymentStatus fwtypes.StringEnum[awstypes.PublishDeploymentStatus] `tfsdk:"publish_deployment_status"`
+	PullRequestComment      fwtypes.StringEnum[awstypes.PullRequestComment]      `tfsdk:"pull_request_comment"`
+	RepositoryLinkId        types.String                                         `tfsdk:"repository_link_id"`
+	RepositoryName          types.String                                         `tfsdk:"repository_name"`
+	ResourceName            types.String                                         `tfsdk:"resource_name"`
+	RoleArn                 fwtypes.ARN                                          `tfsdk:"role_arn"`
+	SyncType                fwtypes.StringEnum[awstypes.SyncConfigurationType]   `tfsdk:"sync_type"`
+	TriggerResourceUpdateOn fwtypes.StringEnum[awstypes.TriggerResourceUpdateOn] `tfsdk:"trigger_resource_update_on"`
+}
+
+func (m *syncConfigurationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), syncConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ResourceName = types.StringValue(parts[0])
+	m.SyncType = fwtypes.StringEnumValue(awstypes.SyncConfigurationType(parts[1]))
+
+	return nil
+}
+
+func (m *syncConfigurationResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ResourceName.ValueString(),
+		string(m.SyncType.ValueEnum()),
+	}
+
+	return flex.FlattenResourceId(parts, syncConfigurationResourceIDPartCount, false)
+}
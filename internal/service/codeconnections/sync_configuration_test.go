@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeconnections_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codeconnections/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codeconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Sync configurations require an authorized connection and a repository containing a stack deployment file.
+func TestAccCodeConnectionsSyncConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.SyncConfiguration
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_CONNECTION_ARN")
+	ownerID := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_OWNER")
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_NAME")
+	branch := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_BRANCH")
+	configFile := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_CONFIG_FILE")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeconnections_sync_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, ownerID, repositoryName, branch, configFile, "ANY_CHANGE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "branch", branch),
+					resource.TestCheckResourceAttr(resourceName, "config_file", configFile),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwnerID, ownerID),
+					resource.TestCheckResourceAttrSet(resourceName, "provider_type"),
+					resource.TestCheckResourceAttr(resourceName, "publish_deployment_status", string(types.PublishDeploymentStatusDisabled)),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_link_id", "aws_codeconnections_repository_link.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRepositoryName, repositoryName),
+					resource.TestCheckResourceAttr(resourceName, "resource_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sync_type", string(types.SyncConfigurationTypeCfnStackSync)),
+					resource.TestCheckResourceAttr(resourceName, "trigger_resource_update_on", string(types.TriggerResourceUpdateOnAnyChange)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, ownerID, repositoryName, branch, configFile, "FILE_CHANGE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "trigger_resource_update_on", string(types.TriggerResourceUpdateOnFileChange)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeConnectionsSyncConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.SyncConfiguration
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_CONNECTION_ARN")
+	ownerID := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_OWNER")
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_NAME")
+	branch := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_REPOSITORY_BRANCH")
+	configFile := acctest.SkipIfEnvVarNotSet(t, "CODECONNECTIONS_CONFIG_FILE")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeconnections_sync_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, ownerID, repositoryName, branch, configFile, "ANY_CHANGE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcodeconnections.ResourceSyncConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSyncConfigurationExists(ctx context.Context, n string, v *types.SyncConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		output, err := tfcodeconnections.FindSyncConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_name"], types.SyncConfigurationType(rs.Primary.Attributes["sync_type"]))
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSyncConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeConnectionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeconnections_sync_configuration" {
+				continue
+			}
+
+			_, err := tfcodeconnections.FindSyncConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_name"], types.SyncConfigurationType(rs.Primary.Attributes["sync_type"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeConnections Sync Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSyncConfigurationConfig_basic(rName, connectionARN, ownerID, repositoryName, branch, configFile, triggerResourceUpdateOn string) string {
+	return acctest.ConfigCompose(testAccRepositoryLinkConfig_basic(connectionARN, ownerID, repositoryName), fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudformation.sync.codeconnections.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_codeconnections_sync_configuration" "test" {
+  branch                     = %[2]q
+  config_file                = %[3]q
+  repository_link_id         = aws_codeconnections_repository_link.test.id
+  resource_name              = %[1]q
+  role_arn                   = aws_iam_role.test.arn
+  sync_type                  = "CFN_STACK_SYNC"
+  trigger_resource_update_on = %[4]q
+}
+`, rName, branch, configFile, triggerResourceUpdateOn))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -214,6 +215,21 @@ func resourcePipeline() *schema.Resource {
 								},
 							},
 						},
+						"before_entry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     stageConditionSchema(),
+									},
+								},
+							},
+						},
 						names.AttrName: {
 							Type:     schema.TypeString,
 							Required: true,
@@ -222,6 +238,55 @@ func resourcePipeline() *schema.Resource {
 								validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_.@-]+`), ""),
 							),
 						},
+						"on_failure": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem:     stageConditionSchema(),
+									},
+									"result": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.Result](),
+									},
+									"retry_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"retry_mode": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ValidateDiagFunc: enum.Validate[types.StageRetryMode](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"on_success": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     stageConditionSchema(),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -456,10 +521,153 @@ func resourcePipeline() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePipelineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func stageConditionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"result": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.Result](),
+			},
+			names.AttrRule: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"commands": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 50,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1000),
+							},
+						},
+						names.AttrConfiguration: {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ValidateDiagFunc: validation.AllDiag(
+								validation.MapKeyLenBetween(1, 50),
+								validation.MapValueLenBetween(1, 10000),
+							),
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+						"input_artifacts": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 100),
+								validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_.@-]+`), ""),
+							),
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"rule_type_id": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"category": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.RuleCategory](),
+									},
+									names.AttrOwner: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateDiagFunc: enum.Validate[types.RuleOwner](),
+									},
+									"provider": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 35),
+											validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_-]+`), ""),
+										),
+									},
+									names.AttrVersion: {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 9),
+											validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_-]+`), ""),
+										),
+									},
+								},
+							},
+						},
+						"timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(5, 86400),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePipelineCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if pipelineType := types.PipelineType(d.Get("pipeline_type").(string)); pipelineType == types.PipelineTypeV2 || pipelineType == "" {
+		return nil
+	}
+
+	// trigger is Optional+Computed, so check the configuration rather than the planned values.
+	configured := func(v cty.Value, name string) bool {
+		if !v.IsKnown() || v.IsNull() {
+			return false
+		}
+		v = v.GetAttr(name)
+		return v.IsKnown() && !v.IsNull() && v.LengthInt() > 0
+	}
+
+	config := d.GetRawConfig()
+	for _, key := range []string{"trigger", "variable"} {
+		if configured(config, key) {
+			return fmt.Errorf("%s is only supported when pipeline_type is %s", key, types.PipelineTypeV2)
+		}
+	}
+
+	if v := config.GetAttr(names.AttrStage); v.IsKnown() && !v.IsNull() {
+		for it := v.ElementIterator(); it.Next(); {
+			_, stage := it.Element()
+			for _, key := range []string{"before_entry", "on_failure", "on_success"} {
+				if configured(stage, key) {
+					return fmt.Errorf("stage %s is only supported when pipeline_type is %s", key, types.PipelineTypeV2)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -796,10 +1004,224 @@ func expandStageDeclaration(tfMap map[string]interface{}) *types.StageDeclaratio
 		apiObject.Actions = expandActionDeclarations(v)
 	}
 
+	if v, ok := tfMap["before_entry"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BeforeEntry = expandBeforeEntryConditions(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
 		apiObject.Name = aws.String(v)
 	}
 
+	if v, ok := tfMap["on_failure"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnFailure = expandFailureConditions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["on_success"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnSuccess = expandSuccessConditions(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandBeforeEntryConditions(tfMap map[string]interface{}) *types.BeforeEntryConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.BeforeEntryConditions{}
+
+	if v, ok := tfMap[names.AttrCondition].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
+	}
+
+	return apiObject
+}
+
+func expandFailureConditions(tfMap map[string]interface{}) *types.FailureConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FailureConditions{}
+
+	if v, ok := tfMap[names.AttrCondition].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
+	}
+
+	if v, ok := tfMap["result"].(string); ok && v != "" {
+		apiObject.Result = types.Result(v)
+	}
+
+	if v, ok := tfMap["retry_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RetryConfiguration = expandRetryConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSuccessConditions(tfMap map[string]interface{}) *types.SuccessConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SuccessConditions{}
+
+	if v, ok := tfMap[names.AttrCondition].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
+	}
+
+	return apiObject
+}
+
+func expandRetryConfiguration(tfMap map[string]interface{}) *types.RetryConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.RetryConfiguration{}
+
+	if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+		apiObject.RetryMode = types.StageRetryMode(v)
+	}
+
+	return apiObject
+}
+
+func expandCondition(tfMap map[string]interface{}) *types.Condition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.Condition{}
+
+	if v, ok := tfMap["result"].(string); ok && v != "" {
+		apiObject.Result = types.Result(v)
+	}
+
+	if v, ok := tfMap[names.AttrRule].([]interface{}); ok && len(v) > 0 {
+		apiObject.Rules = expandRuleDeclarations(v)
+	}
+
+	return apiObject
+}
+
+func expandConditions(tfList []interface{}) []types.Condition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.Condition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCondition(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRuleDeclaration(tfMap map[string]interface{}) *types.RuleDeclaration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.RuleDeclaration{}
+
+	if v, ok := tfMap["commands"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Commands = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap[names.AttrConfiguration].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Configuration = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["input_artifacts"].([]interface{}); ok && len(v) > 0 {
+		apiObject.InputArtifacts = expandInputArtifacts(v)
+	}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["rule_type_id"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RuleTypeId = expandRuleTypeID(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["timeout_in_minutes"].(int); ok && v != 0 {
+		apiObject.TimeoutInMinutes = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandRuleDeclarations(tfList []interface{}) []types.RuleDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.RuleDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandRuleDeclaration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRuleTypeID(tfMap map[string]interface{}) *types.RuleTypeId {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.RuleTypeId{}
+
+	if v, ok := tfMap["category"].(string); ok && v != "" {
+		apiObject.Category = types.RuleCategory(v)
+	}
+
+	if v, ok := tfMap[names.AttrOwner].(string); ok && v != "" {
+		apiObject.Owner = types.RuleOwner(v)
+	}
+
+	if v, ok := tfMap["provider"].(string); ok && v != "" {
+		apiObject.Provider = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrVersion].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
 	return apiObject
 }
 
@@ -1287,13 +1709,146 @@ func flattenStageDeclaration(d *schema.ResourceData, i int, apiObject types.Stag
 		tfMap[names.AttrAction] = flattenActionDeclarations(d, i, v)
 	}
 
+	if v := apiObject.BeforeEntry; v != nil {
+		tfMap["before_entry"] = []interface{}{flattenBeforeEntryConditions(v)}
+	}
+
 	if v := apiObject.Name; v != nil {
 		tfMap[names.AttrName] = aws.ToString(v)
 	}
 
+	if v := apiObject.OnFailure; v != nil {
+		tfMap["on_failure"] = []interface{}{flattenFailureConditions(v)}
+	}
+
+	if v := apiObject.OnSuccess; v != nil {
+		tfMap["on_success"] = []interface{}{flattenSuccessConditions(v)}
+	}
+
+	return tfMap
+}
+
+func flattenBeforeEntryConditions(apiObject *types.BeforeEntryConditions) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Conditions; len(v) > 0 {
+		tfMap[names.AttrCondition] = flattenConditions(v)
+	}
+
+	return tfMap
+}
+
+func flattenFailureConditions(apiObject *types.FailureConditions) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"result": apiObject.Result,
+	}
+
+	if v := apiObject.Conditions; len(v) > 0 {
+		tfMap[names.AttrCondition] = flattenConditions(v)
+	}
+
+	if v := apiObject.RetryConfiguration; v != nil {
+		tfMap["retry_configuration"] = []interface{}{map[string]interface{}{
+			"retry_mode": v.RetryMode,
+		}}
+	}
+
 	return tfMap
 }
 
+func flattenSuccessConditions(apiObject *types.SuccessConditions) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Conditions; len(v) > 0 {
+		tfMap[names.AttrCondition] = flattenConditions(v)
+	}
+
+	return tfMap
+}
+
+func flattenCondition(apiObject types.Condition) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"result": apiObject.Result,
+	}
+
+	if v := apiObject.Rules; len(v) > 0 {
+		tfMap[names.AttrRule] = flattenRuleDeclarations(v)
+	}
+
+	return tfMap
+}
+
+func flattenConditions(apiObjects []types.Condition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenCondition(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenRuleDeclaration(apiObject types.RuleDeclaration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Commands; len(v) > 0 {
+		tfMap["commands"] = v
+	}
+
+	if v := apiObject.Configuration; v != nil {
+		tfMap[names.AttrConfiguration] = v
+	}
+
+	if v := apiObject.InputArtifacts; len(v) > 0 {
+		tfMap["input_artifacts"] = flattenInputArtifacts(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap[names.AttrName] = aws.ToString(v)
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap[names.AttrRegion] = aws.ToString(v)
+	}
+
+	if v := apiObject.RoleArn; v != nil {
+		tfMap[names.AttrRoleARN] = aws.ToString(v)
+	}
+
+	if apiObject := apiObject.RuleTypeId; apiObject != nil {
+		tfMap["rule_type_id"] = []interface{}{map[string]interface{}{
+			"category":        apiObject.Category,
+			names.AttrOwner:   apiObject.Owner,
+			"provider":        aws.ToString(apiObject.Provider),
+			names.AttrVersion: aws.ToString(apiObject.Version),
+		}}
+	}
+
+	if v := apiObject.TimeoutInMinutes; v != nil {
+		tfMap["timeout_in_minutes"] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func flattenRuleDeclarations(apiObjects []types.RuleDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenRuleDeclaration(apiObject))
+	}
+
+	return tfList
+}
+
 func flattenStageDeclarations(d *schema.ResourceData, apiObjects []types.StageDeclaration) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
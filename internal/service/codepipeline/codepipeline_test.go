@@ -1041,6 +1041,84 @@ func TestAccCodePipeline_manualApprovalTimeoutInMinutes(t *testing.T) {
 	})
 }
 
+func TestAccCodePipeline_stageConditions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var p types.PipelineDeclaration
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodePipelineConfig_stageConditions(rName, "V2", "RETRY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &p),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", string(types.PipelineTypeV2)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.result", string(types.ResultFail)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.name", "DeploymentWindow"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.configuration.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.category", string(types.RuleCategoryRule)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.owner", string(types.RuleOwnerAws)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.provider", "DeploymentWindow"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.result", string(types.ResultRetry)),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.retry_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.retry_configuration.0.retry_mode", string(types.StageRetryModeFailedActions)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCodePipelineConfig_stageConditions(rName, "V2", "ROLLBACK"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &p),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.result", string(types.ResultRollback)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodePipeline_stageConditionsRequireV2(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCodePipelineConfig_stageConditions(rName, "V1", "ROLLBACK"),
+				ExpectError: regexache.MustCompile(`stage before_entry is only supported when pipeline_type is V2`),
+			},
+		},
+	})
+}
+
 func testAccCheckPipelineExists(ctx context.Context, n string, v *types.PipelineDeclaration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1727,6 +1805,105 @@ resource "aws_codestarconnections_connection" "test" {
 `, rName))
 }
 
+func testAccCodePipelineConfig_stageConditions(rName, pipelineType, onFailureResult string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name     = "test-pipeline-%[1]s"
+  role_arn = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+
+    encryption_key {
+      id   = "1234"
+      type = "KMS"
+    }
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+
+    before_entry {
+      condition {
+        result = "FAIL"
+
+        rule {
+          name = "DeploymentWindow"
+
+          rule_type_id {
+            category = "Rule"
+            owner    = "AWS"
+            provider = "DeploymentWindow"
+            version  = "1"
+          }
+
+          configuration = {
+            Cron     = "* * 7-17 ? * MON-FRI"
+            TimeZone = "UTC"
+          }
+        }
+      }
+    }
+
+    on_failure {
+      result = %[3]q
+
+      dynamic "retry_configuration" {
+        for_each = %[3]q == "RETRY" ? [1] : []
+
+        content {
+          retry_mode = "FAILED_ACTIONS"
+        }
+      }
+    }
+  }
+
+  pipeline_type = %[2]q
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName, pipelineType, onFailureResult))
+}
+
 func testAccCodePipelineConfig_emptyStageArtifacts(rName string) string { // nosemgrep:ci.codepipeline-in-func-name
 	return acctest.ConfigCompose(
 		testAccS3DefaultBucket(rName),
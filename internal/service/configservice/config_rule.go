@@ -5,14 +5,15 @@ package configservice
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
-	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/configservice"
 	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -138,17 +139,14 @@ func resourceConfigRule() *schema.Resource {
 										Default:  false,
 									},
 									"policy_runtime": {
-										Type:     schema.TypeString,
-										Required: true,
-										ValidateFunc: validation.All(
-											validation.StringLenBetween(0, 64),
-											validation.StringMatch(regexache.MustCompile(`^guard\-2\.x\.x$`), "Must match cloudformation-guard version"),
-										),
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validPolicyRuntime,
 									},
 									"policy_text": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validation.StringLenBetween(0, 10000),
+										ValidateFunc: validPolicyText,
 									},
 								},
 							},
@@ -196,8 +194,46 @@ func resourceConfigRule() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceConfigRuleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceConfigRuleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.Get(names.AttrSource).([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	tfMap := v[0].(map[string]interface{})
+
+	hasCustomPolicyDetails := len(tfMap["custom_policy_details"].([]interface{})) > 0
+	// Unknown values (e.g. a Lambda function ARN) are assumed to be set.
+	hasSourceIdentifier := tfMap["source_identifier"].(string) != "" || !d.NewValueKnown("source.0.source_identifier")
+
+	if !d.NewValueKnown("source.0.owner") {
+		return nil
 	}
+
+	switch owner := types.Owner(tfMap[names.AttrOwner].(string)); owner {
+	case types.OwnerCustomPolicy:
+		if !hasCustomPolicyDetails {
+			return fmt.Errorf("source.custom_policy_details is required when source.owner is %s", owner)
+		}
+		if hasSourceIdentifier {
+			return fmt.Errorf("source.source_identifier must not be set when source.owner is %s", owner)
+		}
+	default:
+		if hasCustomPolicyDetails {
+			return fmt.Errorf("source.custom_policy_details must only be set when source.owner is %s", types.OwnerCustomPolicy)
+		}
+		if !hasSourceIdentifier {
+			return fmt.Errorf("source.source_identifier is required when source.owner is %s", owner)
+		}
+	}
+
+	return nil
 }
 
 func resourceConfigRulePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
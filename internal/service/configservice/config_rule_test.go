@@ -242,6 +242,37 @@ func testAccConfigRule_ownerPolicy(t *testing.T) {
 	})
 }
 
+func testAccConfigRule_sourceValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConfigServiceServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigRuleConfig_sourceValidation(rName, "CUSTOM_POLICY", `source_identifier = "S3_BUCKET_VERSIONING_ENABLED"`),
+				ExpectError: regexache.MustCompile(`source.custom_policy_details is required when source.owner is CUSTOM_POLICY`),
+			},
+			{
+				Config:      testAccConfigRuleConfig_sourceValidation(rName, "AWS", ""),
+				ExpectError: regexache.MustCompile(`source.source_identifier is required when source.owner is AWS`),
+			},
+			{
+				Config: testAccConfigRuleConfig_sourceValidation(rName, "CUSTOM_POLICY", `
+custom_policy_details {
+  policy_runtime = "guard-2.x.x"
+  policy_text    = " "
+}
+`),
+				ExpectError: regexache.MustCompile(`expected "source.0.custom_policy_details.0.policy_text" to not be an empty string or whitespace`),
+			},
+		},
+	})
+}
+
 func testAccConfigRule_Scope_TagKey(t *testing.T) {
 	ctx := acctest.Context(t)
 	var configRule types.ConfigRule
@@ -524,6 +555,20 @@ PARAMS
 `, rName))
 }
 
+func testAccConfigRuleConfig_sourceValidation(rName, owner, source string) string {
+	return fmt.Sprintf(`
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner = %[2]q
+
+    %[3]s
+  }
+}
+`, rName, owner, source)
+}
+
 func testAccConfigRuleConfig_ownerPolicy(rName string, enableDebugLogDelivery bool) string {
 	return acctest.ConfigCompose(testAccConfigRuleConfig_base(rName), fmt.Sprintf(`
 resource "aws_config_config_rule" "test" {
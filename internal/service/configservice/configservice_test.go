@@ -20,6 +20,7 @@ func TestAccConfigService_serial(t *testing.T) {
 			"customlambda":       testAccConfigRule_customlambda,
 			"customPolicy":       testAccConfigRule_ownerPolicy,
 			"evaluationMode":     testAccConfigRule_evaluationMode,
+			"sourceValidation":   testAccConfigRule_sourceValidation,
 			"scopeTagKey":        testAccConfigRule_Scope_TagKey,
 			"scopeTagKeyEmpty":   testAccConfigRule_Scope_TagKey_Empty,
 			"scopeTagValue":      testAccConfigRule_Scope_TagValue,
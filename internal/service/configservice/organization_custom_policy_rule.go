@@ -95,12 +95,12 @@ func resourceOrganizationCustomPolicyRule() *schema.Resource {
 			"policy_runtime": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringLenBetween(1, 64),
+				ValidateFunc: validPolicyRuntime,
 			},
 			"policy_text": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 10000),
+				ValidateFunc: validPolicyText,
 			},
 			"resource_id_scope": {
 				Type:         schema.TypeString,
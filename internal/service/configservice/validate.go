@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package configservice
+
+import (
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validPolicyRuntime validates the runtime system of an AWS Config Custom Policy rule.
+var validPolicyRuntime = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexache.MustCompile(`^guard\-2\.x\.x$`), "Must match cloudformation-guard version"),
+)
+
+// validPolicyText validates the AWS CloudFormation Guard policy definition of an AWS Config Custom Policy rule.
+var validPolicyText = validation.All(
+	validation.StringLenBetween(1, 10000),
+	validation.StringIsNotWhiteSpace,
+)
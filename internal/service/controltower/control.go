@@ -216,7 +216,7 @@ func resourceControlUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationIdentifier), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Control (%s) delete: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "waiting for ControlTower Control (%s) update: %s", d.Id(), err)
 		}
 	}
 
@@ -450,10 +450,10 @@ func waitOperationSucceeded(ctx context.Context, conn *controltower.Client, id s
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*controltower.GetControlOperationOutput); ok {
-		tfresource.SetLastError(err, errors.New(aws.ToString(output.ControlOperation.StatusMessage)))
+	if output, ok := outputRaw.(*types.ControlOperation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
 
-		return output.ControlOperation, err
+		return output, err
 	}
 
 	return nil, err
@@ -25,6 +25,7 @@ func TestAccControlTowerControl_serial(t *testing.T) {
 		"Control": {
 			acctest.CtBasic:      testAccControl_basic,
 			acctest.CtDisappears: testAccControl_disappears,
+			"parameters":         testAccControl_parameters,
 		},
 	}
 
@@ -90,6 +91,51 @@ func testAccControl_disappears(t *testing.T) {
 	})
 }
 
+func testAccControl_parameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var control types.EnabledControlSummary
+	resourceName := "aws_controltower_control.test"
+	controlName := "AWS-GR_EC2_VOLUME_INUSE_CHECK"
+	ouName := "Security"
+	region1 := "us-west-2" //lintignore:AWSAT003
+	region2 := "us-east-1" //lintignore:AWSAT003
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ControlTowerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckControlDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccControlConfig_basic(controlName, ouName, region1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(ctx, resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						names.AttrKey:   "AllowedRegions",
+						names.AttrValue: fmt.Sprintf("[%q]", region1),
+					}),
+				),
+			},
+			{
+				Config: testAccControlConfig_basic(controlName, ouName, region2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckControlExists(ctx, resourceName, &control),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						names.AttrKey:   "AllowedRegions",
+						names.AttrValue: fmt.Sprintf("[%q]", region2),
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckControlExists(ctx context.Context, n string, v *types.EnabledControlSummary) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
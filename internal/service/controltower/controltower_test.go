@@ -13,6 +13,11 @@ func TestAccControlTower_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"Baseline": {
+			acctest.CtBasic:      testAccBaseline_basic,
+			acctest.CtDisappears: testAccBaseline_disappears,
+			"tags":               testAccBaseline_tags,
+		},
 		"LandingZone": {
 			acctest.CtBasic:      testAccLandingZone_basic,
 			acctest.CtDisappears: testAccLandingZone_disappears,
@@ -94,6 +94,63 @@ func resourceTask() *schema.Resource {
 					},
 				},
 			},
+			"manifest_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ManifestAction](),
+						},
+						names.AttrFormat: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ManifestFormat](),
+						},
+						names.AttrSource: {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket_access_role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"manifest_object_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"manifest_object_version_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"s3_bucket_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -329,6 +386,10 @@ func resourceTaskCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Includes = expandFilterRules(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("manifest_config"); ok {
+		input.ManifestConfig = expandManifestConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk(names.AttrName); ok {
 		input.Name = aws.String(v.(string))
 	}
@@ -381,6 +442,9 @@ func resourceTaskRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err := d.Set("includes", flattenFilterRules(output.Includes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting includes: %s", err)
 	}
+	if err := d.Set("manifest_config", flattenManifestConfig(output.ManifestConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting manifest_config: %s", err)
+	}
 	d.Set(names.AttrName, output.Name)
 	if err := d.Set("options", flattenOptions(output.Options)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting options: %s", err)
@@ -417,6 +481,15 @@ func resourceTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.Includes = expandFilterRules(d.Get("includes").([]interface{}))
 		}
 
+		if d.HasChanges("manifest_config") {
+			input.ManifestConfig = expandManifestConfig(d.Get("manifest_config").([]interface{}))
+
+			if input.ManifestConfig == nil {
+				// An empty manifest configuration removes it from the task.
+				input.ManifestConfig = &awstypes.ManifestConfig{}
+			}
+		}
+
 		if d.HasChanges(names.AttrName) {
 			input.Name = aws.String(d.Get(names.AttrName).(string))
 		}
@@ -431,6 +504,11 @@ func resourceTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 
 		if d.HasChanges("task_report_config") {
 			input.TaskReportConfig = expandTaskReportConfig(d.Get("task_report_config").([]interface{}))
+
+			if input.TaskReportConfig == nil {
+				// An empty task report configuration removes it from the task.
+				input.TaskReportConfig = &awstypes.TaskReportConfig{}
+			}
 		}
 
 		if _, err := conn.UpdateTask(ctx, input); err != nil {
@@ -769,3 +847,81 @@ func flattenFilterRules(filterRules []awstypes.FilterRule) []interface{} {
 
 	return l
 }
+
+func expandManifestConfig(l []interface{}) *awstypes.ManifestConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	manifestConfig := &awstypes.ManifestConfig{
+		Action: awstypes.ManifestAction(m[names.AttrAction].(string)),
+		Format: awstypes.ManifestFormat(m[names.AttrFormat].(string)),
+		Source: expandSourceManifestConfig(m[names.AttrSource].([]interface{})),
+	}
+
+	return manifestConfig
+}
+
+func expandSourceManifestConfig(l []interface{}) *awstypes.SourceManifestConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	v, ok := m["s3"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	s3 := v[0].(map[string]interface{})
+
+	sourceManifestConfig := &awstypes.SourceManifestConfig{
+		S3: &awstypes.S3ManifestConfig{
+			BucketAccessRoleArn: aws.String(s3["bucket_access_role_arn"].(string)),
+			ManifestObjectPath:  aws.String(s3["manifest_object_path"].(string)),
+			S3BucketArn:         aws.String(s3["s3_bucket_arn"].(string)),
+		},
+	}
+
+	if v, ok := s3["manifest_object_version_id"].(string); ok && v != "" {
+		sourceManifestConfig.S3.ManifestObjectVersionId = aws.String(v)
+	}
+
+	return sourceManifestConfig
+}
+
+func flattenManifestConfig(manifestConfig *awstypes.ManifestConfig) []interface{} {
+	if manifestConfig == nil || manifestConfig.Source == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		names.AttrAction: string(manifestConfig.Action),
+		names.AttrFormat: string(manifestConfig.Format),
+		names.AttrSource: flattenSourceManifestConfig(manifestConfig.Source),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSourceManifestConfig(sourceManifestConfig *awstypes.SourceManifestConfig) []interface{} {
+	if sourceManifestConfig == nil || sourceManifestConfig.S3 == nil {
+		return []interface{}{}
+	}
+
+	s3 := map[string]interface{}{
+		"bucket_access_role_arn":     aws.ToString(sourceManifestConfig.S3.BucketAccessRoleArn),
+		"manifest_object_path":       aws.ToString(sourceManifestConfig.S3.ManifestObjectPath),
+		"manifest_object_version_id": aws.ToString(sourceManifestConfig.S3.ManifestObjectVersionId),
+		"s3_bucket_arn":              aws.ToString(sourceManifestConfig.S3.S3BucketArn),
+	}
+
+	m := map[string]interface{}{
+		"s3": []interface{}{s3},
+	}
+
+	return []interface{}{m}
+}
@@ -847,6 +847,50 @@ func TestAccDataSyncTask_taskReportConfig(t *testing.T) {
 	})
 }
 
+func TestAccDataSyncTask_manifestConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var task1, task2 datasync.DescribeTaskOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datasync_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskConfig_manifestConfig(rName, "manifest1.csv"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExists(ctx, resourceName, &task1),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.action", "TRANSFER"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.source.0.s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.source.0.s3.0.manifest_object_path", "manifest1.csv"),
+					resource.TestCheckResourceAttrPair(resourceName, "manifest_config.0.source.0.s3.0.bucket_access_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "manifest_config.0.source.0.s3.0.s3_bucket_arn", "aws_s3_bucket.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTaskConfig_manifestConfig(rName, "manifest2.csv"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskExists(ctx, resourceName, &task2),
+					testAccCheckTaskNotRecreated(&task1, &task2),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest_config.0.source.0.s3.0.manifest_object_path", "manifest2.csv"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSyncTask_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var task1, task2, task3 datasync.DescribeTaskOutput
@@ -1584,3 +1628,35 @@ resource "aws_datasync_task" "test" {
 }
 `, rName))
 }
+
+func testAccTaskConfig_manifestConfig(rName, manifestObjectPath string) string {
+	return acctest.ConfigCompose(
+		testAccTaskConfig_baseLocationS3(rName),
+		testAccTaskConfig_baseLocationNFS(rName),
+		fmt.Sprintf(`
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = %[2]q
+  content = "test/file1.txt"
+}
+
+resource "aws_datasync_task" "test" {
+  destination_location_arn = aws_datasync_location_nfs.test.arn
+  name                     = %[1]q
+  source_location_arn      = aws_datasync_location_s3.test.arn
+
+  manifest_config {
+    action = "TRANSFER"
+    format = "CSV"
+
+    source {
+      s3 {
+        bucket_access_role_arn = aws_iam_role.test.arn
+        manifest_object_path   = aws_s3_object.manifest.key
+        s3_bucket_arn          = aws_s3_bucket.test.arn
+      }
+    }
+  }
+}
+`, rName, manifestObjectPath))
+}
@@ -91,6 +91,12 @@ func (r *resourceDomain) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"root_domain_unit_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"skip_deletion_check": schema.BoolAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.Bool{
@@ -199,6 +205,7 @@ func (r *resourceDomain) Create(ctx context.Context, req resource.CreateRequest,
 	plan.ARN = flex.StringToFramework(ctx, out.Arn)
 	plan.ID = flex.StringToFramework(ctx, out.Id)
 	plan.PortalUrl = flex.StringToFramework(ctx, out.PortalUrl)
+	plan.RootDomainUnitID = flex.StringToFramework(ctx, out.RootDomainUnitId)
 
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 	_, err = waitDomainCreated(ctx, conn, plan.ID.ValueString(), createTimeout)
@@ -242,6 +249,7 @@ func (r *resourceDomain) Read(ctx context.Context, req resource.ReadRequest, res
 	state.KmsKeyIdentifier = flex.StringToFrameworkARN(ctx, out.KmsKeyIdentifier)
 	state.Name = flex.StringToFramework(ctx, out.Name)
 	state.PortalUrl = flex.StringToFramework(ctx, out.PortalUrl)
+	state.RootDomainUnitID = flex.StringToFramework(ctx, out.RootDomainUnitId)
 
 	if out.SingleSignOn.Type == awstypes.AuthType("DISABLED") && state.SingleSignOn.IsNull() {
 		// Do not set single sign on in state if it was null and response is DISABLED as this is equivalent
@@ -488,6 +496,7 @@ type domainResourceModel struct {
 	KmsKeyIdentifier    fwtypes.ARN    `tfsdk:"kms_key_identifier"`
 	Name                types.String   `tfsdk:"name"`
 	PortalUrl           types.String   `tfsdk:"portal_url"`
+	RootDomainUnitID    types.String   `tfsdk:"root_domain_unit_id"`
 	SkipDeletionCheck   types.Bool     `tfsdk:"skip_deletion_check"`
 	SingleSignOn        types.List     `tfsdk:"single_sign_on"`
 	Tags                tftags.Map     `tfsdk:"tags"`
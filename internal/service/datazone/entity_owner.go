@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_entity_owner", name="Entity Owner")
+func newResourceEntityOwner(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceEntityOwner{}
+	return r, nil
+}
+
+const (
+	ResNameEntityOwner = "Entity Owner"
+
+	entityOwnerIDPartCount = 5
+
+	entityOwnerTypeGroup = "GROUP"
+	entityOwnerTypeUser  = "USER"
+)
+
+type resourceEntityOwner struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *resourceEntityOwner) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_entity_owner"
+}
+
+func (r *resourceEntityOwner) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataZoneEntityType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.DataZoneEntityTypeDomainUnit)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_identifier": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group_identifier"), path.MatchRoot("user_identifier")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"user_identifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceEntityOwner) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan entityOwnerData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.AddEntityOwnerInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		DomainIdentifier: plan.DomainIdentifier.ValueStringPointer(),
+		EntityIdentifier: plan.EntityIdentifier.ValueStringPointer(),
+		EntityType:       plan.EntityType.ValueEnum(),
+		Owner:            expandEntityOwner(plan),
+	}
+
+	_, err := conn.AddEntityOwner(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEntityOwner, plan.EntityIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := plan.setID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEntityOwner, plan.EntityIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceEntityOwner) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state entityOwnerData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.InitFromID(); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameEntityOwner, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	ownerType, ownerID := entityOwnerTypeUser, state.UserIdentifier.ValueString()
+	if !state.GroupIdentifier.IsNull() {
+		ownerType, ownerID = entityOwnerTypeGroup, state.GroupIdentifier.ValueString()
+	}
+
+	_, err := findEntityOwnerByID(ctx, conn, state.DomainIdentifier.ValueString(), state.EntityType.ValueEnum(), state.EntityIdentifier.ValueString(), ownerType, ownerID)
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameEntityOwner, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEntityOwner) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state entityOwnerData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.InitFromID(); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEntityOwner, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	in := &datazone.RemoveEntityOwnerInput{
+		ClientToken:      aws.String(sdkid.UniqueId()),
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		EntityIdentifier: state.EntityIdentifier.ValueStringPointer(),
+		EntityType:       state.EntityType.ValueEnum(),
+		Owner:            expandEntityOwner(state),
+	}
+
+	_, err := conn.RemoveEntityOwner(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEntityOwner, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceEntityOwner) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func expandEntityOwner(data entityOwnerData) awstypes.OwnerProperties {
+	if !data.GroupIdentifier.IsNull() {
+		return &awstypes.OwnerPropertiesMemberGroup{
+			Value: awstypes.OwnerGroupProperties{
+				GroupIdentifier: data.GroupIdentifier.ValueStringPointer(),
+			},
+		}
+	}
+
+	return &awstypes.OwnerPropertiesMemberUser{
+		Value: awstypes.OwnerUserProperties{
+			UserIdentifier: data.UserIdentifier.ValueStringPointer(),
+		},
+	}
+}
+
+func findEntityOwnerByID(ctx context.Context, conn *datazone.Client, domainID string, entityType awstypes.DataZoneEntityType, entityID, ownerType, ownerID string) (awstypes.OwnerPropertiesOutput, error) {
+	in := &datazone.ListEntityOwnersInput{
+		DomainIdentifier: aws.String(domainID),
+		EntityIdentifier: aws.String(entityID),
+		EntityType:       entityType,
+	}
+
+	pages := datazone.NewListEntityOwnersPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Owners {
+			switch v := v.(type) {
+			case *awstypes.OwnerPropertiesOutputMemberGroup:
+				if ownerType == entityOwnerTypeGroup && aws.ToString(v.Value.GroupId) == ownerID {
+					return v, nil
+				}
+			case *awstypes.OwnerPropertiesOutputMemberUser:
+				if ownerType == entityOwnerTypeUser && aws.ToString(v.Value.UserId) == ownerID {
+					return v, nil
+				}
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+type entityOwnerData struct {
+	DomainIdentifier types.String                                    `tfsdk:"domain_identifier"`
+	EntityIdentifier types.String                                    `tfsdk:"entity_identifier"`
+	EntityType       fwtypes.StringEnum[awstypes.DataZoneEntityType] `tfsdk:"entity_type"`
+	GroupIdentifier  types.String                                    `tfsdk:"group_identifier"`
+	ID               types.String                                    `tfsdk:"id"`
+	UserIdentifier   types.String                                    `tfsdk:"user_identifier"`
+}
+
+func (data *entityOwnerData) InitFromID() error {
+	parts, err := intflex.ExpandResourceId(data.ID.ValueString(), entityOwnerIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.DomainIdentifier = types.StringValue(parts[0])
+	data.EntityType = fwtypes.StringEnumValue(awstypes.DataZoneEntityType(parts[1]))
+	data.EntityIdentifier = types.StringValue(parts[2])
+
+	switch parts[3] {
+	case entityOwnerTypeGroup:
+		data.GroupIdentifier = types.StringValue(parts[4])
+		data.UserIdentifier = types.StringNull()
+	case entityOwnerTypeUser:
+		data.GroupIdentifier = types.StringNull()
+		data.UserIdentifier = types.StringValue(parts[4])
+	default:
+		return fmt.Errorf("unexpected owner type (%s) in ID (%s)", parts[3], data.ID.ValueString())
+	}
+
+	return nil
+}
+
+func (data *entityOwnerData) setID() (string, error) {
+	ownerType, ownerID := entityOwnerTypeUser, data.UserIdentifier.ValueString()
+	if !data.GroupIdentifier.IsNull() {
+		ownerType, ownerID = entityOwnerTypeGroup, data.GroupIdentifier.ValueString()
+	}
+
+	parts := []string{
+		data.DomainIdentifier.ValueString(),
+		string(data.EntityType.ValueEnum()),
+		data.EntityIdentifier.ValueString(),
+		ownerType,
+		ownerID,
+	}
+
+	return intflex.FlattenResourceId(parts, entityOwnerIDPartCount, false)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneEntityOwner_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_entity_owner.test"
+	domainUnitName := "aws_datazone_domain_unit.test"
+	userProfileName := "aws_datazone_user_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityOwnerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityOwnerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityOwnerExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_identifier", domainUnitName, "domain_identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "entity_identifier", domainUnitName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "entity_type", string(awstypes.DataZoneEntityTypeDomainUnit)),
+					resource.TestCheckNoResourceAttr(resourceName, "group_identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_identifier", userProfileName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEntityOwner_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_entity_owner.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityOwnerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityOwnerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityOwnerExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceEntityOwner, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEntityOwnerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_entity_owner" {
+				continue
+			}
+
+			_, err := tfdatazone.FindEntityOwnerByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], awstypes.DataZoneEntityType(rs.Primary.Attributes["entity_type"]), rs.Primary.Attributes["entity_identifier"], "USER", rs.Primary.Attributes["user_identifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameEntityOwner, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameEntityOwner, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEntityOwnerExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEntityOwner, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		_, err := tfdatazone.FindEntityOwnerByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], awstypes.DataZoneEntityType(rs.Primary.Attributes["entity_type"]), rs.Primary.Attributes["entity_identifier"], "USER", rs.Primary.Attributes["user_identifier"])
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameEntityOwner, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccEntityOwnerConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainUnitConfig_basic(rName, "desc"), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+  path = "/"
+}
+
+resource "aws_datazone_user_profile" "test" {
+  user_identifier   = aws_iam_user.test.arn
+  domain_identifier = aws_datazone_domain.test.id
+  user_type         = "IAM_USER"
+}
+
+resource "aws_datazone_entity_owner" "test" {
+  domain_identifier = aws_datazone_domain_unit.test.domain_identifier
+  entity_identifier = aws_datazone_domain_unit.test.id
+  user_identifier   = aws_datazone_user_profile.test.id
+}
+`, rName))
+}
@@ -7,6 +7,8 @@ package datazone
 var (
 	ResourceAssetType                         = newResourceAssetType
 	ResourceDomain                            = newResourceDomain
+	ResourceDomainUnit                        = newResourceDomainUnit
+	ResourceEntityOwner                       = newResourceEntityOwner
 	ResourceEnvironmentBlueprintConfiguration = newResourceEnvironmentBlueprintConfiguration
 	ResourceEnvironment                       = newResourceEnvironment
 	ResourceEnvironmentProfile                = newResourceEnvironmentProfile
@@ -17,6 +19,8 @@ var (
 	ResourceUserProfile                       = newResourceUserProfile
 
 	FindAssetTypeByID          = findAssetTypeByID
+	FindDomainUnitByID         = findDomainUnitByID
+	FindEntityOwnerByID        = findEntityOwnerByID
 	FindEnvironmentByID        = findEnvironmentByID
 	FindEnvironmentProfileByID = findEnvironmentProfileByID
 	FindFormTypeByID           = findFormTypeByID
@@ -5,6 +5,7 @@ package deploy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -14,7 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -75,6 +78,7 @@ func resourceDeploymentGroup() *schema.Resource {
 						"alarms": {
 							Type:     schema.TypeSet,
 							Optional: true,
+							MaxItems: 10,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						names.AttrEnabled: {
@@ -111,7 +115,10 @@ func resourceDeploymentGroup() *schema.Resource {
 						"events": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[types.AutoRollbackEvent](),
+							},
 						},
 					},
 				},
@@ -175,7 +182,7 @@ func resourceDeploymentGroup() *schema.Resource {
 									"termination_wait_time_in_minutes": {
 										Type:         schema.TypeInt,
 										Optional:     true,
-										ValidateFunc: validation.IntAtMost(2880),
+										ValidateFunc: validation.IntBetween(0, 2880),
 									},
 								},
 							},
@@ -451,8 +458,52 @@ func resourceDeploymentGroup() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDeploymentGroupCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceDeploymentGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Blue/green deployment settings that don't apply to the Amazon ECS compute platform.
+	config := d.GetRawConfig()
+	if !configBlockPresent(config, "ecs_service") {
+		return nil
+	}
+
+	if configBlockPresent(config, "deployment_style") {
+		deploymentOption := types.DeploymentOption(d.Get("deployment_style.0.deployment_option").(string))
+		deploymentType := types.DeploymentType(d.Get("deployment_style.0.deployment_type").(string))
+
+		if deploymentOption != types.DeploymentOptionWithTrafficControl || deploymentType != types.DeploymentTypeBlueGreen {
+			return fmt.Errorf("ECS deployment groups require a deployment_style with deployment_option %s and deployment_type %s", types.DeploymentOptionWithTrafficControl, types.DeploymentTypeBlueGreen)
+		}
+	}
+
+	if v := config.GetAttr("blue_green_deployment_config"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		blueGreenConfig := v.Index(cty.NumberIntVal(0))
+
+		if configBlockPresent(blueGreenConfig, "green_fleet_provisioning_option") {
+			return errors.New("blue_green_deployment_config.green_fleet_provisioning_option is not supported for ECS deployment groups")
+		}
+
+		if action := types.InstanceAction(d.Get("blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.action").(string)); action == types.InstanceActionKeepAlive {
+			return fmt.Errorf("blue_green_deployment_config.terminate_blue_instances_on_deployment_success.action %s is not supported for ECS deployment groups", action)
+		}
+	}
+
+	return nil
+}
+
+func configBlockPresent(v cty.Value, name string) bool {
+	if !v.IsKnown() || v.IsNull() {
+		return false
 	}
+
+	v = v.GetAttr(name)
+
+	return v.IsKnown() && !v.IsNull() && v.LengthInt() > 0
 }
 
 func resourceDeploymentGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1524,6 +1524,53 @@ func TestAccDeployDeploymentGroup_ECS_blueGreen(t *testing.T) {
 	})
 }
 
+func TestAccDeployDeploymentGroup_ECS_alarmRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group types.DeploymentGroupInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codedeploy_deployment_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupConfig_ecsAlarmRollback(rName, "TERMINATE", 15),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.alarms.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "alarm_configuration.0.alarms.*", "aws_cloudwatch_metric_alarm.test", "alarm_name"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.0.events.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "auto_rollback_configuration.0.events.*", "DEPLOYMENT_FAILURE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "auto_rollback_configuration.0.events.*", "DEPLOYMENT_STOP_ON_ALARM"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.action", "TERMINATE"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.termination_wait_time_in_minutes", "15"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDeploymentGroupImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccDeploymentGroupConfig_ecsAlarmRollback(rName, "KEEP_ALIVE", 15),
+				ExpectError: regexache.MustCompile(`terminate_blue_instances_on_deployment_success.action KEEP_ALIVE is not supported for ECS deployment groups`),
+			},
+			{
+				Config:      testAccDeploymentGroupConfig_ecsAlarmRollback(rName, "TERMINATE", 2881),
+				ExpectError: regexache.MustCompile(`expected blue_green_deployment_config.0.terminate_blue_instances_on_deployment_success.0.termination_wait_time_in_minutes to be in the range \(0 - 2880\)`),
+			},
+		},
+	})
+}
+
 func TestAccDeployDeploymentGroup_OutdatedInstancesStrategy_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group types.DeploymentGroupInfo
@@ -2845,6 +2892,80 @@ resource "aws_codedeploy_deployment_group" "test" {
 `, rName))
 }
 
+func testAccDeploymentGroupConfig_ecsAlarmRollback(rName, terminationAction string, terminationWaitTime int) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_ecsBase(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/ECS"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+
+  dimensions = {
+    ClusterName = aws_ecs_cluster.test.name
+    ServiceName = aws_ecs_service.test.name
+  }
+}
+
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = aws_codedeploy_app.test.name
+  deployment_config_name = "CodeDeployDefault.ECSAllAtOnce"
+  deployment_group_name  = %[1]q
+  service_role_arn       = aws_iam_role.test.arn
+
+  alarm_configuration {
+    alarms  = [aws_cloudwatch_metric_alarm.test.alarm_name]
+    enabled = true
+  }
+
+  auto_rollback_configuration {
+    enabled = true
+    events  = ["DEPLOYMENT_FAILURE", "DEPLOYMENT_STOP_ON_ALARM"]
+  }
+
+  blue_green_deployment_config {
+    deployment_ready_option {
+      action_on_timeout = "CONTINUE_DEPLOYMENT"
+    }
+
+    terminate_blue_instances_on_deployment_success {
+      action                           = %[2]q
+      termination_wait_time_in_minutes = %[3]d
+    }
+  }
+
+  deployment_style {
+    deployment_option = "WITH_TRAFFIC_CONTROL"
+    deployment_type   = "BLUE_GREEN"
+  }
+
+  ecs_service {
+    cluster_name = aws_ecs_cluster.test.name
+    service_name = aws_ecs_service.test.name
+  }
+
+  load_balancer_info {
+    target_group_pair_info {
+      prod_traffic_route {
+        listener_arns = [aws_lb_listener.test.arn]
+      }
+
+      target_group {
+        name = aws_lb_target_group.blue.name
+      }
+
+      target_group {
+        name = aws_lb_target_group.green.name
+      }
+    }
+  }
+}
+`, rName, terminationAction, terminationWaitTime))
+}
+
 func testAccDeploymentGroupConfig_ecsBlueGreenUpdate(rName string) string {
 	return acctest.ConfigCompose(testAccDeploymentGroupConfig_ecsBase(rName), fmt.Sprintf(`
 resource "aws_codedeploy_deployment_group" "test" {
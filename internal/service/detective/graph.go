@@ -5,6 +5,7 @@ package detective
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,10 +13,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/detective"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/detective/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -41,6 +46,15 @@ func ResourceGraph() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"datasource_packages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.DatasourcePackage](),
+				},
+			},
 			"graph_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -49,8 +63,25 @@ func ResourceGraph() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceGraphCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceGraphCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("datasource_packages") || d.GetRawConfig().GetAttr("datasource_packages").IsNull() {
+		return nil
+	}
+
+	// Data source packages can be started but not stopped.
+	o, n := d.GetChange("datasource_packages")
+	if del := o.(*schema.Set).Difference(n.(*schema.Set)); del.Len() > 0 {
+		return fmt.Errorf("Detective Graph data source packages cannot be stopped once started (%v)", flex.ExpandStringValueSet(del))
 	}
+
+	return nil
 }
 
 func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -75,6 +106,12 @@ func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(aws.ToString(outputRaw.(*detective.CreateGraphOutput).GraphArn))
 
+	if v, ok := d.GetOk("datasource_packages"); ok && v.(*schema.Set).Len() > 0 {
+		if err := startDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringyValueSet[awstypes.DatasourcePackage](v.(*schema.Set))); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceGraphRead(ctx, d, meta)...)
 }
 
@@ -98,12 +135,62 @@ func resourceGraphRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set(names.AttrCreatedTime, aws.ToTime(graph.CreatedTime).Format(time.RFC3339))
 	d.Set("graph_arn", graph.Arn)
 
+	datasourcePackages, err := findDatasourcePackagesByGraphARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Detective Graph (%s) data source packages: %s", d.Id(), err)
+	}
+
+	var packages []string
+	for k, v := range datasourcePackages {
+		if v.DatasourcePackageIngestState != awstypes.DatasourcePackageIngestStateDisabled {
+			packages = append(packages, k)
+		}
+	}
+	d.Set("datasource_packages", packages)
+
 	return diags
 }
 
 func resourceGraphUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Tags only.
-	return resourceGraphRead(ctx, d, meta)
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveClient(ctx)
+
+	if d.HasChange("datasource_packages") {
+		o, n := d.GetChange("datasource_packages")
+		if add := n.(*schema.Set).Difference(o.(*schema.Set)); add.Len() > 0 {
+			if err := startDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringyValueSet[awstypes.DatasourcePackage](add)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceGraphRead(ctx, d, meta)...)
+}
+
+func startDatasourcePackages(ctx context.Context, conn *detective.Client, graphARN string, packages []awstypes.DatasourcePackage) error {
+	// The core data source package is always started.
+	packages = tfslices.Filter(packages, func(v awstypes.DatasourcePackage) bool {
+		return v != awstypes.DatasourcePackageDetectiveCore
+	})
+
+	if len(packages) == 0 {
+		return nil
+	}
+
+	input := &detective.UpdateDatasourcePackagesInput{
+		DatasourcePackages: packages,
+		GraphArn:           aws.String(graphARN),
+	}
+
+	_, err := conn.UpdateDatasourcePackages(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("starting Detective Graph (%s) data source packages: %w", graphARN, err)
+	}
+
+	return nil
 }
 
 func resourceGraphDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -135,6 +222,36 @@ func FindGraphByARN(ctx context.Context, conn *detective.Client, arn string) (*a
 	})
 }
 
+func findDatasourcePackagesByGraphARN(ctx context.Context, conn *detective.Client, arn string) (map[string]awstypes.DatasourcePackageIngestDetail, error) {
+	input := &detective.ListDatasourcePackagesInput{
+		GraphArn: aws.String(arn),
+	}
+	output := make(map[string]awstypes.DatasourcePackageIngestDetail)
+
+	pages := detective.NewListDatasourcePackagesPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range page.DatasourcePackages {
+			output[k] = v
+		}
+	}
+
+	return output, nil
+}
+
 func findGraph(ctx context.Context, conn *detective.Client, input *detective.ListGraphsInput, filter tfslices.Predicate[awstypes.Graph]) (*awstypes.Graph, error) {
 	output, err := findGraphs(ctx, conn, input, filter)
 
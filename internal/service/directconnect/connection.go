@@ -189,6 +189,30 @@ func resourceConnection() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"macsec_keys": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ckn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_on": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			// Enable or disable MAC Security (MACsec) on this connection.
 			"request_macsec": {
 				Type:     schema.TypeBool,
@@ -295,6 +319,9 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("jumbo_frame_capable", connection.JumboFrameCapable)
 	d.Set(names.AttrLocation, connection.Location)
 	d.Set("macsec_capable", connection.MacSecCapable)
+	if err := d.Set("macsec_keys", flattenMacSecKeys(connection.MacSecKeys)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting macsec_keys: %s", err)
+	}
 	d.Set(names.AttrName, connection.ConnectionName)
 	d.Set(names.AttrOwnerAccountID, connection.OwnerAccount)
 	d.Set("partner_name", connection.PartnerName)
@@ -454,3 +481,22 @@ func waitConnectionDeleted(ctx context.Context, conn *directconnect.Client, id s
 
 	return nil, err
 }
+
+func flattenMacSecKeys(apiObjects []awstypes.MacSecKey) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"ckn":           aws.ToString(apiObject.Ckn),
+			"secret_arn":    aws.ToString(apiObject.SecretARN),
+			"start_on":      aws.ToString(apiObject.StartOn),
+			names.AttrState: aws.ToString(apiObject.State),
+		})
+	}
+
+	return tfList
+}
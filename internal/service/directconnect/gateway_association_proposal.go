@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -90,6 +92,15 @@ func resourceGatewayAssociationProposal() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidAccountID,
 			},
+			"proposal_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 	}
 }
@@ -118,6 +129,10 @@ func resourceGatewayAssociationProposalCreate(ctx context.Context, d *schema.Res
 
 	d.SetId(aws.ToString(output.DirectConnectGatewayAssociationProposal.ProposalId))
 
+	if _, err := waitGatewayAssociationProposalCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association Proposal (%s) create: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceGatewayAssociationProposalRead(ctx, d, meta)...)
 }
 
@@ -159,6 +174,7 @@ func resourceGatewayAssociationProposalRead(ctx context.Context, d *schema.Resou
 		d.Set("associated_gateway_type", output.AssociatedGateway.Type)
 		d.Set("dx_gateway_id", output.DirectConnectGatewayId)
 		d.Set("dx_gateway_owner_account_id", output.DirectConnectGatewayOwnerAccount)
+		d.Set("proposal_state", awstypes.DirectConnectGatewayAssociationProposalStateAccepted)
 	} else if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Gateway Association Proposal (%s): %s", d.Id(), err)
 	} else {
@@ -171,6 +187,7 @@ func resourceGatewayAssociationProposalRead(ctx context.Context, d *schema.Resou
 		d.Set("associated_gateway_type", output.AssociatedGateway.Type)
 		d.Set("dx_gateway_id", output.DirectConnectGatewayId)
 		d.Set("dx_gateway_owner_account_id", output.DirectConnectGatewayOwnerAccount)
+		d.Set("proposal_state", output.ProposalState)
 	}
 
 	return diags
@@ -193,6 +210,10 @@ func resourceGatewayAssociationProposalDelete(ctx context.Context, d *schema.Res
 		return sdkdiag.AppendErrorf(diags, "deleting Direct Connect Gateway Association Proposal (%s): %s", d.Id(), err)
 	}
 
+	if _, err := waitGatewayAssociationProposalDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association Proposal (%s) delete: %s", d.Id(), err)
+	}
+
 	return diags
 }
 
@@ -281,6 +302,58 @@ func findGatewayAssociationProposals(ctx context.Context, conn *directconnect.Cl
 	return output, nil
 }
 
+func statusGatewayAssociationProposal(ctx context.Context, conn *directconnect.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGatewayAssociationProposalByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ProposalState), nil
+	}
+}
+
+func waitGatewayAssociationProposalCreated(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.DirectConnectGatewayAssociationProposal, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    enum.Slice(awstypes.DirectConnectGatewayAssociationProposalStateRequested, awstypes.DirectConnectGatewayAssociationProposalStateAccepted),
+		Refresh:                   statusGatewayAssociationProposal(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAssociationProposal); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGatewayAssociationProposalDeleted(ctx context.Context, conn *directconnect.Client, id string, timeout time.Duration) (*awstypes.DirectConnectGatewayAssociationProposal, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DirectConnectGatewayAssociationProposalStateRequested, awstypes.DirectConnectGatewayAssociationProposalStateAccepted),
+		Target:  []string{},
+		Refresh: statusGatewayAssociationProposal(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DirectConnectGatewayAssociationProposal); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func expandRouteFilterPrefixes(tfList []interface{}) []awstypes.RouteFilterPrefix {
 	if len(tfList) == 0 {
 		return nil
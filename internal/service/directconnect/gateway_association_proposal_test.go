@@ -45,6 +45,7 @@ func TestAccDirectConnectGatewayAssociationProposal_basicVPNGateway(t *testing.T
 					acctest.CheckResourceAttrAccountID(ctx, resourceName, "associated_gateway_owner_account_id"),
 					resource.TestCheckResourceAttr(resourceName, "associated_gateway_type", "virtualPrivateGateway"),
 					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_id", resourceNameDxGw, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "proposal_state", "requested"),
 				),
 			},
 			{
@@ -83,6 +84,7 @@ func TestAccDirectConnectGatewayAssociationProposal_basicTransitGateway(t *testi
 					acctest.CheckResourceAttrAccountID(ctx, resourceName, "associated_gateway_owner_account_id"),
 					resource.TestCheckResourceAttr(resourceName, "associated_gateway_type", "transitGateway"),
 					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_id", resourceNameDxGw, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "proposal_state", "requested"),
 				),
 			},
 			{
@@ -152,6 +154,8 @@ func TestAccDirectConnectGatewayAssociationProposal_endOfLifeVPN(t *testing.T) {
 				},
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The proposal has been removed, so the imported state reflects the accepted association.
+				ImportStateVerifyIgnore: []string{"proposal_state"},
 			},
 		},
 	})
@@ -189,6 +193,8 @@ func TestAccDirectConnectGatewayAssociationProposal_endOfLifeTgw(t *testing.T) {
 				},
 				ImportState:       true,
 				ImportStateVerify: true,
+				// The proposal has been removed, so the imported state reflects the accepted association.
+				ImportStateVerifyIgnore: []string{"proposal_state"},
 			},
 		},
 	})
@@ -52,6 +52,39 @@ func TestAccDirectConnectMacSecKeyAssociation_withCkn(t *testing.T) {
 	})
 }
 
+func TestAccDirectConnectMacSecKeyAssociation_rotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionID := acctest.SkipIfEnvVarNotSet(t, "DX_CONNECTION_ID")
+	resourceName := "aws_dx_macsec_key_association.test"
+	ckn1 := testAccMacSecGenerateHex()
+	cak1 := testAccMacSecGenerateHex()
+	ckn2 := testAccMacSecGenerateHex()
+	cak2 := testAccMacSecGenerateHex()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMacSecKeyAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMacSecKeyAssociationConfig_rotation(ckn1, cak1, connectionID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMacSecKeyAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ckn", ckn1),
+				),
+			},
+			{
+				Config: testAccMacSecKeyAssociationConfig_rotation(ckn2, cak2, connectionID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMacSecKeyAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ckn", ckn2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDirectConnectMacSecKeyAssociation_withSecret(t *testing.T) {
 	ctx := acctest.Context(t)
 	connectionID := acctest.SkipIfEnvVarNotSet(t, "DX_CONNECTION_ID")
@@ -140,6 +173,20 @@ resource "aws_dx_macsec_key_association" "test" {
 `, ckn, cak, connectionID)
 }
 
+func testAccMacSecKeyAssociationConfig_rotation(ckn, cak, connectionID string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_macsec_key_association" "test" {
+  connection_id = %[3]q
+  ckn           = %[1]q
+  cak           = %[2]q
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, ckn, cak, connectionID)
+}
+
 // Can only be used with an EXISTING secrets created by previous association - cannot create secrets from scratch.
 func testAccMacSecKeyAssociationConfig_withSecret(secretARN, connectionID string) string {
 	return fmt.Sprintf(`
@@ -678,7 +678,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if d.HasChange(names.AttrStorageType) {
-			input.StorageType = aws.String(d.Get(names.AttrStorageType).(string))
+			// Removing storage_type from configuration migrates the cluster back to the default storage type.
+			if v := d.Get(names.AttrStorageType).(string); v != "" {
+				input.StorageType = aws.String(v)
+			} else {
+				input.StorageType = aws.String(storageTypeStandard)
+			}
 		}
 
 		if d.HasChange(names.AttrPreferredMaintenanceWindow) {
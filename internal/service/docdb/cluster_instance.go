@@ -271,9 +271,7 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 		d.Set("db_subnet_group_name", db.DBSubnetGroup.DBSubnetGroupName)
 	}
 	d.Set("dbi_resource_id", db.DbiResourceId)
-	// The AWS API does not expose 'EnablePerformanceInsights' the line below should be uncommented
-	// as soon as it is available in the DescribeDBClusters output.
-	//d.Set("enable_performance_insights", db.EnablePerformanceInsights)
+	d.Set("enable_performance_insights", db.PerformanceInsightsEnabled)
 	if db.Endpoint != nil {
 		d.Set(names.AttrEndpoint, db.Endpoint.Address)
 		d.Set(names.AttrPort, db.Endpoint.Port)
@@ -284,9 +282,7 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("identifier_prefix", create.NamePrefixFromName(aws.ToString(db.DBInstanceIdentifier)))
 	d.Set("instance_class", db.DBInstanceClass)
 	d.Set(names.AttrKMSKeyID, db.KmsKeyId)
-	// The AWS API does not expose 'PerformanceInsightsKMSKeyId'  the line below should be uncommented
-	// as soon as it is available in the DescribeDBClusters output.
-	//d.Set("performance_insights_kms_key_id", db.PerformanceInsightsKMSKeyId)
+	d.Set("performance_insights_kms_key_id", db.PerformanceInsightsKMSKeyId)
 	d.Set("preferred_backup_window", db.PreferredBackupWindow)
 	d.Set(names.AttrPreferredMaintenanceWindow, db.PreferredMaintenanceWindow)
 	d.Set("promotion_tier", db.PromotionTier)
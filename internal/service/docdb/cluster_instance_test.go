@@ -45,7 +45,7 @@ func TestAccDocDBClusterInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "copy_tags_to_snapshot", acctest.CtFalse),
 					resource.TestCheckResourceAttrSet(resourceName, "db_subnet_group_name"),
 					resource.TestCheckResourceAttrSet(resourceName, "dbi_resource_id"),
-					resource.TestCheckNoResourceAttr(resourceName, "enable_performance_insights"),
+					resource.TestCheckResourceAttr(resourceName, "enable_performance_insights", acctest.CtFalse),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpoint),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEngine, "docdb"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrEngineVersion),
@@ -53,7 +53,7 @@ func TestAccDocDBClusterInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "identifier_prefix", ""),
 					resource.TestCheckResourceAttrSet(resourceName, "instance_class"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyID, ""),
-					resource.TestCheckNoResourceAttr(resourceName, "performance_insights_kms_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "performance_insights_kms_key_id", ""),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrPort),
 					resource.TestCheckResourceAttrSet(resourceName, "preferred_backup_window"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrPreferredMaintenanceWindow),
@@ -237,24 +237,28 @@ func TestAccDocDBClusterInstance_performanceInsights(t *testing.T) {
 		CheckDestroy:             testAccCheckClusterInstanceDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClusterInstanceConfig_performanceInsights(rName),
+				Config: testAccClusterInstanceConfig_performanceInsights(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
-					resource.TestCheckResourceAttrSet(resourceName, "enable_performance_insights"),
-					resource.TestCheckResourceAttrSet(resourceName, "performance_insights_kms_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "enable_performance_insights", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "performance_insights_kms_key_id", "aws_kms_key.test", names.AttrARN),
 				),
 			},
-
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					names.AttrApplyImmediately,
-					"enable_performance_insights",
-					"performance_insights_kms_key_id",
 				},
 			},
+			{
+				Config: testAccClusterInstanceConfig_performanceInsights(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "enable_performance_insights", acctest.CtFalse),
+				),
+			},
 		},
 	})
 }
@@ -496,7 +500,7 @@ resource "aws_docdb_cluster_instance" "test" {
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
-func testAccClusterInstanceConfig_performanceInsights(rName string) string {
+func testAccClusterInstanceConfig_performanceInsights(rName string, enabled bool) string {
 	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
   description = %[1]q
@@ -525,10 +529,11 @@ resource "aws_docdb_cluster_instance" "test" {
   cluster_identifier              = aws_docdb_cluster.test.id
   instance_class                  = data.aws_docdb_orderable_db_instance.test.instance_class
   promotion_tier                  = "3"
-  enable_performance_insights     = true
+  apply_immediately               = true
+  enable_performance_insights     = %[2]t
   performance_insights_kms_key_id = aws_kms_key.test.arn
 }
-`, rName))
+`, rName, enabled))
 }
 
 func testAccClusterInstanceConfig_az(rName string) string {
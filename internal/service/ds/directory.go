@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -143,10 +145,24 @@ func resourceDirectory() *schema.Resource {
 				ValidateFunc: domainValidator,
 			},
 			names.AttrPassword: {
-				Type:      schema.TypeString,
-				Required:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{names.AttrPassword, "password_wo"},
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{names.AttrPassword, "password_wo"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -202,6 +218,10 @@ func resourceDirectory() *schema.Resource {
 			},
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath(names.AttrPassword), cty.GetAttrPath("password_wo")),
+		},
+
 		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
@@ -395,6 +415,21 @@ type directoryCreator interface {
 	Create(ctx context.Context, conn *directoryservice.Client, name string, d *schema.ResourceData) error
 }
 
+// directoryPassword returns the configured directory administrator password.
+// The write-only password, if configured, takes precedence.
+func directoryPassword(d *schema.ResourceData) (string, error) {
+	passwordWO, diags := sdkv2.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	if diags.HasError() {
+		return "", sdkdiag.DiagnosticsError(diags)
+	}
+
+	if passwordWO != "" {
+		return passwordWO, nil
+	}
+
+	return d.Get(names.AttrPassword).(string), nil
+}
+
 type adConnectorCreator struct{}
 
 func (c adConnectorCreator) TypeName() string {
@@ -402,9 +437,14 @@ func (c adConnectorCreator) TypeName() string {
 }
 
 func (c adConnectorCreator) Create(ctx context.Context, conn *directoryservice.Client, name string, d *schema.ResourceData) error {
+	password, err := directoryPassword(d)
+	if err != nil {
+		return err
+	}
+
 	input := &directoryservice.ConnectDirectoryInput{
 		Name:     aws.String(name),
-		Password: aws.String(d.Get(names.AttrPassword).(string)),
+		Password: aws.String(password),
 		Tags:     getTagsIn(ctx),
 	}
 
@@ -445,9 +485,14 @@ func (c microsoftADCreator) TypeName() string {
 }
 
 func (c microsoftADCreator) Create(ctx context.Context, conn *directoryservice.Client, name string, d *schema.ResourceData) error {
+	password, err := directoryPassword(d)
+	if err != nil {
+		return err
+	}
+
 	input := &directoryservice.CreateMicrosoftADInput{
 		Name:     aws.String(name),
-		Password: aws.String(d.Get(names.AttrPassword).(string)),
+		Password: aws.String(password),
 		Tags:     getTagsIn(ctx),
 	}
 
@@ -485,9 +530,14 @@ func (c simpleADCreator) TypeName() string {
 }
 
 func (c simpleADCreator) Create(ctx context.Context, conn *directoryservice.Client, name string, d *schema.ResourceData) error {
+	password, err := directoryPassword(d)
+	if err != nil {
+		return err
+	}
+
 	input := &directoryservice.CreateDirectoryInput{
 		Name:     aws.String(name),
-		Password: aws.String(d.Get(names.AttrPassword).(string)),
+		Password: aws.String(password),
 		Tags:     getTagsIn(ctx),
 	}
 
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_instance", name="Instance")
+// @Region(overrideEnabled=true)
+func newInstanceListResource() list.ListResourceWithConfigure {
+	return &instanceListResource{}
+}
+
+var _ list.ListResourceWithRawV5Schemas = &instanceListResource{}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *instanceListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_instance"
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customFiltersListBlock(ctx),
+		},
+	}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var config instanceListResourceModel
+	if diags := request.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().EC2Client(ctx)
+
+	var input ec2.DescribeInstancesInput
+	if diags := fwflex.Expand(ctx, config, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Terminated instances cannot be managed.
+	input.Filters = append(input.Filters, newFilter("instance-state-name", enum.Slice(
+		awstypes.InstanceStateNamePending,
+		awstypes.InstanceStateNameRunning,
+		awstypes.InstanceStateNameShuttingDown,
+		awstypes.InstanceStateNameStopping,
+		awstypes.InstanceStateNameStopped,
+	)))
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := ec2.NewDescribeInstancesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("listing EC2 Instances", err.Error())
+				yield(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			for _, reservation := range page.Reservations {
+				for _, instance := range reservation.Instances {
+					id := aws.ToString(instance.InstanceId)
+					result := request.NewListResult(ctx)
+					result.DisplayName = instanceDisplayName(instance)
+
+					if !l.SetResult(ctx, request, id, &result) {
+						continue
+					}
+
+					if !yield(result) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+type instanceListResourceModel struct {
+	framework.WithRegionModel
+	Filters fwtypes.ListNestedObjectValueOf[customListFilterModel] `tfsdk:"filter"`
+}
+
+// instanceDisplayName returns the instance's ID and, if present, its Name tag.
+func instanceDisplayName(instance awstypes.Instance) string {
+	id := aws.ToString(instance.InstanceId)
+
+	for _, tag := range instance.Tags {
+		if aws.ToString(tag.Key) == "Name" {
+			return fmt.Sprintf("%s (%s)", aws.ToString(tag.Value), id)
+		}
+	}
+
+	return id
+}
@@ -193,8 +193,8 @@ func resourceVerifiedAccessGroupUpdate(ctx context.Context, d *schema.ResourceDa
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
 
-		if d.HasChange("verified_access_instance_id") {
-			input.VerifiedAccessInstanceId = aws.String(d.Get(names.AttrDescription).(string))
+		if d.HasChange("verifiedaccess_instance_id") {
+			input.VerifiedAccessInstanceId = aws.String(d.Get("verifiedaccess_instance_id").(string))
 		}
 
 		_, err := conn.ModifyVerifiedAccessGroup(ctx, input)
@@ -206,9 +206,14 @@ func resourceVerifiedAccessGroupUpdate(ctx context.Context, d *schema.ResourceDa
 
 	if d.HasChange("policy_document") {
 		in := &ec2.ModifyVerifiedAccessGroupPolicyInput{
-			PolicyDocument:        aws.String(d.Get("policy_document").(string)),
 			VerifiedAccessGroupId: aws.String(d.Id()),
-			PolicyEnabled:         aws.Bool(true),
+		}
+
+		if v := d.Get("policy_document").(string); v != "" {
+			in.PolicyEnabled = aws.Bool(true)
+			in.PolicyDocument = aws.String(v)
+		} else {
+			in.PolicyEnabled = aws.Bool(false)
 		}
 
 		_, err := conn.ModifyVerifiedAccessGroupPolicy(ctx, in)
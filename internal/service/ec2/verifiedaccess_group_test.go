@@ -378,6 +378,14 @@ func testAccVerifiedAccessGroup_setPolicy(t *testing.T, semaphore tfsync.Semapho
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{},
 			},
+			{
+				Config: testAccVerifiedAccessGroupConfig_policy(rName, description, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, description),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", ""),
+				),
+			},
 		},
 	})
 }
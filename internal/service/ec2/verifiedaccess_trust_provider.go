@@ -52,13 +52,18 @@ func resourceVerifiedAccessTrustProvider() *schema.Resource {
 			},
 			"device_options": {
 				Type:     schema.TypeList,
-				ForceNew: true,
 				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"public_signing_key_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
 						"tenant_id": {
 							Type:     schema.TypeString,
+							ForceNew: true,
 							Optional: true,
 						},
 					},
@@ -239,6 +244,12 @@ func resourceVerifiedAccessTrustProviderUpdate(ctx context.Context, d *schema.Re
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
 
+		if d.HasChange("device_options") {
+			if v, ok := d.GetOk("device_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DeviceOptions = expandModifyVerifiedAccessTrustProviderDeviceOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("oidc_options") {
 			if v, ok := d.GetOk("oidc_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.OidcOptions = expandModifyVerifiedAccessTrustProviderOIDCOptions(v.([]interface{})[0].(map[string]interface{}))
@@ -283,6 +294,9 @@ func flattenDeviceOptions(apiObject *types.DeviceOptions) []interface{} {
 
 	tfMap := map[string]interface{}{}
 
+	if v := apiObject.PublicSigningKeyUrl; v != nil {
+		tfMap["public_signing_key_url"] = aws.ToString(v)
+	}
 	if v := apiObject.TenantId; v != nil {
 		tfMap["tenant_id"] = aws.ToString(v)
 	}
@@ -328,6 +342,9 @@ func expandCreateVerifiedAccessTrustProviderDeviceOptions(tfMap map[string]inter
 
 	apiObject := &types.CreateVerifiedAccessTrustProviderDeviceOptions{}
 
+	if v, ok := tfMap["public_signing_key_url"].(string); ok && v != "" {
+		apiObject.PublicSigningKeyUrl = aws.String(v)
+	}
 	if v, ok := tfMap["tenant_id"].(string); ok && v != "" {
 		apiObject.TenantId = aws.String(v)
 	}
@@ -335,6 +352,20 @@ func expandCreateVerifiedAccessTrustProviderDeviceOptions(tfMap map[string]inter
 	return apiObject
 }
 
+func expandModifyVerifiedAccessTrustProviderDeviceOptions(tfMap map[string]interface{}) *types.ModifyVerifiedAccessTrustProviderDeviceOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ModifyVerifiedAccessTrustProviderDeviceOptions{}
+
+	if v, ok := tfMap["public_signing_key_url"].(string); ok && v != "" {
+		apiObject.PublicSigningKeyUrl = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandCreateVerifiedAccessTrustProviderOIDCOptions(tfMap map[string]interface{}) *types.CreateVerifiedAccessTrustProviderOidcOptions {
 	if tfMap == nil {
 		return nil
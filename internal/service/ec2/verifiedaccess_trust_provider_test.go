@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -99,6 +100,54 @@ func TestAccVerifiedAccessTrustProvider_deviceOptions(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedAccessTrustProvider_deviceOptionsPublicSigningKeyURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 types.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+
+	trustProviderType := "device"
+	deviceTrustProviderType := "crowdstrike"
+	publicSigningKeyURL1 := "https://example.com/" + sdkacctest.RandString(10)
+	publicSigningKeyURL2 := "https://example.com/" + sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccess(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessTrustProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_deviceOptionsPublicSigningKeyURL("test", trustProviderType, deviceTrustProviderType, publicSigningKeyURL1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "device_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_options.0.public_signing_key_url", publicSigningKeyURL1),
+					resource.TestCheckResourceAttr(resourceName, "device_trust_provider_type", deviceTrustProviderType),
+					resource.TestCheckResourceAttr(resourceName, "trust_provider_type", trustProviderType),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_deviceOptionsPublicSigningKeyURL("test", trustProviderType, deviceTrustProviderType, publicSigningKeyURL2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(ctx, resourceName, &v2),
+					testAccCheckVerifiedAccessTrustProviderNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "device_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_options.0.public_signing_key_url", publicSigningKeyURL2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVerifiedAccessTrustProvider_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessTrustProvider
@@ -257,6 +306,16 @@ func testAccCheckVerifiedAccessTrustProviderExists(ctx context.Context, n string
 	}
 }
 
+func testAccCheckVerifiedAccessTrustProviderNotRecreated(before, after *types.VerifiedAccessTrustProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.VerifiedAccessTrustProviderId), aws.ToString(after.VerifiedAccessTrustProviderId); before != after {
+			return fmt.Errorf("Verified Access Trust Provider (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckVerifiedAccessTrustProviderDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
@@ -321,6 +380,19 @@ resource "aws_verifiedaccess_trust_provider" "test" {
 `, policyReferenceName, trustProviderType, deviceTrustProviderType, tenantId)
 }
 
+func testAccVerifiedAccessTrustProviderConfig_deviceOptionsPublicSigningKeyURL(policyReferenceName, trustProviderType, deviceTrustProviderType, publicSigningKeyURL string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  device_options {
+    public_signing_key_url = %[4]q
+  }
+  device_trust_provider_type = %[3]q
+  policy_reference_name      = %[1]q
+  trust_provider_type        = %[2]q
+}
+`, policyReferenceName, trustProviderType, deviceTrustProviderType, publicSigningKeyURL)
+}
+
 func testAccVerifiedAccessTrustProviderConfig_oidcOptions(policyReferenceName, trustProviderType, userTrustProviderType, authorizationEndpoint, clientId, clientSecret, issuer, scope, tokenEndpoint, userInfoEndpoint string) string {
 	return fmt.Sprintf(`
 resource "aws_verifiedaccess_trust_provider" "test" {
@@ -5,7 +5,9 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -40,6 +42,11 @@ func resourcePullThroughCacheRule() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"custom_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"ecr_repository_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -60,7 +67,15 @@ func resourcePullThroughCacheRule() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"upstream_repository_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 		},
+
+		CustomizeDiff: resourcePullThroughCacheRuleCustomizeDiff,
 	}
 }
 
@@ -78,6 +93,14 @@ func resourcePullThroughCacheRuleCreate(ctx context.Context, d *schema.ResourceD
 		input.CredentialArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		input.CustomRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("upstream_repository_prefix"); ok {
+		input.UpstreamRepositoryPrefix = aws.String(v.(string))
+	}
+
 	_, err := conn.CreatePullThroughCacheRule(ctx, input)
 
 	if err != nil {
@@ -106,9 +129,11 @@ func resourcePullThroughCacheRuleRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.Set("credential_arn", rule.CredentialArn)
+	d.Set("custom_role_arn", rule.CustomRoleArn)
 	d.Set("ecr_repository_prefix", rule.EcrRepositoryPrefix)
 	d.Set("registry_id", rule.RegistryId)
 	d.Set("upstream_registry_url", rule.UpstreamRegistryUrl)
+	d.Set("upstream_repository_prefix", rule.UpstreamRepositoryPrefix)
 
 	return diags
 }
@@ -119,10 +144,17 @@ func resourcePullThroughCacheRuleUpdate(ctx context.Context, d *schema.ResourceD
 
 	repositoryPrefix := d.Get("ecr_repository_prefix").(string)
 	input := &ecr.UpdatePullThroughCacheRuleInput{
-		CredentialArn:       aws.String(d.Get("credential_arn").(string)),
 		EcrRepositoryPrefix: aws.String(repositoryPrefix),
 	}
 
+	if v, ok := d.GetOk("credential_arn"); ok {
+		input.CredentialArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_role_arn"); ok {
+		input.CustomRoleArn = aws.String(v.(string))
+	}
+
 	_, err := conn.UpdatePullThroughCacheRule(ctx, input)
 
 	if err != nil {
@@ -155,6 +187,62 @@ func resourcePullThroughCacheRuleDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func resourcePullThroughCacheRuleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	url := d.Get("upstream_registry_url").(string)
+	if url == "" {
+		// Not yet known.
+		return nil
+	}
+
+	upstreamRegistry, ok := upstreamRegistryFromURL(url)
+	if !ok {
+		return fmt.Errorf("upstream_registry_url (%s) is not a supported upstream registry", url)
+	}
+
+	credentialARN, customRoleARN := d.Get("credential_arn").(string), d.Get("custom_role_arn").(string)
+
+	switch upstreamRegistry {
+	case types.UpstreamRegistryDockerHub, types.UpstreamRegistryGitHubContainerRegistry, types.UpstreamRegistryGitLabContainerRegistry, types.UpstreamRegistryAzureContainerRegistry:
+		if credentialARN == "" && d.NewValueKnown("credential_arn") {
+			return fmt.Errorf("credential_arn is required for upstream registry %s", upstreamRegistry)
+		}
+	case types.UpstreamRegistryEcr:
+		if credentialARN != "" {
+			return fmt.Errorf("credential_arn is not supported for upstream registry %s", upstreamRegistry)
+		}
+	}
+
+	if customRoleARN != "" && upstreamRegistry != types.UpstreamRegistryEcr {
+		return fmt.Errorf("custom_role_arn is only supported for upstream registry %s", types.UpstreamRegistryEcr)
+	}
+
+	return nil
+}
+
+// upstreamRegistryFromURL returns the upstream registry type for the specified registry URL.
+func upstreamRegistryFromURL(url string) (types.UpstreamRegistry, bool) {
+	switch {
+	case regexache.MustCompile(`^[0-9]{12}\.dkr\.ecr\.[0-9a-z-]+\.amazonaws\.com(\.cn)?$`).MatchString(url):
+		return types.UpstreamRegistryEcr, true
+	case url == "public.ecr.aws":
+		return types.UpstreamRegistryEcrPublic, true
+	case url == "registry-1.docker.io":
+		return types.UpstreamRegistryDockerHub, true
+	case url == "ghcr.io":
+		return types.UpstreamRegistryGitHubContainerRegistry, true
+	case url == "registry.gitlab.com":
+		return types.UpstreamRegistryGitLabContainerRegistry, true
+	case url == "registry.k8s.io":
+		return types.UpstreamRegistryK8s, true
+	case url == "quay.io":
+		return types.UpstreamRegistryQuay, true
+	case strings.HasSuffix(url, ".azurecr.io"):
+		return types.UpstreamRegistryAzureContainerRegistry, true
+	}
+
+	return "", false
+}
+
 func findPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.Client, repositoryPrefix string) (*types.PullThroughCacheRule, error) {
 	input := &ecr.DescribePullThroughCacheRulesInput{
 		EcrRepositoryPrefixes: []string{repositoryPrefix},
@@ -5,7 +5,9 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -57,6 +59,7 @@ func resourceRegistryScanningConfiguration() *schema.Resource {
 										ValidateFunc: validation.All(
 											validation.StringLenBetween(1, 256),
 											validation.StringMatch(regexache.MustCompile(`^[0-9a-z*](?:[0-9a-z_./*-]?[0-9a-z*]+)*$`), "must contain only lowercase alphanumeric, dot, underscore, hyphen, wildcard, and colon characters"),
+											validation.StringDoesNotMatch(regexache.MustCompile(`\*\*`), "must not contain consecutive wildcard characters"),
 										),
 									},
 									"filter_type": {
@@ -81,6 +84,8 @@ func resourceRegistryScanningConfiguration() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[types.ScanType](),
 			},
 		},
+
+		CustomizeDiff: resourceRegistryScanningConfigurationCustomizeDiff,
 	}
 }
 
@@ -148,6 +153,44 @@ func resourceRegistryScanningConfigurationDelete(ctx context.Context, d *schema.
 	return diags
 }
 
+func resourceRegistryScanningConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("scan_type") || !d.NewValueKnown(names.AttrRule) {
+		return nil
+	}
+
+	scanType := types.ScanType(d.Get("scan_type").(string))
+
+	for _, tfMapRaw := range d.Get(names.AttrRule).(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		scanFrequency := types.ScanFrequency(tfMap["scan_frequency"].(string))
+		if scanFrequency == "" {
+			continue
+		}
+
+		if !slices.Contains(registryScanningConfigurationScanFrequencies(scanType), scanFrequency) {
+			return fmt.Errorf("scan_frequency %s is not supported for scan_type %s", scanFrequency, scanType)
+		}
+	}
+
+	return nil
+}
+
+// registryScanningConfigurationScanFrequencies returns the scan frequencies supported by registry scanning rules for the specified scan type.
+func registryScanningConfigurationScanFrequencies(scanType types.ScanType) []types.ScanFrequency {
+	switch scanType {
+	case types.ScanTypeBasic:
+		return []types.ScanFrequency{types.ScanFrequencyScanOnPush}
+	case types.ScanTypeEnhanced:
+		return []types.ScanFrequency{types.ScanFrequencyScanOnPush, types.ScanFrequencyContinuousScan}
+	default:
+		return nil
+	}
+}
+
 func findRegistryScanningConfiguration(ctx context.Context, conn *ecr.Client) (*ecr.GetRegistryScanningConfigurationOutput, error) {
 	input := &ecr.GetRegistryScanningConfigurationInput{}
 
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRegistryScanningConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecr_registry_scanning_configuration.test"
+	resourceName := "aws_ecr_registry_scanning_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegistryScanningConfigurationDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(ctx, dataSourceName, "registry_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtRulePound, resourceName, acctest.CtRulePound),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rule.*", map[string]string{
+						"scan_frequency": "CONTINUOUS_SCAN",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "rule.*.repository_filter.*", map[string]string{
+						names.AttrFilter: "example",
+						"filter_type":    "WILDCARD",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scan_type", resourceName, "scan_type"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegistryScanningConfigurationDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccRegistryScanningConfigurationConfig_twoRules(), `
+data "aws_ecr_registry_scanning_configuration" "test" {
+  depends_on = [aws_ecr_registry_scanning_configuration.test]
+}
+`)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -23,6 +24,8 @@ func TestAccECRScanningConfiguration_serial(t *testing.T) {
 	testCases := map[string]func(t *testing.T){
 		acctest.CtBasic: testAccRegistryScanningConfiguration_basic,
 		"update":        testAccRegistryScanningConfiguration_update,
+		"validation":    testAccRegistryScanningConfiguration_validation,
+		"dataSource":    testAccRegistryScanningConfigurationDataSource_basic,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -124,6 +127,31 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	})
 }
 
+func testAccRegistryScanningConfiguration_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_rule("BASIC", "CONTINUOUS_SCAN", "example"),
+				ExpectError: regexache.MustCompile(`scan_frequency CONTINUOUS_SCAN is not supported for scan_type BASIC`),
+			},
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_rule("ENHANCED", "MANUAL", "example"),
+				ExpectError: regexache.MustCompile(`scan_frequency MANUAL is not supported for scan_type ENHANCED`),
+			},
+			{
+				Config:      testAccRegistryScanningConfigurationConfig_rule("ENHANCED", "SCAN_ON_PUSH", "example**"),
+				ExpectError: regexache.MustCompile(`must not contain consecutive wildcard characters`),
+			},
+		},
+	})
+}
+
 func testAccRegistryScanningConfigurationExists(ctx context.Context, n string, v *ecr.GetRegistryScanningConfigurationOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -168,6 +196,21 @@ resource "aws_ecr_registry_scanning_configuration" "test" {
 `
 }
 
+func testAccRegistryScanningConfigurationConfig_rule(scanType, scanFrequency, filter string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_registry_scanning_configuration" "test" {
+  scan_type = %[1]q
+  rule {
+    scan_frequency = %[2]q
+    repository_filter {
+      filter      = %[3]q
+      filter_type = "WILDCARD"
+    }
+  }
+}
+`, scanType, scanFrequency, filter)
+}
+
 func testAccRegistryScanningConfigurationConfig_twoRules() string {
 	return `
 resource "aws_ecr_registry_scanning_configuration" "test" {
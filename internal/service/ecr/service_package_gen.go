@@ -53,6 +53,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ecr_pull_through_cache_rule",
 			Name:     "Pull Through Cache Rule",
 		},
+		{
+			Factory:  dataSourceRegistryScanningConfiguration,
+			TypeName: "aws_ecr_registry_scanning_configuration",
+			Name:     "Registry Scanning Configuration",
+		},
 		{
 			Factory:  dataSourceRepository,
 			TypeName: "aws_ecr_repository",
@@ -399,6 +399,7 @@ func resourceReplicationGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			replicationGroupValidateMultiAZAutomaticFailover,
+			replicationGroupValidateClusterModeChange,
 			customizeDiffEngineVersionForceNewOnDowngrade,
 			customdiff.ForceNewIf(names.AttrEngine, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				if !diff.HasChange(names.AttrEngine) {
@@ -409,6 +410,12 @@ func resourceReplicationGroup() *schema.Resource {
 				}
 				return true
 			}),
+			customdiff.ComputedIf("cluster_enabled", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("cluster_mode")
+			}),
+			customdiff.ComputedIf("configuration_endpoint_address", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("cluster_mode")
+			}),
 			customdiff.ComputedIf("member_clusters", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("num_cache_clusters") ||
 					diff.HasChange("num_node_groups") ||
@@ -832,8 +839,18 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			requestUpdate = true
 		}
 
+		// Cluster mode can only be enabled from compatible cluster mode, so a disabled to enabled
+		// migration is performed in two steps: first to compatible and then, once all other
+		// modifications have been applied, to enabled.
+		var enableClusterMode bool
 		if d.HasChange("cluster_mode") {
-			input.ClusterMode = awstypes.ClusterMode(d.Get("cluster_mode").(string))
+			o, n := d.GetChange("cluster_mode")
+			if old, new := awstypes.ClusterMode(o.(string)), awstypes.ClusterMode(n.(string)); old == awstypes.ClusterModeDisabled && new == awstypes.ClusterModeEnabled {
+				input.ClusterMode = awstypes.ClusterModeCompatible
+				enableClusterMode = true
+			} else {
+				input.ClusterMode = new
+			}
 			requestUpdate = true
 		}
 
@@ -986,6 +1003,12 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
+		if enableClusterMode {
+			if err := modifyReplicationGroupClusterMode(ctx, conn, d.Id(), awstypes.ClusterModeEnabled, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
 		if d.HasChanges("auth_token", "auth_token_update_strategy") {
 			input := &elasticache.ModifyReplicationGroupInput{
 				ApplyImmediately:        aws.Bool(true),
@@ -1075,6 +1098,31 @@ func resourceReplicationGroupDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func modifyReplicationGroupClusterMode(ctx context.Context, conn *elasticache.Client, replicationGroupID string, clusterMode awstypes.ClusterMode, timeout time.Duration) error {
+	input := &elasticache.ModifyReplicationGroupInput{
+		ApplyImmediately:   aws.Bool(true),
+		ClusterMode:        clusterMode,
+		ReplicationGroupId: aws.String(replicationGroupID),
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.InvalidReplicationGroupStateFault](ctx, timeout, func() (interface{}, error) {
+		return conn.ModifyReplicationGroup(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("modifying ElastiCache Replication Group (%s) cluster mode (%s): %w", replicationGroupID, clusterMode, err)
+	}
+
+	const (
+		delay = 30 * time.Second
+	)
+	if _, err := waitReplicationGroupAvailable(ctx, conn, replicationGroupID, timeout, delay); err != nil {
+		return fmt.Errorf("waiting for ElastiCache Replication Group (%s) cluster mode (%s) update: %w", replicationGroupID, clusterMode, err)
+	}
+
+	return nil
+}
+
 func disassociateReplicationGroup(ctx context.Context, conn *elasticache.Client, globalReplicationGroupID, replicationGroupID, region string, timeout time.Duration) error {
 	input := &elasticache.DisassociateGlobalReplicationGroupInput{
 		GlobalReplicationGroupId: aws.String(globalReplicationGroupID),
@@ -1454,6 +1502,17 @@ func replicationGroupValidateMultiAZAutomaticFailover(_ context.Context, diff *s
 	return nil
 }
 
+// replicationGroupValidateClusterModeChange validates that `cluster_mode` is not changed from `enabled` to any other value
+func replicationGroupValidateClusterModeChange(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("cluster_mode") {
+		return nil
+	}
+	if old, new := diff.GetChange("cluster_mode"); awstypes.ClusterMode(old.(string)) == awstypes.ClusterModeEnabled && awstypes.ClusterMode(new.(string)) != awstypes.ClusterModeEnabled {
+		return fmt.Errorf(`"cluster_mode": cannot be changed from %q to %q`, old, new)
+	}
+	return nil
+}
+
 // replicationGroupValidateAutomaticFailoverNumCacheClusters validates that `automatic_failover_enabled` is set when `multi_az_enabled` is true
 func replicationGroupValidateAutomaticFailoverNumCacheClusters(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	if v := diff.Get("automatic_failover_enabled").(bool); !v {
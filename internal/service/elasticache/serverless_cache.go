@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
@@ -15,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -76,6 +79,9 @@ func (r *serverlessCacheResource) Schema(ctx context.Context, request resource.S
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`), "must be in the format HH:MM"),
+				},
 			},
 			names.AttrDescription: schema.StringAttribute{
 				Optional: true,
@@ -102,6 +108,12 @@ func (r *serverlessCacheResource) Schema(ctx context.Context, request resource.S
 					}, "Replace engine diff", "Replace engine diff"),
 				},
 			},
+			"final_snapshot_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
 			"full_engine_version": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -200,9 +212,15 @@ func (r *serverlessCacheResource) Schema(ctx context.Context, request resource.S
 								Attributes: map[string]schema.Attribute{
 									"maximum": schema.Int64Attribute{
 										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 5000),
+										},
 									},
 									"minimum": schema.Int64Attribute{
 										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 5000),
+										},
 									},
 									names.AttrUnit: schema.StringAttribute{
 										CustomType: fwtypes.StringEnumType[awstypes.DataStorageUnit](),
@@ -403,7 +421,7 @@ func (r *serverlessCacheResource) Delete(ctx context.Context, request resource.D
 
 	input := &elasticache.DeleteServerlessCacheInput{
 		ServerlessCacheName: fwflex.StringFromFramework(ctx, data.ID),
-		FinalSnapshotName:   nil,
+		FinalSnapshotName:   fwflex.StringFromFramework(ctx, data.FinalSnapshotName),
 	}
 
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 5*time.Minute, func() (interface{}, error) {
@@ -427,6 +445,26 @@ func (r *serverlessCacheResource) Delete(ctx context.Context, request resource.D
 	}
 }
 
+func (r *serverlessCacheResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var engine, finalSnapshotName types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrEngine), &engine)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("final_snapshot_name"), &finalSnapshotName)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Snapshots are only supported for Redis OSS and Valkey.
+	if engine.ValueString() == engineMemcached && !finalSnapshotName.IsNull() {
+		response.Diagnostics.Append(
+			fwdiag.NewAttributeConflictsWhenError(
+				path.Root("final_snapshot_name"),
+				path.Root(names.AttrEngine),
+				engineMemcached,
+			),
+		)
+	}
+}
+
 func (r *serverlessCacheResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
 }
@@ -549,6 +587,7 @@ type serverlessCacheResourceModel struct {
 	Description            types.String                                           `tfsdk:"description"`
 	Endpoint               fwtypes.ListNestedObjectValueOf[endpointModel]         `tfsdk:"endpoint"`
 	Engine                 types.String                                           `tfsdk:"engine"`
+	FinalSnapshotName      types.String                                           `tfsdk:"final_snapshot_name"`
 	FullEngineVersion      types.String                                           `tfsdk:"full_engine_version"`
 	ID                     types.String                                           `tfsdk:"id"`
 	KmsKeyID               types.String                                           `tfsdk:"kms_key_id"`
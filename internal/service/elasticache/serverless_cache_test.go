@@ -9,10 +9,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -442,6 +445,106 @@ func TestAccElastiCacheServerlessCache_update_RedisToValkey(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheServerlessCache_cacheUsageLimitsUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+	var v1, v2 awstypes.ServerlessCache
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_cacheUsageLimits(rName, 10, 10000, "03:00", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.data_storage.0.maximum", "10"),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.ecpu_per_second.0.maximum", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "daily_snapshot_time", "03:00"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "1"),
+				),
+			},
+			{
+				Config: testAccServerlessCacheConfig_cacheUsageLimits(rName, 20, 20000, "05:30", 7),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &v2),
+					testAccCheckServerlessCacheNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.data_storage.0.maximum", "20"),
+					resource.TestCheckResourceAttr(resourceName, "cache_usage_limits.0.ecpu_per_second.0.maximum", "20000"),
+					resource.TestCheckResourceAttr(resourceName, "daily_snapshot_time", "05:30"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_retention_limit", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheServerlessCache_finalSnapshotName(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_serverless_cache.test"
+	var serverlessElasticCache awstypes.ServerlessCache
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckServerlessCacheDestroy(ctx),
+			testAccCheckServerlessCacheFinalSnapshotExists(ctx, rName),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerlessCacheConfig_finalSnapshotName(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServerlessCacheExists(ctx, resourceName, &serverlessElasticCache),
+					resource.TestCheckResourceAttr(resourceName, "final_snapshot_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"final_snapshot_name"},
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheServerlessCache_finalSnapshotNameMemcached(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerlessCacheDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServerlessCacheConfig_finalSnapshotNameMemcached(rName),
+				ExpectError: regexache.MustCompile(`Attribute "final_snapshot_name" cannot be specified when "engine" is\s+"memcached"`),
+			},
+		},
+	})
+}
+
 func TestAccElastiCacheServerlessCache_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -580,6 +683,35 @@ func testAccCheckServerlessCacheDestroy(ctx context.Context) resource.TestCheckF
 	}
 }
 
+// testAccCheckServerlessCacheFinalSnapshotExists verifies that the final snapshot was created on destroy and then deletes it.
+func testAccCheckServerlessCacheFinalSnapshotExists(ctx context.Context, snapshotName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheClient(ctx)
+
+		output, err := conn.DescribeServerlessCacheSnapshots(ctx, &elasticache.DescribeServerlessCacheSnapshotsInput{
+			ServerlessCacheSnapshotName: aws.String(snapshotName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading ElastiCache Serverless Cache Snapshot (%s): %w", snapshotName, err)
+		}
+
+		if len(output.ServerlessCacheSnapshots) == 0 {
+			return fmt.Errorf("ElastiCache Serverless Cache Snapshot (%s) not found", snapshotName)
+		}
+
+		_, err = conn.DeleteServerlessCacheSnapshot(ctx, &elasticache.DeleteServerlessCacheSnapshotInput{
+			ServerlessCacheSnapshotName: aws.String(snapshotName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting ElastiCache Serverless Cache Snapshot (%s): %w", snapshotName, err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckServerlessCacheNotRecreated(i, j *awstypes.ServerlessCache) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if !aws.ToTime(i.CreateTime).Equal(aws.ToTime(j.CreateTime)) {
@@ -626,6 +758,48 @@ resource "aws_elasticache_serverless_cache" "test" {
 `, rName)
 }
 
+func testAccServerlessCacheConfig_cacheUsageLimits(rName string, dataStorageMax, ecpuMax int, dailySnapshotTime string, snapshotRetentionLimit int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_serverless_cache" "test" {
+  engine = "redis"
+  name   = %[1]q
+
+  cache_usage_limits {
+    data_storage {
+      maximum = %[2]d
+      unit    = "GB"
+    }
+    ecpu_per_second {
+      maximum = %[3]d
+    }
+  }
+
+  daily_snapshot_time      = %[4]q
+  snapshot_retention_limit = %[5]d
+}
+`, rName, dataStorageMax, ecpuMax, dailySnapshotTime, snapshotRetentionLimit)
+}
+
+func testAccServerlessCacheConfig_finalSnapshotName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_serverless_cache" "test" {
+  engine              = "redis"
+  name                = %[1]q
+  final_snapshot_name = %[1]q
+}
+`, rName)
+}
+
+func testAccServerlessCacheConfig_finalSnapshotNameMemcached(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_serverless_cache" "test" {
+  engine              = "memcached"
+  name                = %[1]q
+  final_snapshot_name = %[1]q
+}
+`, rName)
+}
+
 func testAccServerlessCacheConfig_full(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 resource "aws_elasticache_serverless_cache" "test" {
@@ -5,7 +5,9 @@ package fsx
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -252,6 +255,7 @@ func resourceONTAPFileSystem() *schema.Resource {
 			verify.SetTagsDiff,
 			resourceONTAPFileSystemThroughputCapacityPerHAPairCustomizeDiff,
 			resourceONTAPFileSystemHAPairsCustomizeDiff,
+			resourceONTAPFileSystemDeploymentTypeCustomizeDiff,
 		),
 	}
 }
@@ -284,6 +288,69 @@ func resourceONTAPFileSystemHAPairsCustomizeDiff(_ context.Context, d *schema.Re
 	return nil
 }
 
+func resourceONTAPFileSystemDeploymentTypeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	// Scale-out file systems (multiple HA pairs) and the per-HA pair throughput capacity values depend on the deployment type.
+	if !d.NewValueKnown("deployment_type") {
+		return nil
+	}
+
+	deploymentType := awstypes.OntapDeploymentType(d.Get("deployment_type").(string))
+
+	if d.NewValueKnown("ha_pairs") {
+		if v := d.Get("ha_pairs").(int); v > 1 && deploymentType != awstypes.OntapDeploymentTypeSingleAz2 {
+			return fmt.Errorf("ha_pairs (%d) must be 1 when deployment_type is %q, multiple HA pairs are only supported with %q", v, deploymentType, awstypes.OntapDeploymentTypeSingleAz2)
+		}
+	}
+
+	// FSx defaults to a single HA pair.
+	haPairs := 1
+	if d.NewValueKnown("ha_pairs") {
+		haPairs = max(d.Get("ha_pairs").(int), 1)
+	}
+
+	validValues := ontapThroughputCapacityPerHAPairValues(deploymentType, haPairs)
+	if len(validValues) == 0 {
+		return nil
+	}
+
+	for _, key := range []string{"throughput_capacity", "throughput_capacity_per_ha_pair"} {
+		// Only validate configured values, the computed value of the other argument is derived by FSx.
+		if !d.NewValueKnown(key) || d.GetRawConfig().GetAttr(key).IsNull() {
+			continue
+		}
+
+		values := validValues
+		// throughput_capacity is the total across all HA pairs.
+		if key == "throughput_capacity" {
+			values = tfslices.ApplyToAll(validValues, func(v int) int {
+				return v * haPairs
+			})
+		}
+
+		if v := d.Get(key).(int); v != 0 && !slices.Contains(values, v) {
+			return fmt.Errorf("%s (%d) is not supported when deployment_type is %q and ha_pairs is %d, valid values are %v", key, v, deploymentType, haPairs, values)
+		}
+	}
+
+	return nil
+}
+
+func ontapThroughputCapacityPerHAPairValues(deploymentType awstypes.OntapDeploymentType, haPairs int) []int {
+	switch deploymentType {
+	case awstypes.OntapDeploymentTypeSingleAz1, awstypes.OntapDeploymentTypeMultiAz1:
+		return []int{128, 256, 512, 1024, 2048, 4096}
+	case awstypes.OntapDeploymentTypeSingleAz2:
+		if haPairs > 1 {
+			return []int{1536, 3072, 6144}
+		}
+		return []int{384, 768, 1536, 3072, 6144}
+	case awstypes.OntapDeploymentTypeMultiAz2:
+		return []int{384, 768, 1536, 3072, 6144}
+	default:
+		return nil
+	}
+}
+
 func resourceONTAPFileSystemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FSxClient(ctx)
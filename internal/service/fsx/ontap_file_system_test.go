@@ -258,6 +258,36 @@ func TestAccFSxONTAPFileSystem_haPair_increase(t *testing.T) {
 	})
 }
 
+func TestAccFSxONTAPFileSystem_haPair_deploymentTypeValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckONTAPFileSystemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccONTAPFileSystemConfig_deploymentTypeHAPairs(rName, string(awstypes.OntapDeploymentTypeSingleAz1), 2, 128),
+				ExpectError: regexache.MustCompile(`multiple HA pairs are only supported with "SINGLE_AZ_2"`),
+			},
+			{
+				Config:      testAccONTAPFileSystemConfig_deploymentTypeHAPairs(rName, string(awstypes.OntapDeploymentTypeSingleAz2), 2, 384),
+				ExpectError: regexache.MustCompile(`throughput_capacity_per_ha_pair \(384\) is not supported when deployment_type is "SINGLE_AZ_2"`),
+			},
+			{
+				Config:      testAccONTAPFileSystemConfig_deploymentTypeHAPairs(rName, string(awstypes.OntapDeploymentTypeMultiAz2), 1, 256),
+				ExpectError: regexache.MustCompile(`throughput_capacity_per_ha_pair \(256\) is not supported when deployment_type is "MULTI_AZ_2"`),
+			},
+			{
+				Config:      testAccONTAPFileSystemConfig_deploymentTypeHAPairsThroughputCapacity(rName, string(awstypes.OntapDeploymentTypeSingleAz2), 2, 1536),
+				ExpectError: regexache.MustCompile(`throughput_capacity \(1536\) is not supported when deployment_type is "SINGLE_AZ_2" and ha_pairs is 2`),
+			},
+		},
+	})
+}
+
 func TestAccFSxONTAPFileSystem_fsxAdminPassword(t *testing.T) {
 	ctx := acctest.Context(t)
 	var filesystem1, filesystem2 awstypes.FileSystem
@@ -1000,6 +1030,40 @@ resource "aws_fsx_ontap_file_system" "test" {
 `, rName, throughput, capacity, haPairs))
 }
 
+func testAccONTAPFileSystemConfig_deploymentTypeHAPairs(rName, deploymentType string, haPairs, throughput int) string {
+	return acctest.ConfigCompose(testAccONTAPFileSystemConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_ontap_file_system" "test" {
+  storage_capacity                = 2048
+  subnet_ids                      = [aws_subnet.test[0].id]
+  deployment_type                 = %[2]q
+  ha_pairs                        = %[3]d
+  throughput_capacity_per_ha_pair = %[4]d
+  preferred_subnet_id             = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, deploymentType, haPairs, throughput))
+}
+
+func testAccONTAPFileSystemConfig_deploymentTypeHAPairsThroughputCapacity(rName, deploymentType string, haPairs, throughput int) string {
+	return acctest.ConfigCompose(testAccONTAPFileSystemConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_ontap_file_system" "test" {
+  storage_capacity    = 2048
+  subnet_ids          = [aws_subnet.test[0].id]
+  deployment_type     = %[2]q
+  ha_pairs            = %[3]d
+  throughput_capacity = %[4]d
+  preferred_subnet_id = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, deploymentType, haPairs, throughput))
+}
+
 func testAccONTAPFileSystemConfig_haPair(rName string, capacity int) string {
 	return acctest.ConfigCompose(testAccONTAPFileSystemConfig_base(rName), fmt.Sprintf(`
 resource "aws_fsx_ontap_file_system" "test" {
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fsx
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_fsx_openzfs_snapshot_copy", name="OpenZFS Snapshot Copy")
+func resourceOpenZFSSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOpenZFSSnapshotCopyCreate,
+		ReadWithoutTimeout:   resourceOpenZFSSnapshotCopyRead,
+		DeleteWithoutTimeout: resourceOpenZFSSnapshotCopyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"copy_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice(enum.Slice(
+					awstypes.OpenZFSCopyStrategyFullCopy,
+					awstypes.OpenZFSCopyStrategyIncrementalCopy,
+				), false),
+			},
+			"options": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.UpdateOpenZFSVolumeOption](),
+				},
+			},
+			"source_snapshot_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"volume_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(23, 23),
+			},
+		},
+	}
+}
+
+func resourceOpenZFSSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FSxClient(ctx)
+
+	volumeID := d.Get("volume_id").(string)
+	sourceSnapshotARN := d.Get("source_snapshot_arn").(string)
+	input := &fsx.CopySnapshotAndUpdateVolumeInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		SourceSnapshotARN:  aws.String(sourceSnapshotARN),
+		VolumeId:           aws.String(volumeID),
+	}
+
+	if v, ok := d.GetOk("copy_strategy"); ok {
+		input.CopyStrategy = awstypes.OpenZFSCopyStrategy(v.(string))
+	}
+
+	if v, ok := d.GetOk("options"); ok && v.(*schema.Set).Len() > 0 {
+		input.Options = flex.ExpandStringyValueSet[awstypes.UpdateOpenZFSVolumeOption](v.(*schema.Set))
+	}
+
+	_, err := conn.CopySnapshotAndUpdateVolume(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "copying FSx for OpenZFS Snapshot (%s) to Volume (%s): %s", sourceSnapshotARN, volumeID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", volumeID, id.UniqueId()))
+
+	if _, err := waitVolumeAdministrativeActionCompleted(ctx, conn, volumeID, awstypes.AdministrativeActionTypeVolumeUpdateWithSnapshot, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for FSx for OpenZFS Volume (%s) administrative action (%s) complete: %s", volumeID, awstypes.AdministrativeActionTypeVolumeUpdateWithSnapshot, err)
+	}
+
+	return append(diags, resourceOpenZFSSnapshotCopyRead(ctx, d, meta)...)
+}
+
+func resourceOpenZFSSnapshotCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FSxClient(ctx)
+
+	volumeID := d.Get("volume_id").(string)
+	_, err := findOpenZFSVolumeByID(ctx, conn, volumeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FSx for OpenZFS Volume (%s) not found, removing FSx for OpenZFS Snapshot Copy (%s) from state", volumeID, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading FSx for OpenZFS Volume (%s): %s", volumeID, err)
+	}
+
+	return diags
+}
+
+func resourceOpenZFSSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Data copied into the destination volume cannot be rolled back.
+	log.Printf("[DEBUG] Removing FSx for OpenZFS Snapshot Copy (%s) from state", d.Id())
+
+	return diags
+}
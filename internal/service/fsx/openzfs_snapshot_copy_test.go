@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fsx_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccFSxOpenZFSSnapshotCopy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var volume awstypes.Volume
+	resourceName := "aws_fsx_openzfs_snapshot_copy.test"
+	volumeResourceName := "aws_fsx_openzfs_volume.destination"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenZFSVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenZFSSnapshotCopyConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenZFSVolumeExists(ctx, volumeResourceName, &volume),
+					resource.TestCheckResourceAttr(resourceName, "copy_strategy", string(awstypes.OpenZFSCopyStrategyIncrementalCopy)),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "options.*", string(awstypes.UpdateOpenZFSVolumeOptionDeleteIntermediateSnapshots)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_snapshot_arn", "aws_fsx_openzfs_snapshot.incremental", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_id", volumeResourceName, names.AttrID),
+				),
+			},
+			{
+				Config: testAccOpenZFSSnapshotCopyConfig_basic(rName, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction(volumeResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenZFSVolumeExists(ctx, volumeResourceName, &volume),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenZFSSnapshotCopyConfig_basic(rName, run string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_fsx_openzfs_file_system" "source" {
+  storage_capacity    = 64
+  subnet_ids          = [aws_subnet.test[0].id]
+  deployment_type     = "SINGLE_AZ_1"
+  throughput_capacity = 64
+
+  tags = {
+    Name = "%[1]s-source"
+  }
+}
+
+resource "aws_fsx_openzfs_volume" "source" {
+  name             = "%[1]s-source"
+  parent_volume_id = aws_fsx_openzfs_file_system.source.root_volume_id
+}
+
+resource "aws_fsx_openzfs_snapshot" "initial" {
+  name      = "%[1]s-initial"
+  volume_id = aws_fsx_openzfs_volume.source.id
+}
+
+resource "aws_fsx_openzfs_file_system" "destination" {
+  storage_capacity    = 64
+  subnet_ids          = [aws_subnet.test[0].id]
+  deployment_type     = "SINGLE_AZ_1"
+  throughput_capacity = 64
+
+  tags = {
+    Name = "%[1]s-destination"
+  }
+}
+
+resource "aws_fsx_openzfs_volume" "destination" {
+  name             = "%[1]s-destination"
+  parent_volume_id = aws_fsx_openzfs_file_system.destination.root_volume_id
+
+  origin_snapshot {
+    copy_strategy = "FULL_COPY"
+    snapshot_arn  = aws_fsx_openzfs_snapshot.initial.arn
+  }
+}
+
+resource "aws_fsx_openzfs_snapshot" "incremental" {
+  name      = "%[1]s-incremental"
+  volume_id = aws_fsx_openzfs_volume.source.id
+
+  depends_on = [aws_fsx_openzfs_volume.destination]
+}
+
+resource "aws_fsx_openzfs_snapshot_copy" "test" {
+  source_snapshot_arn = aws_fsx_openzfs_snapshot.incremental.arn
+  volume_id           = aws_fsx_openzfs_volume.destination.id
+  copy_strategy       = "INCREMENTAL_COPY"
+  options             = ["DELETE_INTERMEDIATE_SNAPSHOTS"]
+
+  triggers = {
+    run = %[2]q
+  }
+}
+`, rName, run))
+}
@@ -275,6 +275,14 @@ func resourceOpenZFSVolumeCreate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "waiting for FSx for OpenZFS Volume (%s) create: %s", d.Id(), err)
 	}
 
+	// Volumes created from a full or incremental copy of a snapshot, possibly in another Region,
+	// keep transferring data after the volume itself is available.
+	if v := openzfsConfig.OriginSnapshot; v != nil && v.CopyStrategy != awstypes.OpenZFSCopyStrategyClone {
+		if _, err := waitVolumeAdministrativeActionCompleted(ctx, conn, d.Id(), awstypes.AdministrativeActionTypeVolumeInitializeWithSnapshot, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for FSx for OpenZFS Volume (%s) administrative action (%s) complete: %s", d.Id(), awstypes.AdministrativeActionTypeVolumeInitializeWithSnapshot, err)
+		}
+	}
+
 	return append(diags, resourceOpenZFSVolumeRead(ctx, d, meta)...)
 }
 
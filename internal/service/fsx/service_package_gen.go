@@ -127,6 +127,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceOpenZFSSnapshotCopy,
+			TypeName: "aws_fsx_openzfs_snapshot_copy",
+			Name:     "OpenZFS Snapshot Copy",
+		},
 		{
 			Factory:  resourceOpenZFSVolume,
 			TypeName: "aws_fsx_openzfs_volume",
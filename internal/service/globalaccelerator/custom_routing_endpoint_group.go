@@ -5,6 +5,7 @@ package globalaccelerator
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -31,6 +32,7 @@ func resourceCustomRoutingEndpointGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomRoutingEndpointGroupCreate,
 		ReadWithoutTimeout:   resourceCustomRoutingEndpointGroupRead,
+		UpdateWithoutTimeout: resourceCustomRoutingEndpointGroupUpdate,
 		DeleteWithoutTimeout: resourceCustomRoutingEndpointGroupDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -39,6 +41,7 @@ func resourceCustomRoutingEndpointGroup() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -77,9 +80,13 @@ func resourceCustomRoutingEndpointGroup() *schema.Resource {
 			"endpoint_configuration": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"allow_all_traffic": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"endpoint_id": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -152,6 +159,17 @@ func resourceCustomRoutingEndpointGroupCreate(ctx context.Context, d *schema.Res
 		if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Global Accelerator Custom Routing Accelerator (%s) deploy: %s", acceleratorARN, err)
 		}
+
+		// Traffic to new endpoints is denied by default.
+		for endpointID, allow := range customRoutingEndpointsAllowAllTraffic(v.(*schema.Set).List()) {
+			if !allow {
+				continue
+			}
+
+			if err := updateCustomRoutingEndpointTraffic(ctx, conn, d.Id(), endpointID, allow); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
 
 	return append(diags, resourceCustomRoutingEndpointGroupRead(ctx, d, meta)...)
@@ -183,7 +201,9 @@ func resourceCustomRoutingEndpointGroupRead(ctx context.Context, d *schema.Resou
 		return sdkdiag.AppendErrorf(diags, "setting destination_configuration: %s", err)
 	}
 	d.Set("endpoint_group_region", endpointGroup.EndpointGroupRegion)
-	if err := d.Set("endpoint_configuration", flattenCustomRoutingEndpointDescriptions(endpointGroup.EndpointDescriptions)); err != nil {
+	// The traffic state of each endpoint isn't returned by the API.
+	allowAllTraffic := customRoutingEndpointsAllowAllTraffic(d.Get("endpoint_configuration").(*schema.Set).List())
+	if err := d.Set("endpoint_configuration", flattenCustomRoutingEndpointDescriptions(endpointGroup.EndpointDescriptions, allowAllTraffic)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting endpoint_configuration: %s", err)
 	}
 	d.Set("listener_arn", listenerARN)
@@ -191,6 +211,85 @@ func resourceCustomRoutingEndpointGroupRead(ctx context.Context, d *schema.Resou
 	return diags
 }
 
+func resourceCustomRoutingEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+	if d.HasChange("endpoint_configuration") {
+		acceleratorARN, err := listenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		o, n := d.GetChange("endpoint_configuration")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		oldTraffic, newTraffic := customRoutingEndpointsAllowAllTraffic(os.List()), customRoutingEndpointsAllowAllTraffic(ns.List())
+
+		var del []string
+		for endpointID := range oldTraffic {
+			if _, ok := newTraffic[endpointID]; !ok {
+				del = append(del, endpointID)
+			}
+		}
+
+		if len(del) > 0 {
+			input := &globalaccelerator.RemoveCustomRoutingEndpointsInput{
+				EndpointGroupArn: aws.String(d.Id()),
+				EndpointIds:      del,
+			}
+
+			_, err := conn.RemoveCustomRoutingEndpoints(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %s", d.Id(), err)
+			}
+
+			if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Global Accelerator Custom Routing Accelerator (%s) deploy: %s", acceleratorARN, err)
+			}
+		}
+
+		var add []awstypes.CustomRoutingEndpointConfiguration
+		for endpointID := range newTraffic {
+			if _, ok := oldTraffic[endpointID]; !ok {
+				add = append(add, awstypes.CustomRoutingEndpointConfiguration{
+					EndpointId: aws.String(endpointID),
+				})
+			}
+		}
+
+		if len(add) > 0 {
+			input := &globalaccelerator.AddCustomRoutingEndpointsInput{
+				EndpointConfigurations: add,
+				EndpointGroupArn:       aws.String(d.Id()),
+			}
+
+			_, err := conn.AddCustomRoutingEndpoints(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "adding Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %s", d.Id(), err)
+			}
+
+			if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Global Accelerator Custom Routing Accelerator (%s) deploy: %s", acceleratorARN, err)
+			}
+		}
+
+		for endpointID, allow := range newTraffic {
+			// Traffic to new endpoints is denied by default.
+			if v, ok := oldTraffic[endpointID]; (ok && v == allow) || (!ok && !allow) {
+				continue
+			}
+
+			if err := updateCustomRoutingEndpointTraffic(ctx, conn, d.Id(), endpointID, allow); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceCustomRoutingEndpointGroupRead(ctx, d, meta)...)
+}
+
 func resourceCustomRoutingEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
@@ -245,6 +344,53 @@ func findCustomRoutingEndpointGroupByARN(ctx context.Context, conn *globalaccele
 	return output.EndpointGroup, nil
 }
 
+func updateCustomRoutingEndpointTraffic(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID string, allow bool) error {
+	if allow {
+		input := &globalaccelerator.AllowCustomRoutingTrafficInput{
+			AllowAllTrafficToEndpoint: aws.Bool(true),
+			EndpointGroupArn:          aws.String(endpointGroupARN),
+			EndpointId:                aws.String(endpointID),
+		}
+
+		if _, err := conn.AllowCustomRoutingTraffic(ctx, input); err != nil {
+			return fmt.Errorf("allowing Global Accelerator Custom Routing Endpoint Group (%s) traffic to endpoint (%s): %w", endpointGroupARN, endpointID, err)
+		}
+
+		return nil
+	}
+
+	input := &globalaccelerator.DenyCustomRoutingTrafficInput{
+		DenyAllTrafficToEndpoint: aws.Bool(true),
+		EndpointGroupArn:         aws.String(endpointGroupARN),
+		EndpointId:               aws.String(endpointID),
+	}
+
+	if _, err := conn.DenyCustomRoutingTraffic(ctx, input); err != nil {
+		return fmt.Errorf("denying Global Accelerator Custom Routing Endpoint Group (%s) traffic to endpoint (%s): %w", endpointGroupARN, endpointID, err)
+	}
+
+	return nil
+}
+
+// customRoutingEndpointsAllowAllTraffic returns a map of endpoint ID to whether all traffic to the endpoint is allowed.
+func customRoutingEndpointsAllowAllTraffic(tfList []interface{}) map[string]bool {
+	m := make(map[string]bool)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+			m[v], _ = tfMap["allow_all_traffic"].(bool)
+		}
+	}
+
+	return m
+}
+
 func expandCustomRoutingEndpointDestinationConfiguration(tfMap map[string]interface{}) *awstypes.CustomRoutingDestinationConfiguration {
 	if tfMap == nil {
 		return nil
@@ -369,7 +515,7 @@ func flattenCustomRoutingDestinationDescriptions(apiObjects []awstypes.CustomRou
 	return tfList
 }
 
-func flattenCustomRoutingEndpointDescription(apiObject *awstypes.CustomRoutingEndpointDescription) map[string]interface{} {
+func flattenCustomRoutingEndpointDescription(apiObject *awstypes.CustomRoutingEndpointDescription, allowAllTraffic map[string]bool) map[string]interface{} {
 	if apiObject == nil {
 		return nil
 	}
@@ -377,13 +523,14 @@ func flattenCustomRoutingEndpointDescription(apiObject *awstypes.CustomRoutingEn
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.EndpointId; v != nil {
+		tfMap["allow_all_traffic"] = allowAllTraffic[aws.ToString(v)]
 		tfMap["endpoint_id"] = aws.ToString(v)
 	}
 
 	return tfMap
 }
 
-func flattenCustomRoutingEndpointDescriptions(apiObjects []awstypes.CustomRoutingEndpointDescription) []interface{} {
+func flattenCustomRoutingEndpointDescriptions(apiObjects []awstypes.CustomRoutingEndpointDescription, allowAllTraffic map[string]bool) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -391,7 +538,7 @@ func flattenCustomRoutingEndpointDescriptions(apiObjects []awstypes.CustomRoutin
 	var tfList []interface{}
 
 	for _, apiObject := range apiObjects {
-		tfList = append(tfList, flattenCustomRoutingEndpointDescription(&apiObject))
+		tfList = append(tfList, flattenCustomRoutingEndpointDescription(&apiObject, allowAllTraffic))
 	}
 
 	return tfList
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_globalaccelerator_custom_routing_port_mappings", name="Custom Routing Port Mappings")
+func dataSourceCustomRoutingPortMappings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCustomRoutingPortMappingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"accelerator_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"endpoint_group_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"port_mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accelerator_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"destination_socket_address": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrIPAddress: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrPort: {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"destination_traffic_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_group_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocols": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCustomRoutingPortMappingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+	acceleratorARN := d.Get("accelerator_arn").(string)
+	input := &globalaccelerator.ListCustomRoutingPortMappingsInput{
+		AcceleratorArn: aws.String(acceleratorARN),
+	}
+
+	if v, ok := d.GetOk("endpoint_group_arn"); ok {
+		input.EndpointGroupArn = aws.String(v.(string))
+	}
+
+	var portMappings []awstypes.PortMapping
+	pages := globalaccelerator.NewListCustomRoutingPortMappingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing Global Accelerator Custom Routing Accelerator (%s) port mappings: %s", acceleratorARN, err)
+		}
+
+		portMappings = append(portMappings, page.PortMappings...)
+	}
+
+	d.SetId(acceleratorARN)
+	if err := d.Set("port_mappings", flattenPortMappings(portMappings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting port_mappings: %s", err)
+	}
+
+	return diags
+}
+
+func flattenPortMappings(apiObjects []awstypes.PortMapping) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"accelerator_port":          aws.ToInt32(apiObject.AcceleratorPort),
+			"destination_traffic_state": apiObject.DestinationTrafficState,
+			"endpoint_group_arn":        aws.ToString(apiObject.EndpointGroupArn),
+			"endpoint_id":               aws.ToString(apiObject.EndpointId),
+			"protocols":                 apiObject.Protocols,
+		}
+
+		if v := apiObject.DestinationSocketAddress; v != nil {
+			tfMap["destination_socket_address"] = []interface{}{map[string]interface{}{
+				names.AttrIPAddress: aws.ToString(v.IpAddress),
+				names.AttrPort:      aws.ToInt32(v.Port),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
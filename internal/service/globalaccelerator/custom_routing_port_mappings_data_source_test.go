@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingPortMappingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_globalaccelerator_custom_routing_port_mappings.test"
+	endpointGroupResourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingPortMappingsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "accelerator_arn", "aws_globalaccelerator_custom_routing_accelerator.test", names.AttrID),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "port_mappings.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "port_mappings.0.accelerator_port"),
+					resource.TestCheckResourceAttr(dataSourceName, "port_mappings.0.destination_socket_address.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "port_mappings.0.destination_socket_address.0.ip_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "port_mappings.0.destination_traffic_state", "ALLOW"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "port_mappings.0.endpoint_group_arn", endpointGroupResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "port_mappings.0.endpoint_id", "aws_subnet.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomRoutingPortMappingsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointGroupConfig_endpointConfigurationAllowAllTraffic(rName, true), `
+data "aws_globalaccelerator_custom_routing_port_mappings" "test" {
+  accelerator_arn    = aws_globalaccelerator_custom_routing_accelerator.test.id
+  endpoint_group_arn = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+}
+`)
+}
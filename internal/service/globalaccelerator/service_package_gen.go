@@ -40,6 +40,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_globalaccelerator_custom_routing_accelerator",
 			Name:     "Custom Routing Accelerator",
 		},
+		{
+			Factory:  dataSourceCustomRoutingPortMappings,
+			TypeName: "aws_globalaccelerator_custom_routing_port_mappings",
+			Name:     "Custom Routing Port Mappings",
+		},
 	}
 }
 
@@ -7,7 +7,7 @@ package grafana
 var (
 	ResourceWorkspace                    = resourceWorkspace
 	ResourceWorkspaceAPIKey              = resourceWorkspaceAPIKey
-	ResourceWorkspaceSAMLConfiguration   = resourceWorkspaceSAMLConfiguration
+	ResourceWorkspaceSAMLConfiguration   = newWorkspaceSAMLConfigurationResource
 	ResourceWorkspaceServiceAccount      = newWorkspaceServiceAccountResource
 	ResourceWorkspaceServiceAccountToken = newWorkspaceServiceAccountTokenResource
 
@@ -38,6 +38,7 @@ func TestAccGrafana_serial(t *testing.T) {
 			"configuration":            testAccWorkspace_configuration,
 			"networkAccess":            testAccWorkspace_networkAccess,
 			"version":                  testAccWorkspace_version,
+			"pluginAdminEnabled":       testAccWorkspace_pluginAdminEnabled,
 		},
 		"ApiKey": {
 			acctest.CtBasic: testAccWorkspaceAPIKey_basic,
@@ -54,6 +55,9 @@ func TestAccGrafana_serial(t *testing.T) {
 			"loginValidity": testAccWorkspaceSAMLConfiguration_loginValidity,
 			"assertions":    testAccWorkspaceSAMLConfiguration_assertions,
 		},
+		"SamlConfigurationDataSource": {
+			acctest.CtBasic: testAccWorkspaceSAMLConfigurationDataSource_basic,
+		},
 		"RoleAssociation": {
 			"usersAdmin":           testAccRoleAssociation_usersAdmin,
 			"usersEditor":          testAccRoleAssociation_usersEditor,
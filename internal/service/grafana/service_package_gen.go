@@ -15,11 +15,20 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newWorkspaceSAMLConfigurationDataSource,
+			Name:    "Workspace SAML Configuration",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newWorkspaceSAMLConfigurationResource,
+			Name:    "Workspace SAML Configuration",
+		},
 		{
 			Factory: newWorkspaceServiceAccountResource,
 			Name:    "Workspace Service Account",
@@ -67,11 +76,6 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_grafana_workspace_api_key",
 			Name:     "Workspace API Key",
 		},
-		{
-			Factory:  resourceWorkspaceSAMLConfiguration,
-			TypeName: "aws_grafana_workspace_saml_configuration",
-			Name:     "Grafana Workspace SAML Configuration",
-		},
 	}
 }
 
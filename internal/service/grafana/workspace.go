@@ -5,6 +5,7 @@ package grafana
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -72,7 +73,7 @@ func resourceWorkspace() *schema.Resource {
 				Optional:              true,
 				Computed:              true,
 				ValidateFunc:          validation.StringIsJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressFunc:      suppressEquivalentWorkspaceConfigurationDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
@@ -148,6 +149,11 @@ func resourceWorkspace() *schema.Resource {
 				Required:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.PermissionType](),
 			},
+			"plugin_admin_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -203,8 +209,14 @@ func resourceWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta i
 		Tags:                    getTagsIn(ctx),
 	}
 
-	if v, ok := d.GetOk(names.AttrConfiguration); ok {
-		input.Configuration = aws.String(v.(string))
+	configuration, err := expandWorkspaceConfiguration(d)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if configuration != "" {
+		input.Configuration = aws.String(configuration)
 	}
 
 	if v, ok := d.GetOk("data_sources"); ok {
@@ -314,17 +326,14 @@ func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	setTagsOut(ctx, workspace.Tags)
 
-	input := &grafana.DescribeWorkspaceConfigurationInput{
-		WorkspaceId: aws.String(d.Id()),
-	}
-
-	output, err := conn.DescribeWorkspaceConfiguration(ctx, input)
+	output, err := findWorkspaceConfigurationByID(ctx, conn, d.Id())
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Grafana Workspace (%s) configuration: %s", d.Id(), err)
 	}
 
 	d.Set(names.AttrConfiguration, output.Configuration)
+	d.Set("plugin_admin_enabled", workspaceConfigurationPluginAdminEnabled(aws.ToString(output.Configuration)))
 
 	return diags
 }
@@ -333,7 +342,7 @@ func resourceWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GrafanaClient(ctx)
 
-	if d.HasChangesExcept(names.AttrConfiguration, "grafana_version", names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrConfiguration, "grafana_version", "plugin_admin_enabled", names.AttrTags, names.AttrTagsAll) {
 		input := &grafana.UpdateWorkspaceInput{
 			WorkspaceId: aws.String(d.Id()),
 		}
@@ -409,9 +418,15 @@ func resourceWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChanges(names.AttrConfiguration, "grafana_version") {
+	if d.HasChanges(names.AttrConfiguration, "grafana_version", "plugin_admin_enabled") {
+		configuration, err := expandWorkspaceConfiguration(d)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
 		input := &grafana.UpdateWorkspaceConfigurationInput{
-			Configuration: aws.String(d.Get(names.AttrConfiguration).(string)),
+			Configuration: aws.String(configuration),
 			WorkspaceId:   aws.String(d.Id()),
 		}
 
@@ -419,7 +434,7 @@ func resourceWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			input.GrafanaVersion = aws.String(d.Get("grafana_version").(string))
 		}
 
-		_, err := conn.UpdateWorkspaceConfiguration(ctx, input)
+		_, err = conn.UpdateWorkspaceConfiguration(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Grafana Workspace (%s) configuration: %s", d.Id(), err)
@@ -482,6 +497,31 @@ func findWorkspaceByID(ctx context.Context, conn *grafana.Client, id string) (*a
 	return output.Workspace, nil
 }
 
+func findWorkspaceConfigurationByID(ctx context.Context, conn *grafana.Client, id string) (*grafana.DescribeWorkspaceConfigurationOutput, error) {
+	input := &grafana.DescribeWorkspaceConfigurationInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWorkspaceConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func statusWorkspace(ctx context.Context, conn *grafana.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findWorkspaceByID(ctx, conn, id)
@@ -610,3 +650,75 @@ func flattenNetworkAccessControl(apiObject *awstypes.NetworkAccessConfiguration)
 
 	return []interface{}{tfMap}
 }
+
+const (
+	workspaceConfigurationPluginsKey            = "plugins"
+	workspaceConfigurationPluginAdminEnabledKey = "pluginAdminEnabled"
+)
+
+// expandWorkspaceConfiguration returns the workspace configuration JSON, with `plugins.pluginAdminEnabled` set from `plugin_admin_enabled` if configured.
+func expandWorkspaceConfiguration(d *schema.ResourceData) (string, error) {
+	configuration := d.Get(names.AttrConfiguration).(string)
+
+	if v := d.GetRawConfig().GetAttr("plugin_admin_enabled"); v.IsKnown() && !v.IsNull() {
+		return workspaceConfigurationWithPluginAdminEnabled(configuration, v.True())
+	}
+
+	return configuration, nil
+}
+
+func workspaceConfigurationWithPluginAdminEnabled(configuration string, enabled bool) (string, error) {
+	tfMap := make(map[string]any)
+
+	if configuration != "" {
+		if err := json.Unmarshal([]byte(configuration), &tfMap); err != nil {
+			return "", fmt.Errorf("decoding configuration: %w", err)
+		}
+	}
+
+	plugins, ok := tfMap[workspaceConfigurationPluginsKey].(map[string]any)
+	if !ok {
+		plugins = make(map[string]any)
+	}
+	plugins[workspaceConfigurationPluginAdminEnabledKey] = enabled
+	tfMap[workspaceConfigurationPluginsKey] = plugins
+
+	output, err := json.Marshal(tfMap)
+
+	if err != nil {
+		return "", fmt.Errorf("encoding configuration: %w", err)
+	}
+
+	return string(output), nil
+}
+
+func workspaceConfigurationPluginAdminEnabled(configuration string) bool {
+	var tfMap map[string]any
+
+	if err := json.Unmarshal([]byte(configuration), &tfMap); err != nil {
+		return false
+	}
+
+	if plugins, ok := tfMap[workspaceConfigurationPluginsKey].(map[string]any); ok {
+		if v, ok := plugins[workspaceConfigurationPluginAdminEnabledKey].(bool); ok {
+			return v
+		}
+	}
+
+	return false
+}
+
+// suppressEquivalentWorkspaceConfigurationDiffs suppresses equivalent JSON differences, taking into account any `plugin_admin_enabled` value.
+func suppressEquivalentWorkspaceConfigurationDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if verify.SuppressEquivalentJSONDiffs(k, old, new, d) {
+		return true
+	}
+
+	if v := d.GetRawConfig().GetAttr("plugin_admin_enabled"); v.IsKnown() && !v.IsNull() {
+		if new, err := workspaceConfigurationWithPluginAdminEnabled(new, v.True()); err == nil {
+			return verify.JSONStringsEqual(old, new)
+		}
+	}
+
+	return false
+}
@@ -37,6 +37,10 @@ func dataSourceWorkspace() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			names.AttrConfiguration: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrCreatedDate: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -150,5 +154,13 @@ func dataSourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	setTagsOut(ctx, workspace.Tags)
 
+	output, err := findWorkspaceConfigurationByID(ctx, conn, workspaceID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Grafana Workspace (%s) configuration: %s", workspaceID, err)
+	}
+
+	d.Set(names.AttrConfiguration, output.Configuration)
+
 	return diags
 }
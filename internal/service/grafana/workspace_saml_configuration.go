@@ -5,270 +5,245 @@ package grafana
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/grafana"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/grafana/types"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_grafana_workspace_saml_configuration", name="Grafana Workspace SAML Configuration")
-func resourceWorkspaceSAMLConfiguration() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceWorkspaceSAMLConfigurationUpsert,
-		ReadWithoutTimeout:   resourceWorkspaceSAMLConfigurationRead,
-		UpdateWithoutTimeout: resourceWorkspaceSAMLConfigurationUpsert,
-		DeleteWithoutTimeout: schema.NoopContext,
+// @FrameworkResource("aws_grafana_workspace_saml_configuration", name="Workspace SAML Configuration")
+func newWorkspaceSAMLConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workspaceSAMLConfigurationResource{}
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
 
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(10 * time.Minute),
-			Delete: schema.DefaultTimeout(10 * time.Minute),
-		},
+	return r, nil
+}
 
-		Schema: map[string]*schema.Schema{
-			"admin_role_values": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+type workspaceSAMLConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*workspaceSAMLConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_grafana_workspace_saml_configuration"
+}
+
+func (r *workspaceSAMLConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin_role_values": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Optional:    true,
+				ElementType: types.StringType,
 			},
-			"allowed_organizations": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			"allowed_organizations": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Optional:    true,
+				ElementType: types.StringType,
 			},
-			"editor_role_values": {
-				Type:     schema.TypeList,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			"editor_role_values": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Required:    true,
+				ElementType: types.StringType,
 			},
-			"email_assertion": {
-				Type:     schema.TypeString,
+			"email_assertion": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"groups_assertion": {
-				Type:     schema.TypeString,
+			"groups_assertion": schema.StringAttribute{
 				Optional: true,
 			},
-			"idp_metadata_url": {
-				Type:     schema.TypeString,
+			names.AttrID: framework.IDAttribute(),
+			"idp_metadata_url": schema.StringAttribute{
 				Optional: true,
 			},
-			"idp_metadata_xml": {
-				Type:     schema.TypeString,
+			"idp_metadata_xml": schema.StringAttribute{
 				Optional: true,
 			},
-			"login_assertion": {
-				Type:     schema.TypeString,
+			"login_assertion": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"login_validity_duration": {
-				Type:     schema.TypeInt,
+			"login_validity_duration": schema.Int64Attribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
-			"name_assertion": {
-				Type:     schema.TypeString,
+			"name_assertion": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"org_assertion": {
-				Type:     schema.TypeString,
+			"org_assertion": schema.StringAttribute{
 				Optional: true,
 			},
-			"role_assertion": {
-				Type:     schema.TypeString,
+			"role_assertion": schema.StringAttribute{
 				Optional: true,
 			},
-			names.AttrStatus: {
-				Type:     schema.TypeString,
-				Computed: true,
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SamlConfigurationStatus](),
+				Computed:   true,
 			},
-			"workspace_id": {
-				Type:     schema.TypeString,
+			"workspace_id": schema.StringAttribute{
 				Required: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-func resourceWorkspaceSAMLConfigurationUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).GrafanaClient(ctx)
-
-	workspaceID := d.Get("workspace_id").(string)
-	workspace, err := findWorkspaceByID(ctx, conn, workspaceID)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Grafana Workspace (%s): %s", workspaceID, err)
+func (r *workspaceSAMLConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workspaceSAMLConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	authenticationProviders := workspace.Authentication.Providers
-	roleValues := &awstypes.RoleValues{
-		Editor: flex.ExpandStringValueList(d.Get("editor_role_values").([]interface{})),
-	}
+	conn := r.Meta().GrafanaClient(ctx)
 
-	if v, ok := d.GetOk("admin_role_values"); ok {
-		roleValues.Admin = flex.ExpandStringValueList(v.([]interface{}))
-	}
+	workspaceID := data.WorkspaceID.ValueString()
+	output, err := upsertWorkspaceSAMLConfiguration(ctx, conn, &data, r.CreateTimeout(ctx, data.Timeouts))
 
-	samlConfiguration := &awstypes.SamlConfiguration{
-		RoleValues: roleValues,
-	}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Grafana Workspace SAML Configuration (%s)", workspaceID), err.Error())
 
-	if v, ok := d.GetOk("allowed_organizations"); ok {
-		samlConfiguration.AllowedOrganizations = flex.ExpandStringValueList(v.([]interface{}))
+		return
 	}
 
-	if v, ok := d.GetOk("login_validity_duration"); ok {
-		samlConfiguration.LoginValidityDuration = int32(v.(int))
-	}
+	// Set values for unknowns.
+	data.ID = types.StringValue(workspaceID)
+	data.setUnknowns(ctx, output)
 
-	var assertionAttributes *awstypes.AssertionAttributes
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
 
-	if v, ok := d.GetOk("email_assertion"); ok {
-		assertionAttributes = &awstypes.AssertionAttributes{
-			Email: aws.String(v.(string)),
-		}
+func (r *workspaceSAMLConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workspaceSAMLConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if v, ok := d.GetOk("groups_assertion"); ok {
-		if assertionAttributes == nil {
-			assertionAttributes = &awstypes.AssertionAttributes{}
-		}
+	conn := r.Meta().GrafanaClient(ctx)
 
-		assertionAttributes.Groups = aws.String(v.(string))
-	}
+	output, err := findSAMLConfigurationByID(ctx, conn, data.ID.ValueString())
 
-	if v, ok := d.GetOk("login_assertion"); ok {
-		if assertionAttributes == nil {
-			assertionAttributes = &awstypes.AssertionAttributes{}
-		}
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
 
-		assertionAttributes.Login = aws.String(v.(string))
+		return
 	}
 
-	if v, ok := d.GetOk("name_assertion"); ok {
-		if assertionAttributes == nil {
-			assertionAttributes = &awstypes.AssertionAttributes{}
-		}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Grafana Workspace SAML Configuration (%s)", data.ID.ValueString()), err.Error())
 
-		assertionAttributes.Name = aws.String(v.(string))
+		return
 	}
 
-	if v, ok := d.GetOk("org_assertion"); ok {
-		if assertionAttributes == nil {
-			assertionAttributes = &awstypes.AssertionAttributes{}
-		}
-
-		assertionAttributes.Org = aws.String(v.(string))
-	}
+	// Set attributes for import.
+	data.WorkspaceID = data.ID
+	data.flatten(ctx, output)
 
-	if v, ok := d.GetOk("role_assertion"); ok {
-		if assertionAttributes == nil {
-			assertionAttributes = &awstypes.AssertionAttributes{}
-		}
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
 
-		assertionAttributes.Role = aws.String(v.(string))
+func (r *workspaceSAMLConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new workspaceSAMLConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if assertionAttributes != nil {
-		samlConfiguration.AssertionAttributes = assertionAttributes
-	}
+	conn := r.Meta().GrafanaClient(ctx)
 
-	var idpMetadata awstypes.IdpMetadata
+	output, err := upsertWorkspaceSAMLConfiguration(ctx, conn, &new, r.UpdateTimeout(ctx, new.Timeouts))
 
-	if v, ok := d.GetOk("idp_metadata_url"); ok {
-		idpMetadata = &awstypes.IdpMetadataMemberUrl{
-			Value: v.(string),
-		}
-	}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Grafana Workspace SAML Configuration (%s)", new.ID.ValueString()), err.Error())
 
-	if v, ok := d.GetOk("idp_metadata_xml"); ok {
-		idpMetadata = &awstypes.IdpMetadataMemberXml{
-			Value: v.(string),
-		}
+		return
 	}
 
-	samlConfiguration.IdpMetadata = idpMetadata
+	new.setUnknowns(ctx, output)
 
-	input := &grafana.UpdateWorkspaceAuthenticationInput{
-		AuthenticationProviders: authenticationProviders,
-		SamlConfiguration:       samlConfiguration,
-		WorkspaceId:             aws.String(workspaceID),
-	}
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
 
-	_, err = conn.UpdateWorkspaceAuthentication(ctx, input)
+// upsertWorkspaceSAMLConfiguration updates the workspace's authentication configuration,
+// preserving any existing authentication providers, and waits for SAML to be configured.
+func upsertWorkspaceSAMLConfiguration(ctx context.Context, conn *grafana.Client, data *workspaceSAMLConfigurationResourceModel, timeout time.Duration) (*awstypes.SamlAuthentication, error) {
+	workspaceID := data.WorkspaceID.ValueString()
+	workspace, err := findWorkspaceByID(ctx, conn, workspaceID)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Grafana Workspace SAML Configuration (%s): %s", workspaceID, err)
+		return nil, fmt.Errorf("reading Grafana Workspace (%s): %w", workspaceID, err)
 	}
 
-	if d.IsNewResource() {
-		d.SetId(workspaceID)
+	var authenticationProviders []awstypes.AuthenticationProviderTypes
+	if workspace.Authentication != nil {
+		authenticationProviders = workspace.Authentication.Providers
 	}
 
-	if _, err := waitWorkspaceSAMLConfigurationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Grafana Workspace SAML Configuration (%s) create: %s", d.Id(), err)
+	input := &grafana.UpdateWorkspaceAuthenticationInput{
+		AuthenticationProviders: authenticationProviders,
+		SamlConfiguration:       data.expand(ctx),
+		WorkspaceId:             aws.String(workspaceID),
 	}
 
-	return append(diags, resourceWorkspaceSAMLConfigurationRead(ctx, d, meta)...)
-}
-
-func resourceWorkspaceSAMLConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).GrafanaClient(ctx)
-
-	saml, err := findSAMLConfigurationByID(ctx, conn, d.Id())
-
-	if tfresource.NotFound(err) && !d.IsNewResource() {
-		log.Printf("[WARN] Grafana Workspace SAML Configuration (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
+	if _, err := conn.UpdateWorkspaceAuthentication(ctx, input); err != nil {
+		return nil, err
 	}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Grafana Workspace SAML Configuration (%s): %s", d.Id(), err)
-	}
+	output, err := waitWorkspaceSAMLConfigurationCreated(ctx, conn, workspaceID, timeout)
 
-	d.Set("admin_role_values", saml.Configuration.RoleValues.Admin)
-	d.Set("allowed_organizations", saml.Configuration.AllowedOrganizations)
-	d.Set("editor_role_values", saml.Configuration.RoleValues.Editor)
-	d.Set("email_assertion", saml.Configuration.AssertionAttributes.Email)
-	d.Set("groups_assertion", saml.Configuration.AssertionAttributes.Groups)
-	d.Set("idp_metadata_url", "")
-	d.Set("idp_metadata_xml", "")
-	switch v := saml.Configuration.IdpMetadata.(type) {
-	case *awstypes.IdpMetadataMemberUrl:
-		d.Set("idp_metadata_url", v.Value)
-	case *awstypes.IdpMetadataMemberXml:
-		d.Set("idp_metadata_xml", v.Value)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Grafana Workspace SAML Configuration (%s) create: %w", workspaceID, err)
 	}
-	d.Set("login_assertion", saml.Configuration.AssertionAttributes.Login)
-	d.Set("login_validity_duration", saml.Configuration.LoginValidityDuration)
-	d.Set("name_assertion", saml.Configuration.AssertionAttributes.Name)
-	d.Set("org_assertion", saml.Configuration.AssertionAttributes.Org)
-	d.Set("role_assertion", saml.Configuration.AssertionAttributes.Role)
-	d.Set(names.AttrStatus, saml.Status)
 
-	return diags
+	return output, nil
 }
 
 func findSAMLConfigurationByID(ctx context.Context, conn *grafana.Client, id string) (*awstypes.SamlAuthentication, error) {
@@ -335,3 +310,125 @@ func waitWorkspaceSAMLConfigurationCreated(ctx context.Context, conn *grafana.Cl
 
 	return nil, err
 }
+
+type workspaceSAMLConfigurationResourceModel struct {
+	AdminRoleValues       fwtypes.ListValueOf[types.String]                    `tfsdk:"admin_role_values"`
+	AllowedOrganizations  fwtypes.ListValueOf[types.String]                    `tfsdk:"allowed_organizations"`
+	EditorRoleValues      fwtypes.ListValueOf[types.String]                    `tfsdk:"editor_role_values"`
+	EmailAssertion        types.String                                         `tfsdk:"email_assertion"`
+	GroupsAssertion       types.String                                         `tfsdk:"groups_assertion"`
+	ID                    types.String                                         `tfsdk:"id"`
+	IDPMetadataURL        types.String                                         `tfsdk:"idp_metadata_url"`
+	IDPMetadataXML        types.String                                         `tfsdk:"idp_metadata_xml"`
+	LoginAssertion        types.String                                         `tfsdk:"login_assertion"`
+	LoginValidityDuration types.Int64                                          `tfsdk:"login_validity_duration"`
+	NameAssertion         types.String                                         `tfsdk:"name_assertion"`
+	OrgAssertion          types.String                                         `tfsdk:"org_assertion"`
+	RoleAssertion         types.String                                         `tfsdk:"role_assertion"`
+	Status                fwtypes.StringEnum[awstypes.SamlConfigurationStatus] `tfsdk:"status"`
+	Timeouts              timeouts.Value                                       `tfsdk:"timeouts"`
+	WorkspaceID           types.String                                         `tfsdk:"workspace_id"`
+}
+
+func (data *workspaceSAMLConfigurationResourceModel) expand(ctx context.Context) *awstypes.SamlConfiguration {
+	apiObject := &awstypes.SamlConfiguration{
+		AllowedOrganizations: fwflex.ExpandFrameworkStringValueList(ctx, data.AllowedOrganizations),
+		RoleValues: &awstypes.RoleValues{
+			Admin:  fwflex.ExpandFrameworkStringValueList(ctx, data.AdminRoleValues),
+			Editor: fwflex.ExpandFrameworkStringValueList(ctx, data.EditorRoleValues),
+		},
+	}
+
+	if !data.LoginValidityDuration.IsUnknown() {
+		apiObject.LoginValidityDuration = fwflex.Int32ValueFromFramework(ctx, data.LoginValidityDuration)
+	}
+
+	assertionAttributes := &awstypes.AssertionAttributes{
+		Email:  fwflex.StringFromFramework(ctx, data.EmailAssertion),
+		Groups: fwflex.StringFromFramework(ctx, data.GroupsAssertion),
+		Login:  fwflex.StringFromFramework(ctx, data.LoginAssertion),
+		Name:   fwflex.StringFromFramework(ctx, data.NameAssertion),
+		Org:    fwflex.StringFromFramework(ctx, data.OrgAssertion),
+		Role:   fwflex.StringFromFramework(ctx, data.RoleAssertion),
+	}
+
+	if *assertionAttributes != (awstypes.AssertionAttributes{}) {
+		apiObject.AssertionAttributes = assertionAttributes
+	}
+
+	if v := data.IDPMetadataURL; !v.IsNull() && !v.IsUnknown() {
+		apiObject.IdpMetadata = &awstypes.IdpMetadataMemberUrl{
+			Value: v.ValueString(),
+		}
+	}
+
+	if v := data.IDPMetadataXML; !v.IsNull() && !v.IsUnknown() {
+		apiObject.IdpMetadata = &awstypes.IdpMetadataMemberXml{
+			Value: v.ValueString(),
+		}
+	}
+
+	return apiObject
+}
+
+func (data *workspaceSAMLConfigurationResourceModel) flatten(ctx context.Context, apiObject *awstypes.SamlAuthentication) {
+	data.Status = fwtypes.StringEnumValue(apiObject.Status)
+
+	configuration := apiObject.Configuration
+	if configuration == nil {
+		configuration = &awstypes.SamlConfiguration{}
+	}
+
+	data.AllowedOrganizations = fwflex.FlattenFrameworkStringValueListOfString(ctx, configuration.AllowedOrganizations)
+	data.LoginValidityDuration = fwflex.Int32ValueToFramework(ctx, configuration.LoginValidityDuration)
+
+	if v := configuration.RoleValues; v != nil {
+		data.AdminRoleValues = fwflex.FlattenFrameworkStringValueListOfString(ctx, v.Admin)
+		data.EditorRoleValues = fwflex.FlattenFrameworkStringValueListOfString(ctx, v.Editor)
+	} else {
+		data.AdminRoleValues = fwtypes.NewListValueOfNull[types.String](ctx)
+		data.EditorRoleValues = fwtypes.NewListValueOfNull[types.String](ctx)
+	}
+
+	assertionAttributes := configuration.AssertionAttributes
+	if assertionAttributes == nil {
+		assertionAttributes = &awstypes.AssertionAttributes{}
+	}
+
+	data.EmailAssertion = fwflex.StringToFramework(ctx, assertionAttributes.Email)
+	data.GroupsAssertion = fwflex.StringToFramework(ctx, assertionAttributes.Groups)
+	data.LoginAssertion = fwflex.StringToFramework(ctx, assertionAttributes.Login)
+	data.NameAssertion = fwflex.StringToFramework(ctx, assertionAttributes.Name)
+	data.OrgAssertion = fwflex.StringToFramework(ctx, assertionAttributes.Org)
+	data.RoleAssertion = fwflex.StringToFramework(ctx, assertionAttributes.Role)
+
+	data.IDPMetadataURL = types.StringNull()
+	data.IDPMetadataXML = types.StringNull()
+	switch v := configuration.IdpMetadata.(type) {
+	case *awstypes.IdpMetadataMemberUrl:
+		data.IDPMetadataURL = fwflex.StringValueToFramework(ctx, v.Value)
+	case *awstypes.IdpMetadataMemberXml:
+		data.IDPMetadataXML = fwflex.StringValueToFramework(ctx, v.Value)
+	}
+}
+
+// setUnknowns sets the values of computed attributes that are unknown in the plan,
+// leaving configured values as planned.
+func (data *workspaceSAMLConfigurationResourceModel) setUnknowns(ctx context.Context, apiObject *awstypes.SamlAuthentication) {
+	var v workspaceSAMLConfigurationResourceModel
+	v.flatten(ctx, apiObject)
+
+	data.Status = v.Status
+	if data.EmailAssertion.IsUnknown() {
+		data.EmailAssertion = v.EmailAssertion
+	}
+	if data.LoginAssertion.IsUnknown() {
+		data.LoginAssertion = v.LoginAssertion
+	}
+	if data.LoginValidityDuration.IsUnknown() {
+		data.LoginValidityDuration = v.LoginValidityDuration
+	}
+	if data.NameAssertion.IsUnknown() {
+		data.NameAssertion = v.NameAssertion
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package grafana
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/grafana/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_grafana_workspace_saml_configuration", name="Workspace SAML Configuration")
+func newWorkspaceSAMLConfigurationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &workspaceSAMLConfigurationDataSource{}, nil
+}
+
+type workspaceSAMLConfigurationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*workspaceSAMLConfigurationDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_grafana_workspace_saml_configuration"
+}
+
+func (d *workspaceSAMLConfigurationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin_role_values": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"allowed_organizations": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"editor_role_values": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"email_assertion": schema.StringAttribute{
+				Computed: true,
+			},
+			"groups_assertion": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"idp_metadata_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"idp_metadata_xml": schema.StringAttribute{
+				Computed: true,
+			},
+			"login_assertion": schema.StringAttribute{
+				Computed: true,
+			},
+			"login_validity_duration": schema.Int64Attribute{
+				Computed: true,
+			},
+			"name_assertion": schema.StringAttribute{
+				Computed: true,
+			},
+			"org_assertion": schema.StringAttribute{
+				Computed: true,
+			},
+			"role_assertion": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SamlConfigurationStatus](),
+				Computed:   true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *workspaceSAMLConfigurationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data workspaceSAMLConfigurationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().GrafanaClient(ctx)
+
+	workspaceID := data.WorkspaceID.ValueString()
+	output, err := findSAMLConfigurationByID(ctx, conn, workspaceID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Grafana Workspace SAML Configuration (%s)", workspaceID), tfresource.SingularDataSourceFindError("Grafana Workspace SAML Configuration", err).Error())
+
+		return
+	}
+
+	// The resource model's flattener handles absent assertion attributes and role values.
+	var v workspaceSAMLConfigurationResourceModel
+	v.flatten(ctx, output)
+
+	data.AdminRoleValues = v.AdminRoleValues
+	data.AllowedOrganizations = v.AllowedOrganizations
+	data.EditorRoleValues = v.EditorRoleValues
+	data.EmailAssertion = v.EmailAssertion
+	data.GroupsAssertion = v.GroupsAssertion
+	data.ID = types.StringValue(workspaceID)
+	data.IDPMetadataURL = v.IDPMetadataURL
+	data.IDPMetadataXML = v.IDPMetadataXML
+	data.LoginAssertion = v.LoginAssertion
+	data.LoginValidityDuration = v.LoginValidityDuration
+	data.NameAssertion = v.NameAssertion
+	data.OrgAssertion = v.OrgAssertion
+	data.RoleAssertion = v.RoleAssertion
+	data.Status = v.Status
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type workspaceSAMLConfigurationDataSourceModel struct {
+	AdminRoleValues       fwtypes.ListValueOf[types.String]                    `tfsdk:"admin_role_values"`
+	AllowedOrganizations  fwtypes.ListValueOf[types.String]                    `tfsdk:"allowed_organizations"`
+	EditorRoleValues      fwtypes.ListValueOf[types.String]                    `tfsdk:"editor_role_values"`
+	EmailAssertion        types.String                                         `tfsdk:"email_assertion"`
+	GroupsAssertion       types.String                                         `tfsdk:"groups_assertion"`
+	ID                    types.String                                         `tfsdk:"id"`
+	IDPMetadataURL        types.String                                         `tfsdk:"idp_metadata_url"`
+	IDPMetadataXML        types.String                                         `tfsdk:"idp_metadata_xml"`
+	LoginAssertion        types.String                                         `tfsdk:"login_assertion"`
+	LoginValidityDuration types.Int64                                          `tfsdk:"login_validity_duration"`
+	NameAssertion         types.String                                         `tfsdk:"name_assertion"`
+	OrgAssertion          types.String                                         `tfsdk:"org_assertion"`
+	RoleAssertion         types.String                                         `tfsdk:"role_assertion"`
+	Status                fwtypes.StringEnum[awstypes.SamlConfigurationStatus] `tfsdk:"status"`
+	WorkspaceID           types.String                                         `tfsdk:"workspace_id"`
+}
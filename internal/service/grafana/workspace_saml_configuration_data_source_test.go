@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package grafana_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccWorkspaceSAMLConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace_saml_configuration.test"
+	dataSourceName := "data.aws_grafana_workspace_saml_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.GrafanaEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GrafanaServiceID),
+		CheckDestroy:             nil,
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceSAMLConfigurationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "admin_role_values.#", dataSourceName, "admin_role_values.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "editor_role_values.#", dataSourceName, "editor_role_values.#"),
+					resource.TestCheckResourceAttrPair(resourceName, "email_assertion", dataSourceName, "email_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "groups_assertion", dataSourceName, "groups_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "idp_metadata_xml", dataSourceName, "idp_metadata_xml"),
+					resource.TestCheckResourceAttrPair(resourceName, "login_assertion", dataSourceName, "login_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "login_validity_duration", dataSourceName, "login_validity_duration"),
+					resource.TestCheckResourceAttrPair(resourceName, "name_assertion", dataSourceName, "name_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "org_assertion", dataSourceName, "org_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_assertion", dataSourceName, "role_assertion"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrStatus, dataSourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", dataSourceName, "workspace_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceSAMLConfigurationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceSAMLConfigurationConfig_providerAssertions(rName), `
+data "aws_grafana_workspace_saml_configuration" "test" {
+  workspace_id = aws_grafana_workspace_saml_configuration.test.workspace_id
+}
+`)
+}
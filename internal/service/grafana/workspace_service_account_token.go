@@ -6,6 +6,7 @@ package grafana
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/grafana"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -230,6 +232,36 @@ func (r *workspaceServiceAccountTokenResource) Delete(ctx context.Context, reque
 	}
 }
 
+func (r *workspaceServiceAccountTokenResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state workspaceServiceAccountTokenResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ExpiresAt.IsNull() || state.ExpiresAt.IsUnknown() {
+		return
+	}
+
+	expiresAt, diags := state.ExpiresAt.ValueRFC3339Time()
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// An expired token can no longer be used, so replace it.
+	// expires_at must be marked unknown as otherwise its planned value is taken from state and there is no change to trigger the replacement.
+	if time.Now().After(expiresAt) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("expires_at"), timetypes.NewRFC3339Unknown())...)
+		response.RequiresReplace = append(response.RequiresReplace, path.Root("expires_at"))
+	}
+}
+
 func findWorkspaceServiceAccountToken(ctx context.Context, conn *grafana.Client, input *grafana.ListWorkspaceServiceAccountTokensInput, filter tfslices.Predicate[*awstypes.ServiceAccountTokenSummary]) (*awstypes.ServiceAccountTokenSummary, error) {
 	output, err := findWorkspaceServiceAccountTokens(ctx, conn, input, filter)
 
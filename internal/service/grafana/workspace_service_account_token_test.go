@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/grafana"
 	"github.com/aws/aws-sdk-go-v2/service/grafana/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -76,6 +78,43 @@ func TestAccGrafanaWorkspaceServiceAccountToken_disappears(t *testing.T) {
 	})
 }
 
+func TestAccGrafanaWorkspaceServiceAccountToken_expired(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_grafana_workspace_service_account_token.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var v types.ServiceAccountTokenSummary
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.GrafanaEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GrafanaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkspaceServiceAccountTokenDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceServiceAccountTokenConfig_secondsToLive(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceServiceAccountTokenExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				PreConfig: func() {
+					time.Sleep(90 * time.Second)
+				},
+				Config: testAccWorkspaceServiceAccountTokenConfig_secondsToLive(rName, 60),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckWorkspaceServiceAccountTokenExists(ctx context.Context, n string, v *types.ServiceAccountTokenSummary) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -123,12 +162,16 @@ func testAccCheckWorkspaceServiceAccountTokenDestroy(ctx context.Context) resour
 }
 
 func testAccWorkspaceServiceAccountTokenConfig_basic(rName string) string {
+	return testAccWorkspaceServiceAccountTokenConfig_secondsToLive(rName, 3600)
+}
+
+func testAccWorkspaceServiceAccountTokenConfig_secondsToLive(rName string, secondsToLive int) string {
 	return acctest.ConfigCompose(testAccWorkspaceServiceAccountConfig_basic(rName), fmt.Sprintf(`
 resource "aws_grafana_workspace_service_account_token" "test" {
   name               = %[1]q
   service_account_id = aws_grafana_workspace_service_account.test.service_account_id
-  seconds_to_live    = 3600
+  seconds_to_live    = %[2]d
   workspace_id       = aws_grafana_workspace.test.id
 }
-`, rName))
+`, rName, secondsToLive))
 }
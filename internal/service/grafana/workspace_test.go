@@ -428,6 +428,43 @@ func testAccWorkspace_configuration(t *testing.T) {
 	})
 }
 
+func testAccWorkspace_pluginAdminEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WorkspaceDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.GrafanaEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GrafanaServiceID),
+		CheckDestroy:             testAccCheckWorkspaceDestroy(ctx),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceConfig_pluginAdminEnabled(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrConfiguration, `{"plugins":{"pluginAdminEnabled":true},"unifiedAlerting":{"enabled":true}}`),
+					resource.TestCheckResourceAttr(resourceName, "plugin_admin_enabled", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspaceConfig_pluginAdminEnabled(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrConfiguration, `{"plugins":{"pluginAdminEnabled":false},"unifiedAlerting":{"enabled":true}}`),
+					resource.TestCheckResourceAttr(resourceName, "plugin_admin_enabled", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccWorkspace_networkAccess(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.WorkspaceDescription
@@ -826,6 +863,19 @@ resource "aws_grafana_workspace" "test" {
 `, configuration))
 }
 
+func testAccWorkspaceConfig_pluginAdminEnabled(rName string, pluginAdminEnabled bool) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_base(rName), fmt.Sprintf(`
+resource "aws_grafana_workspace" "test" {
+  account_access_type      = "CURRENT_ACCOUNT"
+  authentication_providers = ["SAML"]
+  permission_type          = "SERVICE_MANAGED"
+  role_arn                 = aws_iam_role.test.arn
+  configuration            = jsonencode({ unifiedAlerting = { enabled = true } })
+  plugin_admin_enabled     = %[1]t
+}
+`, pluginAdminEnabled))
+}
+
 func testAccWorkspaceConfig_version(rName, version string) string {
 	return acctest.ConfigCompose(testAccWorkspaceConfig_base(rName), fmt.Sprintf(`
 resource "aws_grafana_workspace" "test" {
@@ -33,14 +33,13 @@ func ResourceDetectorFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrName: {
 							Type:             schema.TypeString,
 							Required:         true,
-							ForceNew:         true,
 							ValidateDiagFunc: enum.Validate[awstypes.FeatureAdditionalConfiguration](),
 						},
 						names.AttrStatus: {
@@ -76,6 +75,11 @@ func resourceDetectorFeaturePut(ctx context.Context, d *schema.ResourceData, met
 	conn := meta.(*conns.AWSClient).GuardDutyClient(ctx)
 
 	detectorID, name := d.Get("detector_id").(string), d.Get(names.AttrName).(string)
+
+	// Features are updated via the detector, so serialize updates to features on the same detector.
+	conns.GlobalMutexKV.Lock(detectorID)
+	defer conns.GlobalMutexKV.Unlock(detectorID)
+
 	feature := awstypes.DetectorFeatureConfiguration{
 		Name:   awstypes.DetectorFeature(name),
 		Status: awstypes.FeatureStatus(d.Get(names.AttrStatus).(string)),
@@ -124,7 +128,11 @@ func resourceDetectorFeatureRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Detector Feature (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+	tfList := flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)
+	if v, ok := d.GetOk("additional_configuration"); ok {
+		tfList = filterConfiguredAdditionalConfigurations(v.([]interface{}), tfList)
+	}
+	if err := d.Set("additional_configuration", tfList); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting additional_configuration: %s", err)
 	}
 	d.Set("detector_id", detectorID)
@@ -165,6 +173,33 @@ func FindDetectorFeatureByTwoPartKey(ctx context.Context, conn *guardduty.Client
 	}))
 }
 
+// filterConfiguredAdditionalConfigurations returns the additional configurations in tfList
+// that are present in configured, in configured order.
+// GuardDuty returns every additional configuration supported by a feature (e.g. all RUNTIME_MONITORING agents),
+// including ones not managed by this resource.
+func filterConfiguredAdditionalConfigurations(configured, tfList []interface{}) []interface{} {
+	byName := make(map[string]interface{})
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			byName[tfMap[names.AttrName].(string)] = tfMap
+		}
+	}
+
+	var filtered []interface{}
+	for _, tfMapRaw := range configured {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if v, ok := byName[tfMap[names.AttrName].(string)]; ok {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered
+}
+
 func expandDetectorAdditionalConfiguration(tfMap map[string]interface{}) awstypes.DetectorAdditionalConfiguration {
 	apiObject := awstypes.DetectorAdditionalConfiguration{}
 
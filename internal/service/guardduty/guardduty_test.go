@@ -32,6 +32,7 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			acctest.CtBasic:            testAccDetectorFeature_basic,
 			"additional_configuration": testAccDetectorFeature_additionalConfiguration,
 			"multiple":                 testAccDetectorFeature_multiple,
+			"runtime_monitoring":       testAccDetectorFeature_runtimeMonitoring,
 		},
 		"Filter": {
 			acctest.CtBasic:      testAccFilter_basic,
@@ -63,6 +64,7 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			acctest.CtBasic:            testAccOrganizationConfigurationFeature_basic,
 			"additional_configuration": testAccOrganizationConfigurationFeature_additionalConfiguration,
 			"multiple":                 testAccOrganizationConfigurationFeature_multiple,
+			"runtime_monitoring":       testAccOrganizationConfigurationFeature_runtimeMonitoring,
 		},
 		"ThreatIntelSet": {
 			acctest.CtBasic: testAccThreatIntelSet_basic,
@@ -77,6 +79,7 @@ func TestAccGuardDuty_serial(t *testing.T) {
 		"PublishingDestination": {
 			acctest.CtBasic:      testAccPublishingDestination_basic,
 			acctest.CtDisappears: testAccPublishingDestination_disappears,
+			"validation":         testAccPublishingDestination_validation,
 		},
 	}
 
@@ -33,7 +33,7 @@ func ResourceOrganizationConfigurationFeature() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"additional_configuration": {
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
 				Type:     schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -45,7 +45,6 @@ func ResourceOrganizationConfigurationFeature() *schema.Resource {
 						names.AttrName: {
 							Type:             schema.TypeString,
 							Required:         true,
-							ForceNew:         true,
 							ValidateDiagFunc: enum.Validate[awstypes.OrgFeatureAdditionalConfiguration](),
 						},
 					},
@@ -138,7 +137,11 @@ func resourceOrganizationConfigurationFeatureRead(ctx context.Context, d *schema
 		return sdkdiag.AppendErrorf(diags, "reading GuardDuty Organization Configuration Feature (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("additional_configuration", flattenOrganizationAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+	tfList := flattenOrganizationAdditionalConfigurationResults(feature.AdditionalConfiguration)
+	if v, ok := d.GetOk("additional_configuration"); ok {
+		tfList = filterConfiguredAdditionalConfigurations(v.([]interface{}), tfList)
+	}
+	if err := d.Set("additional_configuration", tfList); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting additional_configuration: %s", err)
 	}
 	d.Set("auto_enable", feature.AutoEnable)
@@ -5,11 +5,13 @@ package guardduty
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -54,12 +57,58 @@ func ResourcePublishingDestination() *schema.Resource {
 			names.AttrKMSKeyARN: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: verify.ValidARNCheck(kmsKeyARNCheck),
 			},
 		},
+
+		CustomizeDiff: resourcePublishingDestinationCustomizeDiff,
 	}
 }
 
+// publishingDestinationARNChecks validates a destination ARN against the requirements of each destination type.
+var publishingDestinationARNChecks = map[awstypes.DestinationType]func(arn.ARN) error{
+	awstypes.DestinationTypeS3: func(v arn.ARN) error {
+		// S3 bucket ARNs, optionally with a key prefix, have no Region or account ID.
+		if v.Service != "s3" || v.Region != "" || v.AccountID != "" || strings.HasPrefix(v.Resource, "/") {
+			return errors.New("must be an S3 bucket ARN, optionally followed by a key prefix")
+		}
+		return nil
+	},
+}
+
+func resourcePublishingDestinationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	destinationType := awstypes.DestinationType(d.Get("destination_type").(string))
+	check, ok := publishingDestinationARNChecks[destinationType]
+	if !ok {
+		return nil
+	}
+
+	// Skip unknown values.
+	destinationARN := d.Get(names.AttrDestinationARN).(string)
+	if destinationARN == "" {
+		return nil
+	}
+
+	v, err := arn.Parse(destinationARN)
+	if err != nil {
+		return fmt.Errorf("%s (%s) is an invalid ARN: %w", names.AttrDestinationARN, destinationARN, err)
+	}
+
+	if err := check(v); err != nil {
+		return fmt.Errorf("%s (%s) for destination type %s %w", names.AttrDestinationARN, destinationARN, destinationType, err)
+	}
+
+	return nil
+}
+
+func kmsKeyARNCheck(v any, k string, arn arn.ARN) (ws []string, errors []error) {
+	// GuardDuty requires a symmetric KMS key ARN, not an alias.
+	if arn.Service != "kms" || !strings.HasPrefix(arn.Resource, "key/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid KMS key ARN", k, v))
+	}
+	return
+}
+
 func resourcePublishingDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).GuardDutyClient(ctx)
@@ -74,18 +123,20 @@ func resourcePublishingDestinationCreate(ctx context.Context, d *schema.Resource
 		DestinationType: awstypes.DestinationType(d.Get("destination_type").(string)),
 	}
 
-	output, err := conn.CreatePublishingDestination(ctx, &input)
+	// Retry for IAM eventual consistency on the destination bucket policy and KMS key policy.
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.BadRequestException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreatePublishingDestination(ctx, &input)
+	}, "does not have permission")
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating GuardDuty Publishing Destination: %s", err)
 	}
 
+	output := outputRaw.(*guardduty.CreatePublishingDestinationOutput)
 	d.SetId(fmt.Sprintf("%s:%s", d.Get("detector_id"), aws.ToString(output.DestinationId)))
 
-	_, err = waitPublishingDestinationCreated(ctx, conn, aws.ToString(output.DestinationId), detectorID)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty PublishingDestination status to be \"%s\": %s",
-			string(awstypes.PublishingStatusPublishing), err)
+	if _, err := waitPublishingDestinationCreated(ctx, conn, aws.ToString(output.DestinationId), detectorID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty Publishing Destination (%s) create: %s", d.Id(), err)
 	}
 
 	return append(diags, resourcePublishingDestinationRead(ctx, d, meta)...)
@@ -146,6 +197,10 @@ func resourcePublishingDestinationUpdate(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "updating GuardDuty Publishing Destination (%s): %s", d.Id(), err)
 	}
 
+	if _, err := waitPublishingDestinationUpdated(ctx, conn, destinationId, detectorId); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty Publishing Destination (%s) update: %s", d.Id(), err)
+	}
+
 	return append(diags, resourcePublishingDestinationRead(ctx, d, meta)...)
 }
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -80,6 +81,44 @@ func testAccPublishingDestination_disappears(t *testing.T) {
 	})
 }
 
+func testAccPublishingDestination_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDetectorNotExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GuardDutyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPublishingDestinationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPublishingDestinationConfig_validation("arn:aws:s3:::example-bucket", "arn:aws:kms:us-west-2:123456789012:alias/example"), //lintignore:AWSAT003,AWSAT005
+				ExpectError: regexache.MustCompile(`is not a valid KMS key ARN`),
+			},
+			{
+				Config:      testAccPublishingDestinationConfig_validation("arn:aws:sns:us-west-2:123456789012:example", "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"), //lintignore:AWSAT003,AWSAT005
+				ExpectError: regexache.MustCompile(`must be an S3 bucket ARN`),
+			},
+		},
+	})
+}
+
+func testAccPublishingDestinationConfig_validation(destinationARN, kmsKeyARN string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_publishing_destination" "test" {
+  detector_id     = aws_guardduty_detector.test.id
+  destination_arn = %[1]q
+  kms_key_arn     = %[2]q
+}
+`, destinationARN, kmsKeyARN)
+}
+
 func testAccPublishingDestinationConfig_basic(bucketName string) string {
 	return fmt.Sprintf(`
 
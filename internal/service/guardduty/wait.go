@@ -5,12 +5,15 @@ package guardduty
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -23,6 +26,12 @@ const (
 	// Maximum amount of time to wait for a PublishingDestination to return Publishing
 	publishingDestinationCreatedTimeout = 5 * time.Minute
 
+	// Maximum amount of time to wait for an updated PublishingDestination to return Publishing
+	publishingDestinationUpdatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for destination bucket and KMS key policies to propagate
+	propagationTimeout = 2 * time.Minute
+
 	// Maximum amount of time to wait for membership to propagate
 	// When removing Organization Admin Accounts, there is eventual
 	// consistency even after the account is no longer listed.
@@ -68,7 +77,7 @@ func waitAdminAccountNotFound(ctx context.Context, conn *guardduty.Client, admin
 }
 
 // waitPublishingDestinationCreated waits for GuardDuty to return Publishing
-func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Client, destinationID, detectorID string) (*guardduty.CreatePublishingDestinationOutput, error) {
+func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Client, destinationID, detectorID string) (*guardduty.DescribePublishingDestinationOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.PublishingStatusPendingVerification),
 		Target:  enum.Slice(awstypes.PublishingStatusPublishing),
@@ -78,7 +87,31 @@ func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Clien
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if v, ok := outputRaw.(*guardduty.CreatePublishingDestinationOutput); ok {
+	if v, ok := outputRaw.(*guardduty.DescribePublishingDestinationOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// waitPublishingDestinationUpdated waits for GuardDuty to return Publishing after the destination properties change
+func waitPublishingDestinationUpdated(ctx context.Context, conn *guardduty.Client, destinationID, detectorID string) (*guardduty.DescribePublishingDestinationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PublishingStatusPendingVerification),
+		Target:  enum.Slice(awstypes.PublishingStatusPublishing),
+		Refresh: statusPublishingDestination(ctx, conn, destinationID, detectorID),
+		Timeout: publishingDestinationUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*guardduty.DescribePublishingDestinationOutput); ok {
+		if v.Status == awstypes.PublishingStatusUnableToPublishFixDestinationProperty {
+			if t := aws.ToInt64(v.PublishingFailureStartTimestamp); t > 0 {
+				tfresource.SetLastError(err, fmt.Errorf("unable to publish findings since %s", time.UnixMilli(t).UTC().Format(time.RFC3339)))
+			}
+		}
+
 		return v, err
 	}
 
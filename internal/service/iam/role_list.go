@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// @SDKListResource("aws_iam_role", name="Role")
+func newRoleListResource() list.ListResourceWithConfigure {
+	return &roleListResource{}
+}
+
+var _ list.ListResourceWithRawV5Schemas = &roleListResource{}
+
+type roleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *roleListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role"
+}
+
+func (l *roleListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"path_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list roles whose paths begin with this prefix, e.g. `/application/`.",
+			},
+		},
+	}
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var config roleListResourceModel
+	if diags := request.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().IAMClient(ctx)
+
+	var input iam.ListRolesInput
+	if diags := fwflex.Expand(ctx, config, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := iam.NewListRolesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("listing IAM Roles", err.Error())
+				yield(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			for _, role := range page.Roles {
+				name := aws.ToString(role.RoleName)
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, request, name, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type roleListResourceModel struct {
+	PathPrefix types.String `tfsdk:"path_prefix"`
+}
@@ -0,0 +1,669 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="CIS Scan Configuration")
+// @Tags(identifierAttribute="arn")
+func newCISScanConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &cisScanConfigurationResource{}, nil
+}
+
+type cisScanConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *cisScanConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_inspector2_cis_scan_configuration"
+}
+
+func (r *cisScanConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	startTimeBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[timeModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"time_of_day": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(regexache.MustCompile(`^([0-1]?[0-9]|2[0-3]):[0-5][0-9]$`), "must be in 24-hour HH:MM format"),
+					},
+				},
+				"timezone": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"scan_name": schema.StringAttribute{
+				Required: true,
+			},
+			"security_level": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CisSecurityLevel](),
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrSchedule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scheduleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"daily": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dailyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("monthly"),
+									path.MatchRelative().AtParent().AtName("one_time"),
+									path.MatchRelative().AtParent().AtName("weekly"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"monthly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[monthlyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"day": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Day](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"one_time": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[oneTimeScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+						"weekly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[weeklyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.SetAttribute{
+										CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.Day]](ctx),
+										ElementType: fwtypes.StringEnumType[awstypes.Day](),
+										Required:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+			"targets": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cisTargetsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(
+									stringvalidator.RegexMatches(regexache.MustCompile(`^(\d{12}|ALL_ACCOUNTS|SELF)$`), "must be a 12-digit account ID, ALL_ACCOUNTS or SELF"),
+								),
+							},
+						},
+						"target_resource_tags": schema.MapAttribute{
+							ElementType: types.SetType{ElemType: types.StringType},
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *cisScanConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	schedule, diags := data.expandSchedule(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	accountIDs, targetResourceTags, diags := data.expandTargets(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.ScanName.ValueString()
+	input := &inspector2.CreateCisScanConfigurationInput{
+		ScanName:      aws.String(name),
+		Schedule:      schedule,
+		SecurityLevel: data.SecurityLevel.ValueEnum(),
+		Tags:          getTagsIn(ctx),
+		Targets: &awstypes.CreateCisTargets{
+			AccountIds:         accountIDs,
+			TargetResourceTags: targetResourceTags,
+		},
+	}
+
+	output, err := conn.CreateCisScanConfiguration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 CIS Scan Configuration (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ScanConfigurationARN = fwflex.StringToFramework(ctx, output.ScanConfigurationArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *cisScanConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	output, err := findCISScanConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 CIS Scan Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ScanName = fwflex.StringToFramework(ctx, output.ScanName)
+	data.SecurityLevel = fwtypes.StringEnumValue(output.SecurityLevel)
+	response.Diagnostics.Append(data.flattenSchedule(ctx, output.Schedule)...)
+	response.Diagnostics.Append(data.flattenTargets(ctx, output.Targets)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cisScanConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	if !new.ScanName.Equal(old.ScanName) ||
+		!new.Schedule.Equal(old.Schedule) ||
+		!new.SecurityLevel.Equal(old.SecurityLevel) ||
+		!new.Targets.Equal(old.Targets) {
+		input := &inspector2.UpdateCisScanConfigurationInput{
+			ScanConfigurationArn: fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.ScanName.Equal(old.ScanName) {
+			input.ScanName = fwflex.StringFromFramework(ctx, new.ScanName)
+		}
+
+		if !new.Schedule.Equal(old.Schedule) {
+			schedule, diags := new.expandSchedule(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.Schedule = schedule
+		}
+
+		if !new.SecurityLevel.Equal(old.SecurityLevel) {
+			input.SecurityLevel = new.SecurityLevel.ValueEnum()
+		}
+
+		if !new.Targets.Equal(old.Targets) {
+			accountIDs, targetResourceTags, diags := new.expandTargets(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.Targets = &awstypes.UpdateCisTargets{
+				AccountIds:         accountIDs,
+				TargetResourceTags: targetResourceTags,
+			}
+		}
+
+		_, err := conn.UpdateCisScanConfiguration(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Inspector2 CIS Scan Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *cisScanConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	_, err := conn.DeleteCisScanConfiguration(ctx, &inspector2.DeleteCisScanConfigurationInput{
+		ScanConfigurationArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 CIS Scan Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *cisScanConfigurationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCISScanConfigurationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.CisScanConfiguration, error) {
+	input := &inspector2.ListCisScanConfigurationsInput{
+		FilterCriteria: &awstypes.ListCisScanConfigurationsFilterCriteria{
+			ScanConfigurationArnFilters: []awstypes.CisStringFilter{
+				{
+					Comparison: awstypes.CisStringComparisonEquals,
+					Value:      aws.String(arn),
+				},
+			},
+		},
+	}
+
+	return findCISScanConfiguration(ctx, conn, input)
+}
+
+func findCISScanConfiguration(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) (*awstypes.CisScanConfiguration, error) {
+	output, err := findCISScanConfigurations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCISScanConfigurations(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) ([]awstypes.CisScanConfiguration, error) {
+	var output []awstypes.CisScanConfiguration
+
+	pages := inspector2.NewListCisScanConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ScanConfigurations...)
+	}
+
+	return output, nil
+}
+
+type cisScanConfigurationResourceModel struct {
+	ID                   types.String                                     `tfsdk:"id"`
+	ScanConfigurationARN types.String                                     `tfsdk:"arn"`
+	ScanName             types.String                                     `tfsdk:"scan_name"`
+	Schedule             fwtypes.ListNestedObjectValueOf[scheduleModel]   `tfsdk:"schedule"`
+	SecurityLevel        fwtypes.StringEnum[awstypes.CisSecurityLevel]    `tfsdk:"security_level"`
+	Tags                 tftags.Map                                       `tfsdk:"tags"`
+	TagsAll              tftags.Map                                       `tfsdk:"tags_all"`
+	Targets              fwtypes.ListNestedObjectValueOf[cisTargetsModel] `tfsdk:"targets"`
+}
+
+func (data *cisScanConfigurationResourceModel) InitFromID() error {
+	data.ScanConfigurationARN = data.ID
+
+	return nil
+}
+
+func (data *cisScanConfigurationResourceModel) setID() {
+	data.ID = data.ScanConfigurationARN
+}
+
+func (data *cisScanConfigurationResourceModel) expandSchedule(ctx context.Context) (awstypes.Schedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scheduleData, d := data.Schedule.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || scheduleData == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !scheduleData.Daily.IsNull():
+		dailyData, d := scheduleData.Daily.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		startTime, d := dailyData.StartTime.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.ScheduleMemberDaily{
+			Value: awstypes.DailySchedule{
+				StartTime: startTime.expand(ctx),
+			},
+		}, diags
+
+	case !scheduleData.Monthly.IsNull():
+		monthlyData, d := scheduleData.Monthly.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		startTime, d := monthlyData.StartTime.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.ScheduleMemberMonthly{
+			Value: awstypes.MonthlySchedule{
+				Day:       monthlyData.Day.ValueEnum(),
+				StartTime: startTime.expand(ctx),
+			},
+		}, diags
+
+	case !scheduleData.OneTime.IsNull():
+		return &awstypes.ScheduleMemberOneTime{
+			Value: awstypes.OneTimeSchedule{},
+		}, diags
+
+	case !scheduleData.Weekly.IsNull():
+		weeklyData, d := scheduleData.Weekly.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		startTime, d := weeklyData.StartTime.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var days []awstypes.Day
+		for _, v := range weeklyData.Days.Elements() {
+			days = append(days, v.(fwtypes.StringEnum[awstypes.Day]).ValueEnum())
+		}
+
+		return &awstypes.ScheduleMemberWeekly{
+			Value: awstypes.WeeklySchedule{
+				Days:      days,
+				StartTime: startTime.expand(ctx),
+			},
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (data *cisScanConfigurationResourceModel) flattenSchedule(ctx context.Context, apiObject awstypes.Schedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	scheduleData := &scheduleModel{
+		Daily:   fwtypes.NewListNestedObjectValueOfNull[dailyScheduleModel](ctx),
+		Monthly: fwtypes.NewListNestedObjectValueOfNull[monthlyScheduleModel](ctx),
+		OneTime: fwtypes.NewListNestedObjectValueOfNull[oneTimeScheduleModel](ctx),
+		Weekly:  fwtypes.NewListNestedObjectValueOfNull[weeklyScheduleModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.ScheduleMemberDaily:
+		scheduleData.Daily = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &dailyScheduleModel{
+			StartTime: flattenTime(ctx, v.Value.StartTime),
+		})
+
+	case *awstypes.ScheduleMemberMonthly:
+		scheduleData.Monthly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &monthlyScheduleModel{
+			Day:       fwtypes.StringEnumValue(v.Value.Day),
+			StartTime: flattenTime(ctx, v.Value.StartTime),
+		})
+
+	case *awstypes.ScheduleMemberOneTime:
+		scheduleData.OneTime = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &oneTimeScheduleModel{})
+
+	case *awstypes.ScheduleMemberWeekly:
+		var elements []attr.Value
+		for _, day := range v.Value.Days {
+			elements = append(elements, fwtypes.StringEnumValue(day))
+		}
+
+		days, d := fwtypes.NewSetValueOf[fwtypes.StringEnum[awstypes.Day]](ctx, elements)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		scheduleData.Weekly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &weeklyScheduleModel{
+			Days:      days,
+			StartTime: flattenTime(ctx, v.Value.StartTime),
+		})
+
+	default:
+		data.Schedule = fwtypes.NewListNestedObjectValueOfNull[scheduleModel](ctx)
+
+		return diags
+	}
+
+	data.Schedule = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, scheduleData)
+
+	return diags
+}
+
+func (data *cisScanConfigurationResourceModel) expandTargets(ctx context.Context) ([]string, map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	targetsData, d := data.Targets.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || targetsData == nil {
+		return nil, nil, diags
+	}
+
+	accountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, targetsData.AccountIDs)
+
+	targetResourceTags := make(map[string][]string)
+	diags.Append(targetsData.TargetResourceTags.ElementsAs(ctx, &targetResourceTags, false)...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	return accountIDs, targetResourceTags, diags
+}
+
+func (data *cisScanConfigurationResourceModel) flattenTargets(ctx context.Context, apiObject *awstypes.CisTargets) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		data.Targets = fwtypes.NewListNestedObjectValueOfNull[cisTargetsModel](ctx)
+
+		return diags
+	}
+
+	targetResourceTags, d := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, apiObject.TargetResourceTags)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var accountIDs []attr.Value
+	for _, v := range apiObject.AccountIds {
+		accountIDs = append(accountIDs, types.StringValue(v))
+	}
+
+	data.Targets = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cisTargetsModel{
+		AccountIDs:         fwtypes.NewSetValueOfMust[types.String](ctx, accountIDs),
+		TargetResourceTags: targetResourceTags,
+	})
+
+	return diags
+}
+
+type scheduleModel struct {
+	Daily   fwtypes.ListNestedObjectValueOf[dailyScheduleModel]   `tfsdk:"daily"`
+	Monthly fwtypes.ListNestedObjectValueOf[monthlyScheduleModel] `tfsdk:"monthly"`
+	OneTime fwtypes.ListNestedObjectValueOf[oneTimeScheduleModel] `tfsdk:"one_time"`
+	Weekly  fwtypes.ListNestedObjectValueOf[weeklyScheduleModel]  `tfsdk:"weekly"`
+}
+
+type dailyScheduleModel struct {
+	StartTime fwtypes.ListNestedObjectValueOf[timeModel] `tfsdk:"start_time"`
+}
+
+type monthlyScheduleModel struct {
+	Day       fwtypes.StringEnum[awstypes.Day]           `tfsdk:"day"`
+	StartTime fwtypes.ListNestedObjectValueOf[timeModel] `tfsdk:"start_time"`
+}
+
+type oneTimeScheduleModel struct{}
+
+type weeklyScheduleModel struct {
+	Days      fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.Day]] `tfsdk:"days"`
+	StartTime fwtypes.ListNestedObjectValueOf[timeModel]           `tfsdk:"start_time"`
+}
+
+type timeModel struct {
+	TimeOfDay types.String `tfsdk:"time_of_day"`
+	Timezone  types.String `tfsdk:"timezone"`
+}
+
+func (m *timeModel) expand(ctx context.Context) *awstypes.Time {
+	if m == nil {
+		return nil
+	}
+
+	return &awstypes.Time{
+		TimeOfDay: fwflex.StringFromFramework(ctx, m.TimeOfDay),
+		Timezone:  fwflex.StringFromFramework(ctx, m.Timezone),
+	}
+}
+
+func flattenTime(ctx context.Context, apiObject *awstypes.Time) fwtypes.ListNestedObjectValueOf[timeModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[timeModel](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &timeModel{
+		TimeOfDay: fwflex.StringToFramework(ctx, apiObject.TimeOfDay),
+		Timezone:  fwflex.StringToFramework(ctx, apiObject.Timezone),
+	})
+}
+
+type cisTargetsModel struct {
+	AccountIDs         fwtypes.SetValueOf[types.String] `tfsdk:"account_ids"`
+	TargetResourceTags types.Map                        `tfsdk:"target_resource_tags"`
+}
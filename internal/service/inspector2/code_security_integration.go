@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Code Security Integration")
+// @Tags(identifierAttribute="arn")
+func newCodeSecurityIntegrationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &codeSecurityIntegrationResource{}, nil
+}
+
+type codeSecurityIntegrationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[codeSecurityIntegrationResourceModel]
+}
+
+func (r *codeSecurityIntegrationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_inspector2_code_security_integration"
+}
+
+func (r *codeSecurityIntegrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"authorization_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 60),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IntegrationStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IntegrationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"gitlab_self_managed": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[gitLabSelfManagedIntegrationDetailModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"access_token": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+						"instance_url": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *codeSecurityIntegrationResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data codeSecurityIntegrationResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() || data.GitLabSelfManaged.IsUnknown() {
+		return
+	}
+
+	if data.Type.ValueEnum() == awstypes.IntegrationTypeGitlabSelfManaged && data.GitLabSelfManaged.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("gitlab_self_managed"),
+			"Missing Attribute Configuration",
+			fmt.Sprintf("gitlab_self_managed must be configured when type is %q.", awstypes.IntegrationTypeGitlabSelfManaged),
+		)
+	}
+
+	if data.Type.ValueEnum() != awstypes.IntegrationTypeGitlabSelfManaged && !data.GitLabSelfManaged.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("gitlab_self_managed"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("gitlab_self_managed can only be configured when type is %q.", awstypes.IntegrationTypeGitlabSelfManaged),
+		)
+	}
+}
+
+func (r *codeSecurityIntegrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data codeSecurityIntegrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	name := data.Name.ValueString()
+	input := &inspector2.CreateCodeSecurityIntegrationInput{
+		Name: aws.String(name),
+		Tags: getTagsIn(ctx),
+		Type: data.Type.ValueEnum(),
+	}
+
+	gitLabSelfManagedData, diags := data.GitLabSelfManaged.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if gitLabSelfManagedData != nil {
+		input.Details = &awstypes.CreateIntegrationDetailMemberGitlabSelfManaged{
+			Value: awstypes.CreateGitLabSelfManagedIntegrationDetail{
+				AccessToken: fwflex.StringFromFramework(ctx, gitLabSelfManagedData.AccessToken),
+				InstanceUrl: fwflex.StringFromFramework(ctx, gitLabSelfManagedData.InstanceURL),
+			},
+		}
+	}
+
+	output, err := conn.CreateCodeSecurityIntegration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 Code Security Integration (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AuthorizationURL = fwflex.StringToFramework(ctx, output.AuthorizationUrl)
+	data.IntegrationARN = fwflex.StringToFramework(ctx, output.IntegrationArn)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *codeSecurityIntegrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data codeSecurityIntegrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	output, err := findCodeSecurityIntegrationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 Code Security Integration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The GitLab self-managed access token is not returned by the API.
+	data.AuthorizationURL = fwflex.StringToFramework(ctx, output.AuthorizationUrl)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.Type = fwtypes.StringEnumValue(output.Type)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *codeSecurityIntegrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data codeSecurityIntegrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	_, err := conn.DeleteCodeSecurityIntegration(ctx, &inspector2.DeleteCodeSecurityIntegrationInput{
+		IntegrationArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 Code Security Integration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *codeSecurityIntegrationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCodeSecurityIntegrationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*inspector2.GetCodeSecurityIntegrationOutput, error) {
+	input := &inspector2.GetCodeSecurityIntegrationInput{
+		IntegrationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetCodeSecurityIntegration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type codeSecurityIntegrationResourceModel struct {
+	AuthorizationURL  types.String                                                             `tfsdk:"authorization_url"`
+	GitLabSelfManaged fwtypes.ListNestedObjectValueOf[gitLabSelfManagedIntegrationDetailModel] `tfsdk:"gitlab_self_managed"`
+	ID                types.String                                                             `tfsdk:"id"`
+	IntegrationARN    types.String                                                             `tfsdk:"arn"`
+	Name              types.String                                                             `tfsdk:"name"`
+	Status            fwtypes.StringEnum[awstypes.IntegrationStatus]                           `tfsdk:"status"`
+	Tags              tftags.Map                                                               `tfsdk:"tags"`
+	TagsAll           tftags.Map                                                               `tfsdk:"tags_all"`
+	Type              fwtypes.StringEnum[awstypes.IntegrationType]                             `tfsdk:"type"`
+}
+
+func (data *codeSecurityIntegrationResourceModel) InitFromID() error {
+	data.IntegrationARN = data.ID
+
+	return nil
+}
+
+func (data *codeSecurityIntegrationResourceModel) setID() {
+	data.ID = data.IntegrationARN
+}
+
+type gitLabSelfManagedIntegrationDetailModel struct {
+	AccessToken types.String `tfsdk:"access_token"`
+	InstanceURL types.String `tfsdk:"instance_url"`
+}
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Code Security Scan Configuration")
+// @Tags(identifierAttribute="arn")
+func newCodeSecurityScanConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &codeSecurityScanConfigurationResource{}, nil
+}
+
+type codeSecurityScanConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *codeSecurityScanConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_inspector2_code_security_scan_configuration"
+}
+
+func (r *codeSecurityScanConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"level": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfigurationLevel](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 60),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9-_$:.]*$`), "must contain only alphanumeric characters and the following characters: - _ $ : ."),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[codeSecurityScanConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_set_categories": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.RuleSetCategory](),
+							ElementType: fwtypes.StringEnumType[awstypes.RuleSetCategory](),
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"continuous_integration_scan_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[continuousIntegrationScanConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"supported_events": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringEnumType[awstypes.ContinuousIntegrationScanEvent](),
+										ElementType: fwtypes.StringEnumType[awstypes.ContinuousIntegrationScanEvent](),
+										Required:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+						"periodic_scan_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[periodicScanConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"frequency": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.PeriodicScanFrequency](),
+										Required:   true,
+									},
+									"frequency_expression": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"scope_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scopeSettingsModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"project_selection_scope": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ProjectSelectionScope](),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *codeSecurityScanConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data codeSecurityScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	name := data.Name.ValueString()
+	var input inspector2.CreateCodeSecurityScanConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCodeSecurityScanConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 Code Security Scan Configuration (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ScanConfigurationARN = fwflex.StringToFramework(ctx, output.ScanConfigurationArn)
+	data.setID()
+
+	scanConfiguration, err := findCodeSecurityScanConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 Code Security Scan Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, scanConfiguration, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *codeSecurityScanConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data codeSecurityScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	output, err := findCodeSecurityScanConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 Code Security Scan Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *codeSecurityScanConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new codeSecurityScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) {
+		var input inspector2.UpdateCodeSecurityScanConfigurationInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateCodeSecurityScanConfiguration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Inspector2 Code Security Scan Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findCodeSecurityScanConfigurationByARN(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 Code Security Scan Configuration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *codeSecurityScanConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data codeSecurityScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	_, err := conn.DeleteCodeSecurityScanConfiguration(ctx, &inspector2.DeleteCodeSecurityScanConfigurationInput{
+		ScanConfigurationArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 Code Security Scan Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *codeSecurityScanConfigurationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findCodeSecurityScanConfigurationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*inspector2.GetCodeSecurityScanConfigurationOutput, error) {
+	input := &inspector2.GetCodeSecurityScanConfigurationInput{
+		ScanConfigurationArn: aws.String(arn),
+	}
+
+	output, err := conn.GetCodeSecurityScanConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type codeSecurityScanConfigurationResourceModel struct {
+	Configuration        fwtypes.ListNestedObjectValueOf[codeSecurityScanConfigurationModel] `tfsdk:"configuration"`
+	ID                   types.String                                                        `tfsdk:"id"`
+	Level                fwtypes.StringEnum[awstypes.ConfigurationLevel]                     `tfsdk:"level"`
+	Name                 types.String                                                        `tfsdk:"name"`
+	ScanConfigurationARN types.String                                                        `tfsdk:"arn"`
+	ScopeSettings        fwtypes.ListNestedObjectValueOf[scopeSettingsModel]                 `tfsdk:"scope_settings"`
+	Tags                 tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll              tftags.Map                                                          `tfsdk:"tags_all"`
+}
+
+func (data *codeSecurityScanConfigurationResourceModel) InitFromID() error {
+	data.ScanConfigurationARN = data.ID
+
+	return nil
+}
+
+func (data *codeSecurityScanConfigurationResourceModel) setID() {
+	data.ID = data.ScanConfigurationARN
+}
+
+type codeSecurityScanConfigurationModel struct {
+	ContinuousIntegrationScanConfiguration fwtypes.ListNestedObjectValueOf[continuousIntegrationScanConfigurationModel] `tfsdk:"continuous_integration_scan_configuration"`
+	PeriodicScanConfiguration              fwtypes.ListNestedObjectValueOf[periodicScanConfigurationModel]              `tfsdk:"periodic_scan_configuration"`
+	RuleSetCategories                      fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.RuleSetCategory]]             `tfsdk:"rule_set_categories"`
+}
+
+type continuousIntegrationScanConfigurationModel struct {
+	SupportedEvents fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.ContinuousIntegrationScanEvent]] `tfsdk:"supported_events"`
+}
+
+type periodicScanConfigurationModel struct {
+	Frequency           fwtypes.StringEnum[awstypes.PeriodicScanFrequency] `tfsdk:"frequency"`
+	FrequencyExpression types.String                                       `tfsdk:"frequency_expression"`
+}
+
+type scopeSettingsModel struct {
+	ProjectSelectionScope fwtypes.StringEnum[awstypes.ProjectSelectionScope] `tfsdk:"project_selection_scope"`
+}
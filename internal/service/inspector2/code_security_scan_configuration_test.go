@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCodeSecurityScanConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v inspector2.GetCodeSecurityScanConfigurationOutput
+	resourceName := "aws_inspector2_code_security_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCodeSecurityScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeSecurityScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeSecurityScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "level", string(awstypes.ConfigurationLevelAccount)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.rule_set_categories.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.rule_set_categories.*", string(awstypes.RuleSetCategorySast)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.continuous_integration_scan_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.periodic_scan_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.periodic_scan_configuration.0.frequency", string(awstypes.PeriodicScanFrequencyWeekly)),
+					resource.TestCheckResourceAttr(resourceName, "scope_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCodeSecurityScanConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v inspector2.GetCodeSecurityScanConfigurationOutput
+	resourceName := "aws_inspector2_code_security_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCodeSecurityScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeSecurityScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeSecurityScanConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfinspector2.ResourceCodeSecurityScanConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCodeSecurityScanConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v inspector2.GetCodeSecurityScanConfigurationOutput
+	resourceName := "aws_inspector2_code_security_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCodeSecurityScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeSecurityScanConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeSecurityScanConfigurationExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccCodeSecurityScanConfigurationConfig_updated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeSecurityScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.rule_set_categories.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.rule_set_categories.*", string(awstypes.RuleSetCategoryIac)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.rule_set_categories.*", string(awstypes.RuleSetCategorySast)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.rule_set_categories.*", string(awstypes.RuleSetCategorySca)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.continuous_integration_scan_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.continuous_integration_scan_configuration.0.supported_events.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.continuous_integration_scan_configuration.0.supported_events.*", string(awstypes.ContinuousIntegrationScanEventPullRequest)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "configuration.0.continuous_integration_scan_configuration.0.supported_events.*", string(awstypes.ContinuousIntegrationScanEventPush)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.periodic_scan_configuration.0.frequency", string(awstypes.PeriodicScanFrequencyMonthly)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCodeSecurityScanConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v inspector2.GetCodeSecurityScanConfigurationOutput
+	resourceName := "aws_inspector2_code_security_scan_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCodeSecurityScanConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeSecurityScanConfigurationConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeSecurityScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCodeSecurityScanConfigurationConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeSecurityScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccCodeSecurityScanConfigurationConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCodeSecurityScanConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCodeSecurityScanConfigurationExists(ctx context.Context, n string, v *inspector2.GetCodeSecurityScanConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		output, err := tfinspector2.FindCodeSecurityScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCodeSecurityScanConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_inspector2_code_security_scan_configuration" {
+				continue
+			}
+
+			_, err := tfinspector2.FindCodeSecurityScanConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Inspector2 Code Security Scan Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCodeSecurityScanConfigurationConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_code_security_scan_configuration" "test" {
+  name  = %[1]q
+  level = "ACCOUNT"
+
+  configuration {
+    rule_set_categories = ["SAST"]
+
+    periodic_scan_configuration {
+      frequency = "WEEKLY"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCodeSecurityScanConfigurationConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_code_security_scan_configuration" "test" {
+  name  = %[1]q
+  level = "ACCOUNT"
+
+  configuration {
+    rule_set_categories = ["IAC", "SAST", "SCA"]
+
+    continuous_integration_scan_configuration {
+      supported_events = ["PULL_REQUEST", "PUSH"]
+    }
+
+    periodic_scan_configuration {
+      frequency = "MONTHLY"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCodeSecurityScanConfigurationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_code_security_scan_configuration" "test" {
+  name  = %[1]q
+  level = "ACCOUNT"
+
+  configuration {
+    rule_set_categories = ["SAST"]
+
+    periodic_scan_configuration {
+      frequency = "WEEKLY"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccCodeSecurityScanConfigurationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_inspector2_code_security_scan_configuration" "test" {
+  name  = %[1]q
+  level = "ACCOUNT"
+
+  configuration {
+    rule_set_categories = ["SAST"]
+
+    periodic_scan_configuration {
+      frequency = "WEEKLY"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -556,7 +556,10 @@ func AccountStatuses(ctx context.Context, conn *inspector2.Client, accountIDs []
 			continue
 		}
 		for k, v := range m {
-			if k == "LambdaCode" {
+			switch k {
+			case "CodeRepository":
+				k = "CODE_REPOSITORY"
+			case "LambdaCode":
 				k = "LAMBDA_CODE"
 			}
 			status.ResourceStatuses[types.ResourceScanType(strings.ToUpper(k))] = v.Status
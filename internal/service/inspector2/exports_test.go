@@ -5,13 +5,19 @@ package inspector2
 
 // Exports for use in tests only.
 var (
-	ResourceDelegatedAdminAccount     = resourceDelegatedAdminAccount
-	ResourceMemberAssociation         = resourceMemberAssociation
-	ResourceOrganizationConfiguration = resourceOrganizationConfiguration
+	ResourceCISScanConfiguration          = newCISScanConfigurationResource
+	ResourceCodeSecurityIntegration       = newCodeSecurityIntegrationResource
+	ResourceCodeSecurityScanConfiguration = newCodeSecurityScanConfigurationResource
+	ResourceDelegatedAdminAccount         = resourceDelegatedAdminAccount
+	ResourceMemberAssociation             = resourceMemberAssociation
+	ResourceOrganizationConfiguration     = resourceOrganizationConfiguration
 
-	FindDelegatedAdminAccountByID = findDelegatedAdminAccountByID
-	FindMemberByAccountID         = findMemberByAccountID
-	FindOrganizationConfiguration = findOrganizationConfiguration
+	FindCISScanConfigurationByARN          = findCISScanConfigurationByARN
+	FindCodeSecurityIntegrationByARN       = findCodeSecurityIntegrationByARN
+	FindCodeSecurityScanConfigurationByARN = findCodeSecurityScanConfigurationByARN
+	FindDelegatedAdminAccountByID          = findDelegatedAdminAccountByID
+	FindMemberByAccountID                  = findMemberByAccountID
+	FindOrganizationConfiguration          = findOrganizationConfiguration
 
 	EnablerID      = enablerID
 	ParseEnablerID = parseEnablerID
@@ -13,9 +13,28 @@ func TestAccInspector2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"CISScanConfiguration": {
+			acctest.CtBasic:      testAccCISScanConfiguration_basic,
+			acctest.CtDisappears: testAccCISScanConfiguration_disappears,
+			"update":             testAccCISScanConfiguration_update,
+			"tags":               testAccCISScanConfiguration_tags,
+		},
+		"CodeSecurityIntegration": {
+			acctest.CtBasic:            testAccCodeSecurityIntegration_basic,
+			acctest.CtDisappears:       testAccCodeSecurityIntegration_disappears,
+			"tags":                     testAccCodeSecurityIntegration_tags,
+			"gitLabSelfManagedMissing": testAccCodeSecurityIntegration_gitLabSelfManagedMissing,
+		},
+		"CodeSecurityScanConfiguration": {
+			acctest.CtBasic:      testAccCodeSecurityScanConfiguration_basic,
+			acctest.CtDisappears: testAccCodeSecurityScanConfiguration_disappears,
+			"update":             testAccCodeSecurityScanConfiguration_update,
+			"tags":               testAccCodeSecurityScanConfiguration_tags,
+		},
 		"Enabler": {
 			acctest.CtBasic:                      testAccEnabler_basic,
 			"accountID":                          testAccEnabler_accountID,
+			"codeRepository":                     testAccEnabler_codeRepository,
 			acctest.CtDisappears:                 testAccEnabler_disappears,
 			"lambda":                             testAccEnabler_lambda,
 			"lambdaCode":                         testAccEnabler_lambdaCode,
@@ -38,6 +57,7 @@ func TestAccInspector2_serial(t *testing.T) {
 		"OrganizationConfiguration": {
 			acctest.CtBasic:      testAccOrganizationConfiguration_basic,
 			acctest.CtDisappears: testAccOrganizationConfiguration_disappears,
+			"codeRepository":     testAccOrganizationConfiguration_codeRepository,
 			"ec2ECR":             testAccOrganizationConfiguration_ec2ECR,
 			"lambda":             testAccOrganizationConfiguration_lambda,
 			"lambdaCode":         testAccOrganizationConfiguration_lambdaCode,
@@ -42,6 +42,11 @@ func resourceOrganizationConfiguration() *schema.Resource {
 				MinItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"code_repository": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"ec2": {
 							Type:     schema.TypeBool,
 							Required: true,
@@ -146,10 +151,11 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Deleting Inspector2 Organization Configuration: %s", d.Id())
 	autoEnable := &awstypes.AutoEnable{
-		Ec2:        aws.Bool(false),
-		Ecr:        aws.Bool(false),
-		Lambda:     aws.Bool(false),
-		LambdaCode: aws.Bool(false),
+		CodeRepository: aws.Bool(false),
+		Ec2:            aws.Bool(false),
+		Ecr:            aws.Bool(false),
+		Lambda:         aws.Bool(false),
+		LambdaCode:     aws.Bool(false),
 	}
 	_, err := conn.UpdateOrganizationConfiguration(ctx, &inspector2.UpdateOrganizationConfigurationInput{
 		AutoEnable: autoEnable,
@@ -203,6 +209,7 @@ func waitOrganizationConfigurationUpdated(ctx context.Context, conn *inspector2.
 		equal = equal && aws.ToBool(output.AutoEnable.Ecr) == aws.ToBool(target.Ecr)
 		equal = equal && aws.ToBool(output.AutoEnable.Lambda) == aws.ToBool(target.Lambda)
 		equal = equal && aws.ToBool(output.AutoEnable.LambdaCode) == aws.ToBool(target.LambdaCode)
+		equal = equal && aws.ToBool(output.AutoEnable.CodeRepository) == aws.ToBool(target.CodeRepository)
 
 		return equal, nil
 	})
@@ -221,6 +228,10 @@ func flattenAutoEnable(apiObject *awstypes.AutoEnable) map[string]interface{} {
 
 	tfMap := map[string]interface{}{}
 
+	if v := apiObject.CodeRepository; v != nil {
+		tfMap["code_repository"] = aws.ToBool(v)
+	}
+
 	if v := apiObject.Ec2; v != nil {
 		tfMap["ec2"] = aws.ToBool(v)
 	}
@@ -247,6 +258,10 @@ func expandAutoEnable(tfMap map[string]interface{}) *awstypes.AutoEnable {
 
 	apiObject := &awstypes.AutoEnable{}
 
+	if v, ok := tfMap["code_repository"].(bool); ok {
+		apiObject.CodeRepository = aws.Bool(v)
+	}
+
 	if v, ok := tfMap["ec2"].(bool); ok {
 		apiObject.Ec2 = aws.Bool(v)
 	}
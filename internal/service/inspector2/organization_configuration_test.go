@@ -156,6 +156,41 @@ func testAccOrganizationConfiguration_lambdaCode(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConfiguration_codeRepository(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_codeRepository(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.code_repository", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccOrganizationConfigurationConfig_codeRepository(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.code_repository", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckOrganizationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
@@ -233,3 +268,23 @@ resource "aws_inspector2_organization_configuration" "test" {
 }
 `, ec2, ecr, lambda, lambda_code)
 }
+
+func testAccOrganizationConfigurationConfig_codeRepository(codeRepository bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_inspector2_organization_configuration" "test" {
+  auto_enable {
+    code_repository = %[1]t
+    ec2             = false
+    ecr             = false
+  }
+
+  depends_on = [aws_inspector2_delegated_admin_account.test]
+}
+`, codeRepository)
+}
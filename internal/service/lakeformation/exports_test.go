@@ -5,12 +5,16 @@ package lakeformation
 
 // exports used for testing only.
 var (
-	ResourceDataCellsFilter = newResourceDataCellsFilter
-	ResourceResourceLFTag   = newResourceResourceLFTag
+	ResourceDataCellsFilter         = newResourceDataCellsFilter
+	ResourceOptIn                   = newResourceOptIn
+	ResourceResourceLFTag           = newResourceResourceLFTag
+	ResourceResourceLFTagsExclusive = newResourceResourceLFTagsExclusive
 
-	FindDataCellsFilterByID = findDataCellsFilterByID
-	FindResourceLFTagByID   = findResourceLFTagByID
-	LFTagParseResourceID    = lfTagParseResourceID
+	FindDataCellsFilterByID         = findDataCellsFilterByID
+	FindOptInByPrincipalAndResource = findOptInByPrincipalAndResource
+	FindResourceLFTagByID           = findResourceLFTagByID
+	FindResourceLFTagsExclusive     = findResourceLFTagsExclusive
+	LFTagParseResourceID            = lfTagParseResourceID
 
 	ValidPrincipal = validPrincipal
 )
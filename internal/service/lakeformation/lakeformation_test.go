@@ -30,6 +30,9 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			acctest.CtBasic:  testAccDataLakeSettingsDataSource_basic,
 			"readOnlyAdmins": testAccDataLakeSettingsDataSource_readOnlyAdmins,
 		},
+		"OptIn": {
+			acctest.CtBasic: testAccOptIn_basic,
+		},
 		"PermissionsBasic": {
 			acctest.CtBasic:         testAccPermissions_basic,
 			"database":              testAccPermissions_database,
@@ -94,6 +97,10 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"table":                testAccResourceLFTags_table,
 			"tableWithColumns":     testAccResourceLFTags_tableWithColumns,
 		},
+		"ResourceLFTagsExclusive": {
+			acctest.CtBasic:     testAccResourceLFTagsExclusive_basic,
+			"outOfBandAddition": testAccResourceLFTagsExclusive_outOfBandAddition,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
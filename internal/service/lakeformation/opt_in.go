@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lakeformation_opt_in", name="Opt In")
+func newResourceOptIn(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceOptIn{}
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameOptIn = "Opt In"
+)
+
+type resourceOptIn struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithNoUpdate
+}
+
+func (r *resourceOptIn) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lakeformation_opt_in"
+}
+
+func (r *resourceOptIn) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"last_modified": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrPrincipal: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrDatabase:   lfTagResourceDatabaseBlock(ctx),
+			"table":              lfTagResourceTableBlock(ctx),
+			"table_with_columns": lfTagResourceTableWithColumnsBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceOptIn) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var plan resourceOptInData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res := expandLFTagResource(ctx, plan.Database, plan.Table, plan.TableWithColumns, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lakeformation.CreateLakeFormationOptInInput{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: fwflex.StringFromFramework(ctx, plan.Principal),
+		},
+		Resource: res,
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, IAMPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateLakeFormationOptIn(ctx, in)
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNameOptIn, prettify(in), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, strconv.Itoa(create.StringHashcode(prettify(in))))
+
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, r.CreateTimeout(ctx, plan.Timeouts), func() (interface{}, error) {
+		return findOptInByPrincipalAndResource(ctx, conn, plan.Principal.ValueString(), res)
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionWaitingForCreation, ResNameOptIn, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	out := outputRaw.(*awstypes.LakeFormationOptInsInfo)
+	plan.LastModified = fwflex.TimeToFramework(ctx, out.LastModified)
+	plan.LastUpdatedBy = fwflex.StringToFramework(ctx, out.LastUpdatedBy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceOptIn) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourceOptInData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res := expandLFTagResource(ctx, state.Database, state.Table, state.TableWithColumns, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findOptInByPrincipalAndResource(ctx, conn, state.Principal.ValueString(), res)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionReading, ResNameOptIn, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.LastModified = fwflex.TimeToFramework(ctx, out.LastModified)
+	state.LastUpdatedBy = fwflex.StringToFramework(ctx, out.LastUpdatedBy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceOptIn) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourceOptInData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res := expandLFTagResource(ctx, state.Database, state.Table, state.TableWithColumns, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lakeformation.DeleteLakeFormationOptInInput{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: fwflex.StringFromFramework(ctx, state.Principal),
+		},
+		Resource: res,
+	}
+
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, IAMPropagationTimeout, func() (interface{}, error) {
+		return conn.DeleteLakeFormationOptIn(ctx, in)
+	})
+
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionDeleting, ResNameOptIn, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceOptIn) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot(names.AttrDatabase),
+			path.MatchRoot("table"),
+			path.MatchRoot("table_with_columns"),
+		),
+	}
+}
+
+func findOptInByPrincipalAndResource(ctx context.Context, conn *lakeformation.Client, principal string, res *awstypes.Resource) (*awstypes.LakeFormationOptInsInfo, error) {
+	in := &lakeformation.ListLakeFormationOptInsInput{
+		Principal: &awstypes.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+		Resource: res,
+	}
+
+	pages := lakeformation.NewListLakeFormationOptInsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.EntityNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LakeFormationOptInsInfoList {
+			if v.Principal != nil && aws.ToString(v.Principal.DataLakePrincipalIdentifier) == principal {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: in,
+	}
+}
+
+type resourceOptInData struct {
+	Database         fwtypes.ListNestedObjectValueOf[Database]         `tfsdk:"database"`
+	ID               types.String                                      `tfsdk:"id"`
+	LastModified     timetypes.RFC3339                                 `tfsdk:"last_modified"`
+	LastUpdatedBy    types.String                                      `tfsdk:"last_updated_by"`
+	Principal        types.String                                      `tfsdk:"principal"`
+	Table            fwtypes.ListNestedObjectValueOf[table]            `tfsdk:"table"`
+	TableWithColumns fwtypes.ListNestedObjectValueOf[tableWithColumns] `tfsdk:"table_with_columns"`
+	Timeouts         timeouts.Value                                    `tfsdk:"timeouts"`
+}
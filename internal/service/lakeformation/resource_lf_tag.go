@@ -72,26 +72,7 @@ func (r *resourceResourceLFTag) Schema(ctx context.Context, req resource.SchemaR
 			names.AttrID:        framework.IDAttribute(),
 		},
 		Blocks: map[string]schema.Block{
-			names.AttrDatabase: schema.ListNestedBlock{
-				CustomType: fwtypes.NewListNestedObjectTypeOf[Database](ctx),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						names.AttrCatalogID: catalogIDSchemaOptional(),
-						names.AttrName: schema.StringAttribute{
-							Required: true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-							},
-						},
-					},
-				},
-			},
+			names.AttrDatabase: lfTagResourceDatabaseBlock(ctx),
 			"lf_tag": schema.ListNestedBlock{
 				CustomType: fwtypes.NewListNestedObjectTypeOf[LFTag](ctx),
 				Validators: []validator.List{
@@ -126,120 +107,151 @@ func (r *resourceResourceLFTag) Schema(ctx context.Context, req resource.SchemaR
 					},
 				},
 			},
-			"table": schema.ListNestedBlock{
-				CustomType: fwtypes.NewListNestedObjectTypeOf[table](ctx),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
+			"table":              lfTagResourceTableBlock(ctx),
+			"table_with_columns": lfTagResourceTableWithColumnsBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func lfTagResourceDatabaseBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[Database](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrCatalogID: catalogIDSchemaOptional(),
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
 				},
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func lfTagResourceTableBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[table](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrCatalogID: catalogIDSchemaOptional(),
+				names.AttrDatabaseName: schema.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
 				},
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						names.AttrCatalogID: catalogIDSchemaOptional(),
-						names.AttrDatabaseName: schema.StringAttribute{
-							Required: true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-							},
-						},
-						names.AttrName: schema.StringAttribute{
-							Optional: true,
-							Validators: []validator.String{
-								stringvalidator.AtLeastOneOf(
-									path.MatchRelative().AtParent().AtName(names.AttrName),
-									path.MatchRelative().AtParent().AtName("wildcard"),
-								),
-							},
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-							},
-						},
-						"wildcard": schema.BoolAttribute{
-							Optional: true,
-							Validators: []validator.Bool{
-								boolvalidator.AtLeastOneOf(
-									path.MatchRelative().AtParent().AtName(names.AttrName),
-									path.MatchRelative().AtParent().AtName("wildcard"),
-								),
-							},
-							PlanModifiers: []planmodifier.Bool{
-								boolplanmodifier.RequiresReplace(),
-							},
-						},
+				names.AttrName: schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.AtLeastOneOf(
+							path.MatchRelative().AtParent().AtName(names.AttrName),
+							path.MatchRelative().AtParent().AtName("wildcard"),
+						),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"wildcard": schema.BoolAttribute{
+					Optional: true,
+					Validators: []validator.Bool{
+						boolvalidator.AtLeastOneOf(
+							path.MatchRelative().AtParent().AtName(names.AttrName),
+							path.MatchRelative().AtParent().AtName("wildcard"),
+						),
+					},
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.RequiresReplace(),
 					},
 				},
 			},
-			"table_with_columns": schema.ListNestedBlock{
-				CustomType: fwtypes.NewListNestedObjectTypeOf[tableWithColumns](ctx),
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func lfTagResourceTableWithColumnsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[tableWithColumns](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrCatalogID: catalogIDSchemaOptional(),
+				"column_names": schema.SetAttribute{
+					CustomType: fwtypes.SetOfStringType,
+					Optional:   true,
+					Validators: []validator.Set{
+						setvalidator.AtLeastOneOf(
+							path.MatchRelative().AtParent().AtName("column_names"),
+							path.MatchRelative().AtParent().AtName("column_wildcard"),
+						),
+					},
+					PlanModifiers: []planmodifier.Set{
+						setplanmodifier.RequiresReplace(),
+					},
 				},
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+				names.AttrDatabaseName: schema.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
 				},
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						names.AttrCatalogID: catalogIDSchemaOptional(),
-						"column_names": schema.SetAttribute{
-							CustomType: fwtypes.SetOfStringType,
-							Optional:   true,
-							Validators: []validator.Set{
-								setvalidator.AtLeastOneOf(
-									path.MatchRelative().AtParent().AtName("column_names"),
-									path.MatchRelative().AtParent().AtName("column_wildcard"),
-								),
-							},
-							PlanModifiers: []planmodifier.Set{
-								setplanmodifier.RequiresReplace(),
-							},
-						},
-						names.AttrDatabaseName: schema.StringAttribute{
-							Required: true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-							},
-						},
-						names.AttrName: schema.StringAttribute{
-							Required: true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-								stringplanmodifier.UseStateForUnknown(),
-							},
-						},
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+						stringplanmodifier.UseStateForUnknown(),
 					},
-					Blocks: map[string]schema.Block{
-						"column_wildcard": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[columnWildcardData](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
-								listvalidator.AtLeastOneOf(
-									path.MatchRelative().AtParent().AtName("column_names"),
-									path.MatchRelative().AtParent().AtName("column_wildcard"),
-								),
-							},
-							PlanModifiers: []planmodifier.List{
-								listplanmodifier.RequiresReplace(),
-							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"excluded_column_names": schema.SetAttribute{
-										CustomType: fwtypes.SetOfStringType,
-										Optional:   true,
-										PlanModifiers: []planmodifier.Set{
-											setplanmodifier.RequiresReplace(),
-										},
-									},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"column_wildcard": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[columnWildcardData](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+						listvalidator.AtLeastOneOf(
+							path.MatchRelative().AtParent().AtName("column_names"),
+							path.MatchRelative().AtParent().AtName("column_wildcard"),
+						),
+					},
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"excluded_column_names": schema.SetAttribute{
+								CustomType: fwtypes.SetOfStringType,
+								Optional:   true,
+								PlanModifiers: []planmodifier.Set{
+									setplanmodifier.RequiresReplace(),
 								},
 							},
 						},
 					},
 				},
 			},
-			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
-				Create: true,
-				Delete: true,
-			}),
 		},
 	}
 }
@@ -537,6 +549,54 @@ func findResourceLFTagByID(ctx context.Context, conn *lakeformation.Client, cata
 	return out, nil
 }
 
+// expandLFTagResource expands whichever one of the database, table or table with columns
+// blocks is configured into a Data Catalog resource.
+func expandLFTagResource(ctx context.Context, database fwtypes.ListNestedObjectValueOf[Database], tbl fwtypes.ListNestedObjectValueOf[table], tblWithColumns fwtypes.ListNestedObjectValueOf[tableWithColumns], diags *diag.Diagnostics) *awstypes.Resource {
+	var r awstypes.Resource
+
+	switch {
+	case !database.IsNull():
+		ptr, d := database.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil
+		}
+
+		var apiObject awstypes.DatabaseResource
+		diags.Append(fwflex.Expand(ctx, ptr, &apiObject)...)
+		r.Database = &apiObject
+	case !tbl.IsNull():
+		ptr, d := tbl.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil
+		}
+
+		var apiObject awstypes.TableResource
+		diags.Append(fwflex.Expand(ctx, ptr, &apiObject)...)
+		r.Table = &apiObject
+	case !tblWithColumns.IsNull():
+		ptr, d := tblWithColumns.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil
+		}
+
+		var apiObject awstypes.TableWithColumnsResource
+		diags.Append(fwflex.Expand(ctx, ptr, &apiObject)...)
+		r.TableWithColumns = &apiObject
+	default:
+		diags.AddError("unexpected resource type",
+			"unexpected resource type")
+	}
+
+	if diags.HasError() {
+		return nil
+	}
+
+	return &r
+}
+
 type lfTagTagger interface {
 	expandResource(context.Context, *diag.Diagnostics) *awstypes.Resource
 	findTag(context.Context, *lakeformation.GetResourceLFTagsOutput, *diag.Diagnostics) fwtypes.ListNestedObjectValueOf[LFTag]
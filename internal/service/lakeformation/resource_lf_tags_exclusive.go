@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lakeformation_resource_lf_tags_exclusive", name="Resource LF Tags Exclusive")
+func newResourceResourceLFTagsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceResourceLFTagsExclusive{}, nil
+}
+
+const (
+	ResNameResourceLFTagsExclusive = "Resource LF Tags Exclusive"
+)
+
+type resourceResourceLFTagsExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceResourceLFTagsExclusive) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lakeformation_resource_lf_tags_exclusive"
+}
+
+func (r *resourceResourceLFTagsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCatalogID: catalogIDSchemaOptional(),
+			names.AttrID:        framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrDatabase: lfTagResourceDatabaseBlock(ctx),
+			"lf_tag": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[lfTagExclusive](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^([\p{L}\p{Z}\p{N}_.:\*\/=+\-@%]*)$`), ""),
+							},
+						},
+					},
+				},
+			},
+			"table":              lfTagResourceTableBlock(ctx),
+			"table_with_columns": lfTagResourceTableWithColumnsBlock(ctx),
+		},
+	}
+}
+
+func (r *resourceResourceLFTagsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceResourceLFTagsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res := expandLFTagResource(ctx, plan.Database, plan.Table, plan.TableWithColumns, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := expandLFTagsExclusive(ctx, plan.LFTag)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncLFTags(ctx, plan.CatalogID.ValueString(), res, want); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNameResourceLFTagsExclusive, prettify(res), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = fwflex.StringValueToFramework(ctx, strconv.Itoa(create.StringHashcode(prettify(res))))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceResourceLFTagsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourceResourceLFTagsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res := expandLFTagResource(ctx, state.Database, state.Table, state.TableWithColumns, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findResourceLFTagsExclusive(ctx, conn, state.CatalogID.ValueString(), res)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionReading, ResNameResourceLFTagsExclusive, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	tags := make([]*lfTagExclusive, 0, len(out))
+	for _, v := range out {
+		for _, value := range v.TagValues {
+			tags = append(tags, &lfTagExclusive{
+				Key:   fwflex.StringToFramework(ctx, v.TagKey),
+				Value: fwflex.StringValueToFramework(ctx, value),
+			})
+		}
+	}
+
+	lfTags, diags := fwtypes.NewSetNestedObjectValueOfSlice(ctx, tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.LFTag = lfTags
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceResourceLFTagsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceResourceLFTagsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.LFTag.Equal(state.LFTag) {
+		res := expandLFTagResource(ctx, plan.Database, plan.Table, plan.TableWithColumns, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		want, diags := expandLFTagsExclusive(ctx, plan.LFTag)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := r.syncLFTags(ctx, plan.CatalogID.ValueString(), res, want); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LakeFormation, create.ErrActionUpdating, ResNameResourceLFTagsExclusive, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceResourceLFTagsExclusive) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot(names.AttrDatabase),
+			path.MatchRoot("table"),
+			path.MatchRoot("table_with_columns"),
+		),
+	}
+}
+
+// syncLFTags handles keeping the configured LF-Tag assignments in sync with
+// the remote resource.
+//
+// LF-Tags defined on this resource but not assigned to the Data Catalog
+// resource will be added, and LF-Tags assigned with a different value will
+// be overwritten. LF-Tags assigned to the Data Catalog resource but not
+// configured on this resource will be removed.
+func (r *resourceResourceLFTagsExclusive) syncLFTags(ctx context.Context, catalogID string, res *awstypes.Resource, want map[string]string) error {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	out, err := findResourceLFTagsExclusive(ctx, conn, catalogID, res)
+	if err != nil {
+		return err
+	}
+
+	have := make(map[string]string)
+	for _, v := range out {
+		if len(v.TagValues) > 0 {
+			have[aws.ToString(v.TagKey)] = v.TagValues[0]
+		}
+	}
+
+	var add, remove []awstypes.LFTagPair
+	for key, value := range want {
+		if v, ok := have[key]; !ok || v != value {
+			add = append(add, awstypes.LFTagPair{
+				TagKey:    aws.String(key),
+				TagValues: []string{value},
+			})
+		}
+	}
+	for key, value := range have {
+		if _, ok := want[key]; !ok {
+			remove = append(remove, awstypes.LFTagPair{
+				TagKey:    aws.String(key),
+				TagValues: []string{value},
+			})
+		}
+	}
+
+	if len(remove) > 0 {
+		input := &lakeformation.RemoveLFTagsFromResourceInput{
+			LFTags:   remove,
+			Resource: res,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		outputRaw, err := tfresource.RetryWhen(ctx, IAMPropagationTimeout,
+			func() (interface{}, error) {
+				return conn.RemoveLFTagsFromResource(ctx, input)
+			},
+			retryLFTagsExclusive,
+		)
+
+		if err != nil {
+			return fmt.Errorf("removing Lake Formation LF-Tags: %w", err)
+		}
+
+		if output := outputRaw.(*lakeformation.RemoveLFTagsFromResourceOutput); output != nil {
+			if err := lfTagErrors(output.Failures); err != nil {
+				return fmt.Errorf("removing Lake Formation LF-Tags: %w", err)
+			}
+		}
+	}
+
+	if len(add) > 0 {
+		input := &lakeformation.AddLFTagsToResourceInput{
+			LFTags:   add,
+			Resource: res,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		outputRaw, err := tfresource.RetryWhen(ctx, IAMPropagationTimeout,
+			func() (interface{}, error) {
+				return conn.AddLFTagsToResource(ctx, input)
+			},
+			retryLFTagsExclusive,
+		)
+
+		if err != nil {
+			return fmt.Errorf("adding Lake Formation LF-Tags: %w", err)
+		}
+
+		if output := outputRaw.(*lakeformation.AddLFTagsToResourceOutput); output != nil {
+			if err := lfTagErrors(output.Failures); err != nil {
+				return fmt.Errorf("adding Lake Formation LF-Tags: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func retryLFTagsExclusive(err error) (bool, error) {
+	if errs.IsA[*awstypes.ConcurrentModificationException](err) || errs.IsA[*awstypes.AccessDeniedException](err) {
+		return true, err
+	}
+
+	return false, err
+}
+
+func lfTagErrors(failures []awstypes.LFTagError) error {
+	var failureErrs []error
+
+	for _, v := range failures {
+		if v.LFTag == nil || v.Error == nil {
+			continue
+		}
+
+		failureErrs = append(failureErrs, fmt.Errorf("tag key:%s, values:%+v: %s: %s", aws.ToString(v.LFTag.TagKey), v.LFTag.TagValues, aws.ToString(v.Error.ErrorCode), aws.ToString(v.Error.ErrorMessage)))
+	}
+
+	return errors.Join(failureErrs...)
+}
+
+// findResourceLFTagsExclusive returns the LF-Tags assigned directly to the specified Data Catalog resource.
+func findResourceLFTagsExclusive(ctx context.Context, conn *lakeformation.Client, catalogID string, res *awstypes.Resource) ([]awstypes.LFTagPair, error) {
+	out, err := findResourceLFTagByID(ctx, conn, catalogID, res)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case res.Database != nil:
+		return out.LFTagOnDatabase, nil
+	case res.Table != nil:
+		return out.LFTagsOnTable, nil
+	case res.TableWithColumns != nil:
+		if len(out.LFTagsOnColumns) == 0 {
+			return nil, nil
+		}
+
+		return out.LFTagsOnColumns[0].LFTags, nil
+	}
+
+	return nil, nil
+}
+
+func expandLFTagsExclusive(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[lfTagExclusive]) (map[string]string, diag.Diagnostics) {
+	tags, diags := tfSet.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObject := make(map[string]string, len(tags))
+	for _, v := range tags {
+		key := v.Key.ValueString()
+		if _, ok := apiObject[key]; ok {
+			diags.AddAttributeError(
+				path.Root("lf_tag"),
+				"Duplicate LF-Tag key",
+				fmt.Sprintf("LF-Tag key %q is configured more than once. A Data Catalog resource can have only one value for each LF-Tag key.", key),
+			)
+			continue
+		}
+
+		apiObject[key] = v.Value.ValueString()
+	}
+
+	return apiObject, diags
+}
+
+type resourceResourceLFTagsExclusiveData struct {
+	CatalogID        types.String                                      `tfsdk:"catalog_id"`
+	Database         fwtypes.ListNestedObjectValueOf[Database]         `tfsdk:"database"`
+	ID               types.String                                      `tfsdk:"id"`
+	LFTag            fwtypes.SetNestedObjectValueOf[lfTagExclusive]    `tfsdk:"lf_tag"`
+	Table            fwtypes.ListNestedObjectValueOf[table]            `tfsdk:"table"`
+	TableWithColumns fwtypes.ListNestedObjectValueOf[tableWithColumns] `tfsdk:"table_with_columns"`
+}
+
+type lfTagExclusive struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccResourceLFTagsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tags_exclusive.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "database.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						names.AttrKey:   rName + "-1",
+						names.AttrValue: "value1",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceLFTagsExclusiveConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExclusiveExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						names.AttrKey:   rName + "-1",
+						names.AttrValue: "value2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						names.AttrKey:   rName + "-2",
+						names.AttrValue: "value1",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceLFTagsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						names.AttrKey:   rName + "-1",
+						names.AttrValue: "value1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceLFTagsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tags_exclusive.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LakeFormation)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExclusiveExists(ctx, resourceName, 1),
+					testAccCheckResourceLFTagsExclusiveAddLFTag(ctx, resourceName, rName+"-2", "value1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceLFTagsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceLFTagsExclusiveResource(rs *terraform.ResourceState) *awstypes.Resource {
+	res := &awstypes.Resource{
+		Database: &awstypes.DatabaseResource{
+			Name: aws.String(rs.Primary.Attributes["database.0.name"]),
+		},
+	}
+
+	if v, ok := rs.Primary.Attributes["database.0.catalog_id"]; ok && v != "" {
+		res.Database.CatalogId = aws.String(v)
+	}
+
+	return res
+}
+
+func testAccCheckResourceLFTagsExclusiveExists(ctx context.Context, name string, expectedCount int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameResourceLFTagsExclusive, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		out, err := tflakeformation.FindResourceLFTagsExclusive(ctx, conn, rs.Primary.Attributes[names.AttrCatalogID], testAccResourceLFTagsExclusiveResource(rs))
+		if err != nil {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameResourceLFTagsExclusive, rs.Primary.ID, err)
+		}
+
+		if count, _ := strconv.Atoi(rs.Primary.Attributes["lf_tag.#"]); count != expectedCount || len(out) != expectedCount {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNameResourceLFTagsExclusive, rs.Primary.ID, fmt.Errorf("expected %d LF-Tags, got %d", expectedCount, len(out)))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceLFTagsExclusiveAddLFTag(ctx context.Context, name, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		_, err := conn.AddLFTagsToResource(ctx, &lakeformation.AddLFTagsToResourceInput{
+			LFTags: []awstypes.LFTagPair{{
+				TagKey:    aws.String(key),
+				TagValues: []string{value},
+			}},
+			Resource: testAccResourceLFTagsExclusiveResource(rs),
+		})
+
+		return err
+	}
+}
+
+func testAccResourceLFTagsExclusiveConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_lakeformation_lf_tag" "test1" {
+  key    = "%[1]s-1"
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are set up before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_lakeformation_lf_tag" "test2" {
+  key    = "%[1]s-2"
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are set up before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName)
+}
+
+func testAccResourceLFTagsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccResourceLFTagsExclusiveConfig_base(rName),
+		`
+resource "aws_lakeformation_resource_lf_tags_exclusive" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test1.key
+    value = "value1"
+  }
+}
+`)
+}
+
+func testAccResourceLFTagsExclusiveConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(
+		testAccResourceLFTagsExclusiveConfig_base(rName),
+		`
+resource "aws_lakeformation_resource_lf_tags_exclusive" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test1.key
+    value = "value2"
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test2.key
+    value = "value1"
+  }
+}
+`)
+}
@@ -24,10 +24,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceDataCellsFilter,
 			Name:    "Data Cells Filter",
 		},
+		{
+			Factory: newResourceOptIn,
+			Name:    "Opt In",
+		},
 		{
 			Factory: newResourceResourceLFTag,
 			Name:    "Resource LF Tag",
 		},
+		{
+			Factory: newResourceResourceLFTagsExclusive,
+			Name:    "Resource LF Tags Exclusive",
+		},
 	}
 }
 
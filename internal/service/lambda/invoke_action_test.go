@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaInvokeAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ssmParameterName := fmt.Sprintf("/tf-test/action/%s", rName)
+	// The test function records its input in an SSM parameter when invoked with a "delete" action.
+	payloadJSON := `{"key1":"value1","key2":"value2","tf":{"action":"delete"}}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.LambdaServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccInvocationConfig_function("lambda_invocation_crud", rName, ssmParameterName),
+					testAccInvocationConfig_crudAllowSSM(rName, ssmParameterName),
+					testAccInvokeActionConfig_basic(rName, payloadJSON),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvokeActionResult(ctx, ssmParameterName, payloadJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaInvokeAction_functionNotFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.LambdaServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInvokeActionConfig_functionNotFound(rName),
+				ExpectError: regexache.MustCompile(`invoking Lambda Function`),
+			},
+		},
+	})
+}
+
+// testAccCheckInvokeActionResult verifies the input with which the Lambda function was invoked.
+// Actions do not record anything in state so the test function stores its input in an SSM parameter.
+// We will read it out, compare with the expected result and clean up the SSM parameter.
+func testAccCheckInvokeActionResult(ctx context.Context, ssmParameterName, expectedResult string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+		res, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+			Name:           aws.String(ssmParameterName),
+			WithDecryption: aws.Bool(true),
+		})
+
+		if cleanupErr := removeSSMParameter(ctx, conn, ssmParameterName); cleanupErr != nil {
+			return fmt.Errorf("Could not cleanup SSM Parameter %s", ssmParameterName)
+		}
+
+		if err != nil {
+			return fmt.Errorf("Could not get SSM Parameter %s", ssmParameterName)
+		}
+
+		if !verify.JSONStringsEqual(*res.Parameter.Value, expectedResult) {
+			return fmt.Errorf("action input expected %s, got %s", expectedResult, *res.Parameter.Value)
+		}
+
+		return nil
+	}
+}
+
+func testAccInvokeActionConfig_basic(rName, payloadJSON string) string {
+	return fmt.Sprintf(`
+action "aws_lambda_invoke" "test" {
+  config {
+    function_name = aws_lambda_function.test.function_name
+    payload       = %[2]q
+  }
+}
+
+resource "terraform_data" "test" {
+  input = %[1]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_lambda_invoke.test]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test_ssm]
+}
+`, rName, payloadJSON)
+}
+
+func testAccInvokeActionConfig_functionNotFound(rName string) string {
+	return fmt.Sprintf(`
+action "aws_lambda_invoke" "test" {
+  config {
+    function_name = %[1]q
+    payload       = jsonencode({})
+  }
+}
+
+resource "terraform_data" "test" {
+  input = %[1]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_lambda_invoke.test]
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Bot")
+func newDataSourceBot(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceBot{}, nil
+}
+
+const (
+	DSNameBot = "Bot Data Source"
+)
+
+type dataSourceBot struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceBot) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_lexv2models_bot"
+}
+
+func (d *dataSourceBot) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"data_privacy": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataPrivacyData](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[dataPrivacyData](ctx),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"idle_session_ttl_in_seconds": schema.Int64Attribute{
+				Computed: true,
+			},
+			"last_updated_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BotStatus](),
+				Computed:   true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BotType](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceBot) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().LexV2ModelsClient(ctx)
+
+	var data dataSourceBotData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findBotByName(ctx, conn, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, DSNameBot, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data, flex.WithFieldNamePrefix("Bot"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	botARN := d.Meta().RegionalARN(ctx, "lex", fmt.Sprintf("bot/%s", aws.ToString(out.BotId)))
+	data.ARN = flex.StringValueToFramework(ctx, botARN)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findBotByName(ctx context.Context, conn *lexmodelsv2.Client, name string) (*lexmodelsv2.DescribeBotOutput, error) {
+	in := &lexmodelsv2.ListBotsInput{
+		Filters: []awstypes.BotFilter{
+			{
+				Name:     awstypes.BotFilterNameBotName,
+				Operator: awstypes.BotFilterOperatorEquals,
+				Values:   []string{name},
+			},
+		},
+	}
+
+	bots, err := findBots(ctx, conn, in)
+	if err != nil {
+		return nil, err
+	}
+
+	// The filter is not guaranteed to be an exact match.
+	var ids []string
+	for _, v := range bots {
+		if aws.ToString(v.BotName) == name {
+			ids = append(ids, aws.ToString(v.BotId))
+		}
+	}
+
+	switch count := len(ids); count {
+	case 0:
+		return nil, tfresource.NewEmptyResultError(in)
+	case 1:
+		return FindBotByID(ctx, conn, ids[0])
+	default:
+		return nil, tfresource.NewTooManyResultsError(count, in)
+	}
+}
+
+func findBots(ctx context.Context, conn *lexmodelsv2.Client, in *lexmodelsv2.ListBotsInput) ([]awstypes.BotSummary, error) {
+	var bots []awstypes.BotSummary
+
+	pages := lexmodelsv2.NewListBotsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		bots = append(bots, page.BotSummaries...)
+	}
+
+	return bots, nil
+}
+
+type dataSourceBotData struct {
+	ARN                     types.String                                     `tfsdk:"arn"`
+	CreationDateTime        timetypes.RFC3339                                `tfsdk:"creation_date_time"`
+	DataPrivacy             fwtypes.ListNestedObjectValueOf[dataPrivacyData] `tfsdk:"data_privacy"`
+	Description             types.String                                     `tfsdk:"description"`
+	ID                      types.String                                     `tfsdk:"id"`
+	IdleSessionTTLInSeconds types.Int64                                      `tfsdk:"idle_session_ttl_in_seconds"`
+	LastUpdatedDateTime     timetypes.RFC3339                                `tfsdk:"last_updated_date_time"`
+	Name                    types.String                                     `tfsdk:"name"`
+	RoleARN                 types.String                                     `tfsdk:"role_arn"`
+	Status                  fwtypes.StringEnum[awstypes.BotStatus]           `tfsdk:"status"`
+	Type                    fwtypes.StringEnum[awstypes.BotType]             `tfsdk:"type"`
+}
@@ -401,6 +401,27 @@ func waitBotLocaleUpdated(ctx context.Context, conn *lexmodelsv2.Client, id stri
 	return nil, err
 }
 
+// waitBotLocaleStable waits for a bot locale to leave any transitional status,
+// e.g. after a child resource such as a slot is mutated.
+func waitBotLocaleStable(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BotLocaleStatusBuilding, awstypes.BotLocaleStatusCreating, awstypes.BotLocaleStatusImporting, awstypes.BotLocaleStatusProcessing),
+		Target:                    enum.Slice(awstypes.BotLocaleStatusBuilt, awstypes.BotLocaleStatusNotBuilt, awstypes.BotLocaleStatusReadyExpressTesting),
+		Refresh:                   statusBotLocale(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
 func waitBotLocaleDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.BotLocaleStatusDeleting),
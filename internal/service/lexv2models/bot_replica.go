@@ -0,0 +1,433 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Bot Replica")
+func newResourceBotReplica(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceBotReplica{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameBotReplica = "Bot Replica"
+
+	botReplicaIDPartCount = 2
+)
+
+type resourceBotReplica struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *resourceBotReplica) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_bot_replica"
+}
+
+func (r *resourceBotReplica) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alias_replicas": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[botAliasReplicaSummaryData](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[botAliasReplicaSummaryData](ctx),
+				},
+			},
+			"bot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bot_replica_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BotReplicaStatus](),
+				Computed:   true,
+			},
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"replica_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_replicas": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[botVersionReplicaSummaryData](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[botVersionReplicaSummaryData](ctx),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceBotReplica) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceBotReplicaData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := []string{
+		plan.BotID.ValueString(),
+		plan.ReplicaRegion.ValueString(),
+	}
+	id, err := intflex.FlattenResourceId(idParts, botReplicaIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotReplica, plan.BotID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	in := &lexmodelsv2.CreateBotReplicaInput{
+		BotId:         plan.BotID.ValueStringPointer(),
+		ReplicaRegion: plan.ReplicaRegion.ValueStringPointer(),
+	}
+
+	out, err := conn.CreateBotReplica(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotReplica, id, err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotReplica, id, nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	waitOut, err := waitBotReplicaEnabled(ctx, conn, id, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotReplica, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.refreshFromOutput(ctx, conn, waitOut)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceBotReplica) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotReplicaData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findBotReplicaByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotReplica, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refreshFromOutput(ctx, conn, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceBotReplica) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotReplicaData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.DeleteBotReplicaInput{
+		BotId:         state.BotID.ValueStringPointer(),
+		ReplicaRegion: state.ReplicaRegion.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteBotReplica(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameBotReplica, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitBotReplicaDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameBotReplica, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func waitBotReplicaEnabled(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotReplicaOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BotReplicaStatusEnabling),
+		Target:                    enum.Slice(awstypes.BotReplicaStatusEnabled),
+		Refresh:                   statusBotReplica(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotReplicaOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitBotReplicaDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotReplicaOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BotReplicaStatusDeleting, awstypes.BotReplicaStatusEnabled),
+		Target:  []string{},
+		Refresh: statusBotReplica(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotReplicaOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusBotReplica(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findBotReplicaByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.BotReplicaStatus), nil
+	}
+}
+
+func findBotReplicaByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeBotReplicaOutput, error) {
+	parts, err := intflex.ExpandResourceId(id, botReplicaIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &lexmodelsv2.DescribeBotReplicaInput{
+		BotId:         aws.String(parts[0]),
+		ReplicaRegion: aws.String(parts[1]),
+	}
+
+	out, err := conn.DescribeBotReplica(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.BotId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func findBotAliasReplicas(ctx context.Context, conn *lexmodelsv2.Client, botID, replicaRegion string) ([]awstypes.BotAliasReplicaSummary, error) {
+	in := &lexmodelsv2.ListBotAliasReplicasInput{
+		BotId:         aws.String(botID),
+		ReplicaRegion: aws.String(replicaRegion),
+	}
+
+	var summaries []awstypes.BotAliasReplicaSummary
+	pages := lexmodelsv2.NewListBotAliasReplicasPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, page.BotAliasReplicaSummaries...)
+	}
+
+	return summaries, nil
+}
+
+func findBotVersionReplicas(ctx context.Context, conn *lexmodelsv2.Client, botID, replicaRegion string) ([]awstypes.BotVersionReplicaSummary, error) {
+	in := &lexmodelsv2.ListBotVersionReplicasInput{
+		BotId:         aws.String(botID),
+		ReplicaRegion: aws.String(replicaRegion),
+	}
+
+	var summaries []awstypes.BotVersionReplicaSummary
+	pages := lexmodelsv2.NewListBotVersionReplicasPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, page.BotVersionReplicaSummaries...)
+	}
+
+	return summaries, nil
+}
+
+// refreshFromOutput sets the replica attributes and the aliases and versions
+// that have been replicated to the replica Region.
+func (rd *resourceBotReplicaData) refreshFromOutput(ctx context.Context, conn *lexmodelsv2.Client, out *lexmodelsv2.DescribeBotReplicaOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if out == nil {
+		return diags
+	}
+
+	rd.BotID = flex.StringToFramework(ctx, out.BotId)
+	rd.BotReplicaStatus = fwtypes.StringEnumValue(out.BotReplicaStatus)
+	rd.CreationDateTime = flex.TimeToFramework(ctx, out.CreationDateTime)
+	rd.ReplicaRegion = flex.StringToFramework(ctx, out.ReplicaRegion)
+	rd.SourceRegion = flex.StringToFramework(ctx, out.SourceRegion)
+
+	aliases, err := findBotAliasReplicas(ctx, conn, rd.BotID.ValueString(), rd.ReplicaRegion.ValueString())
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotReplica, rd.ID.String(), err),
+			err.Error(),
+		)
+		return diags
+	}
+	diags.Append(flex.Flatten(ctx, aliases, &rd.AliasReplicas)...)
+
+	versions, err := findBotVersionReplicas(ctx, conn, rd.BotID.ValueString(), rd.ReplicaRegion.ValueString())
+	if err != nil {
+		diags.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotReplica, rd.ID.String(), err),
+			err.Error(),
+		)
+		return diags
+	}
+	diags.Append(flex.Flatten(ctx, versions, &rd.VersionReplicas)...)
+
+	return diags
+}
+
+type resourceBotReplicaData struct {
+	AliasReplicas    fwtypes.ListNestedObjectValueOf[botAliasReplicaSummaryData]   `tfsdk:"alias_replicas"`
+	BotID            types.String                                                  `tfsdk:"bot_id"`
+	BotReplicaStatus fwtypes.StringEnum[awstypes.BotReplicaStatus]                 `tfsdk:"bot_replica_status"`
+	CreationDateTime timetypes.RFC3339                                             `tfsdk:"creation_date_time"`
+	ID               types.String                                                  `tfsdk:"id"`
+	ReplicaRegion    types.String                                                  `tfsdk:"replica_region"`
+	SourceRegion     types.String                                                  `tfsdk:"source_region"`
+	Timeouts         timeouts.Value                                                `tfsdk:"timeouts"`
+	VersionReplicas  fwtypes.ListNestedObjectValueOf[botVersionReplicaSummaryData] `tfsdk:"version_replicas"`
+}
+
+type botAliasReplicaSummaryData struct {
+	BotAliasID                types.String                                           `tfsdk:"bot_alias_id"`
+	BotAliasReplicationStatus fwtypes.StringEnum[awstypes.BotAliasReplicationStatus] `tfsdk:"bot_alias_replication_status"`
+	BotVersion                types.String                                           `tfsdk:"bot_version"`
+	CreationDateTime          timetypes.RFC3339                                      `tfsdk:"creation_date_time"`
+}
+
+type botVersionReplicaSummaryData struct {
+	BotVersion                  types.String                                             `tfsdk:"bot_version"`
+	BotVersionReplicationStatus fwtypes.StringEnum[awstypes.BotVersionReplicationStatus] `tfsdk:"bot_version_replication_status"`
+	CreationDateTime            timetypes.RFC3339                                        `tfsdk:"creation_date_time"`
+}
@@ -27,6 +27,7 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -258,6 +259,8 @@ func waitBotVersionCreated(ctx context.Context, conn *lexmodelsv2.Client, id str
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotVersionOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+
 		return out, err
 	}
 
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Bots")
+func newDataSourceBots(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceBots{}, nil
+}
+
+const (
+	DSNameBots = "Bots Data Source"
+)
+
+type dataSourceBots struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceBots) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_lexv2models_bots"
+}
+
+func (d *dataSourceBots) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bots": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[botSummaryData](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[botSummaryData](ctx),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[botFilterData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.BotFilterName](),
+							Required:   true,
+						},
+						"operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.BotFilterOperator](),
+							Required:   true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType: fwtypes.ListOfStringType,
+							Required:   true,
+							Validators: []validator.List{
+								listvalidator.SizeBetween(1, 1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceBots) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().LexV2ModelsClient(ctx)
+
+	var data dataSourceBotsData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.ListBotsInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, data.Filters, &in.Filters)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bots, err := findBots(ctx, conn, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionReading, DSNameBots, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, bots, &data.BotSummaries, flex.WithFieldNamePrefix("Bot"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceBotsData struct {
+	BotSummaries fwtypes.ListNestedObjectValueOf[botSummaryData] `tfsdk:"bots"`
+	Filters      fwtypes.ListNestedObjectValueOf[botFilterData]  `tfsdk:"filter"`
+}
+
+type botFilterData struct {
+	Name     fwtypes.StringEnum[awstypes.BotFilterName]     `tfsdk:"name"`
+	Operator fwtypes.StringEnum[awstypes.BotFilterOperator] `tfsdk:"operator"`
+	Values   fwtypes.ListValueOf[types.String]              `tfsdk:"values"`
+}
+
+type botSummaryData struct {
+	Description         types.String                           `tfsdk:"description"`
+	ID                  types.String                           `tfsdk:"id"`
+	LastUpdatedDateTime timetypes.RFC3339                      `tfsdk:"last_updated_date_time"`
+	LatestBotVersion    types.String                           `tfsdk:"latest_bot_version"`
+	Name                types.String                           `tfsdk:"name"`
+	Status              fwtypes.StringEnum[awstypes.BotStatus] `tfsdk:"status"`
+	Type                fwtypes.StringEnum[awstypes.BotType]   `tfsdk:"type"`
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsBotsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lexv2models_bots.test"
+	resourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotsDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "bots.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bots.0.id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bots.0.name", resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccBotsDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotConfig_basic(rName, 60, true),
+		`
+data "aws_lexv2models_bots" "test" {
+  filter {
+    name     = "BotName"
+    operator = "EQ"
+    values   = [aws_lexv2models_bot.test.name]
+  }
+}
+`)
+}
@@ -0,0 +1,590 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Vocabulary")
+func newResourceCustomVocabulary(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCustomVocabulary{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameCustomVocabulary = "Custom Vocabulary"
+
+	customVocabularyIDPartCount = 3
+)
+
+type resourceCustomVocabulary struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceCustomVocabulary) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_custom_vocabulary"
+}
+
+func (r *resourceCustomVocabulary) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bot_version": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_vocabulary_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CustomVocabularyStatus](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"locale_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"item": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[customVocabularyItemData](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeBetween(1, 500),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"display_as": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+						"phrase": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 100),
+							},
+						},
+						"weight": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 3),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceCustomVocabulary) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceCustomVocabularyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := []string{
+		plan.BotID.ValueString(),
+		plan.BotVersion.ValueString(),
+		plan.LocaleID.ValueString(),
+	}
+	id, err := intflex.FlattenResourceId(idParts, customVocabularyIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameCustomVocabulary, plan.LocaleID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	items, diags := plan.Item.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.BatchCreateCustomVocabularyItemInput{
+		BotId:                    plan.BotID.ValueStringPointer(),
+		BotVersion:               plan.BotVersion.ValueStringPointer(),
+		CustomVocabularyItemList: expandNewCustomVocabularyItems(items),
+		LocaleId:                 plan.LocaleID.ValueStringPointer(),
+	}
+
+	out, err := conn.BatchCreateCustomVocabularyItem(ctx, in)
+	if err == nil {
+		err = customVocabularyItemErrors(out.Errors)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameCustomVocabulary, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	waitOut, err := waitCustomVocabularyReady(ctx, conn, id, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameCustomVocabulary, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.CustomVocabularyStatus = fwtypes.StringEnumValue(waitOut.CustomVocabularyStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceCustomVocabulary) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceCustomVocabularyData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findCustomVocabularyByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameCustomVocabulary, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	items, err := findCustomVocabularyItemsByID(ctx, conn, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameCustomVocabulary, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.BotID = flex.StringToFramework(ctx, out.BotId)
+	state.BotVersion = flex.StringToFramework(ctx, out.BotVersion)
+	state.CustomVocabularyStatus = fwtypes.StringEnumValue(out.CustomVocabularyStatus)
+	state.LocaleID = flex.StringToFramework(ctx, out.LocaleId)
+
+	var diags diag.Diagnostics
+	state.Item, diags = flattenCustomVocabularyItems(ctx, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceCustomVocabulary) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan, state resourceCustomVocabularyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Item.Equal(state.Item) {
+		planItems, diags := plan.Item.ToSlice(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Item IDs are not kept in state, so match the planned items to the existing ones by phrase.
+		existing, err := findCustomVocabularyItemsByID(ctx, conn, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameCustomVocabulary, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		existingByPhrase := make(map[string]awstypes.CustomVocabularyItem, len(existing))
+		for _, v := range existing {
+			existingByPhrase[aws.ToString(v.Phrase)] = v
+		}
+
+		var add []*customVocabularyItemData
+		var update []awstypes.CustomVocabularyItem
+		for _, v := range planItems {
+			phrase := v.Phrase.ValueString()
+			current, ok := existingByPhrase[phrase]
+			if !ok {
+				add = append(add, v)
+				continue
+			}
+			delete(existingByPhrase, phrase)
+
+			if aws.ToString(current.DisplayAs) != v.DisplayAs.ValueString() || aws.ToInt32(current.Weight) != int32(v.Weight.ValueInt64()) {
+				update = append(update, awstypes.CustomVocabularyItem{
+					DisplayAs: v.DisplayAs.ValueStringPointer(),
+					ItemId:    current.ItemId,
+					Phrase:    current.Phrase,
+					Weight:    expandCustomVocabularyItemWeight(v.Weight),
+				})
+			}
+		}
+
+		var remove []awstypes.CustomVocabularyEntryId
+		for _, v := range existingByPhrase {
+			remove = append(remove, awstypes.CustomVocabularyEntryId{ItemId: v.ItemId})
+		}
+
+		if len(remove) > 0 {
+			out, err := conn.BatchDeleteCustomVocabularyItem(ctx, &lexmodelsv2.BatchDeleteCustomVocabularyItemInput{
+				BotId:                    plan.BotID.ValueStringPointer(),
+				BotVersion:               plan.BotVersion.ValueStringPointer(),
+				CustomVocabularyItemList: remove,
+				LocaleId:                 plan.LocaleID.ValueStringPointer(),
+			})
+			if err == nil {
+				err = customVocabularyItemErrors(out.Errors)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameCustomVocabulary, plan.ID.String(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		if len(update) > 0 {
+			out, err := conn.BatchUpdateCustomVocabularyItem(ctx, &lexmodelsv2.BatchUpdateCustomVocabularyItemInput{
+				BotId:                    plan.BotID.ValueStringPointer(),
+				BotVersion:               plan.BotVersion.ValueStringPointer(),
+				CustomVocabularyItemList: update,
+				LocaleId:                 plan.LocaleID.ValueStringPointer(),
+			})
+			if err == nil {
+				err = customVocabularyItemErrors(out.Errors)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameCustomVocabulary, plan.ID.String(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		if len(add) > 0 {
+			out, err := conn.BatchCreateCustomVocabularyItem(ctx, &lexmodelsv2.BatchCreateCustomVocabularyItemInput{
+				BotId:                    plan.BotID.ValueStringPointer(),
+				BotVersion:               plan.BotVersion.ValueStringPointer(),
+				CustomVocabularyItemList: expandNewCustomVocabularyItems(add),
+				LocaleId:                 plan.LocaleID.ValueStringPointer(),
+			})
+			if err == nil {
+				err = customVocabularyItemErrors(out.Errors)
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameCustomVocabulary, plan.ID.String(), err),
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		waitOut, err := waitCustomVocabularyReady(ctx, conn, plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameCustomVocabulary, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		plan.CustomVocabularyStatus = fwtypes.StringEnumValue(waitOut.CustomVocabularyStatus)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceCustomVocabulary) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceCustomVocabularyData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.DeleteCustomVocabularyInput{
+		BotId:      state.BotID.ValueStringPointer(),
+		BotVersion: state.BotVersion.ValueStringPointer(),
+		LocaleId:   state.LocaleID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteCustomVocabulary(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameCustomVocabulary, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitCustomVocabularyDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameCustomVocabulary, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func waitCustomVocabularyReady(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.CustomVocabularyStatusCreating, awstypes.CustomVocabularyStatusImporting),
+		Target:                    enum.Slice(awstypes.CustomVocabularyStatusReady),
+		Refresh:                   statusCustomVocabulary(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeCustomVocabularyMetadataOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitCustomVocabularyDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CustomVocabularyStatusDeleting),
+		Target:  []string{},
+		Refresh: statusCustomVocabulary(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeCustomVocabularyMetadataOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusCustomVocabulary(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findCustomVocabularyByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.CustomVocabularyStatus), nil
+	}
+}
+
+func findCustomVocabularyByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeCustomVocabularyMetadataOutput, error) {
+	parts, err := intflex.ExpandResourceId(id, customVocabularyIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &lexmodelsv2.DescribeCustomVocabularyMetadataInput{
+		BotId:      aws.String(parts[0]),
+		BotVersion: aws.String(parts[1]),
+		LocaleId:   aws.String(parts[2]),
+	}
+
+	out, err := conn.DescribeCustomVocabularyMetadata(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func findCustomVocabularyItemsByID(ctx context.Context, conn *lexmodelsv2.Client, id string) ([]awstypes.CustomVocabularyItem, error) {
+	parts, err := intflex.ExpandResourceId(id, customVocabularyIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &lexmodelsv2.ListCustomVocabularyItemsInput{
+		BotId:      aws.String(parts[0]),
+		BotVersion: aws.String(parts[1]),
+		LocaleId:   aws.String(parts[2]),
+	}
+
+	var items []awstypes.CustomVocabularyItem
+	pages := lexmodelsv2.NewListCustomVocabularyItemsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return nil, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: in,
+				}
+			}
+
+			return nil, err
+		}
+
+		items = append(items, page.CustomVocabularyItems...)
+	}
+
+	return items, nil
+}
+
+func customVocabularyItemErrors(apiObjects []awstypes.FailedCustomVocabularyItem) error {
+	var errList []error
+
+	for _, v := range apiObjects {
+		errList = append(errList, fmt.Errorf("%s: %s: %s", aws.ToString(v.ItemId), v.ErrorCode, aws.ToString(v.ErrorMessage)))
+	}
+
+	return errors.Join(errList...)
+}
+
+func expandNewCustomVocabularyItems(tfList []*customVocabularyItemData) []awstypes.NewCustomVocabularyItem {
+	var apiObjects []awstypes.NewCustomVocabularyItem
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, awstypes.NewCustomVocabularyItem{
+			DisplayAs: v.DisplayAs.ValueStringPointer(),
+			Phrase:    v.Phrase.ValueStringPointer(),
+			Weight:    expandCustomVocabularyItemWeight(v.Weight),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandCustomVocabularyItemWeight(v types.Int64) *int32 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	return aws.Int32(int32(v.ValueInt64()))
+}
+
+func flattenCustomVocabularyItems(ctx context.Context, apiObjects []awstypes.CustomVocabularyItem) (fwtypes.SetNestedObjectValueOf[customVocabularyItemData], diag.Diagnostics) {
+	tfList := make([]*customVocabularyItemData, 0, len(apiObjects))
+
+	for _, v := range apiObjects {
+		item := &customVocabularyItemData{
+			DisplayAs: flex.StringToFramework(ctx, v.DisplayAs),
+			Phrase:    flex.StringToFramework(ctx, v.Phrase),
+			Weight:    types.Int64Null(),
+		}
+		if v.Weight != nil {
+			item.Weight = types.Int64Value(int64(aws.ToInt32(v.Weight)))
+		}
+
+		tfList = append(tfList, item)
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfSlice(ctx, tfList)
+}
+
+type resourceCustomVocabularyData struct {
+	BotID                  types.String                                             `tfsdk:"bot_id"`
+	BotVersion             types.String                                             `tfsdk:"bot_version"`
+	CustomVocabularyStatus fwtypes.StringEnum[awstypes.CustomVocabularyStatus]      `tfsdk:"custom_vocabulary_status"`
+	ID                     types.String                                             `tfsdk:"id"`
+	Item                   fwtypes.SetNestedObjectValueOf[customVocabularyItemData] `tfsdk:"item"`
+	LocaleID               types.String                                             `tfsdk:"locale_id"`
+	Timeouts               timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+type customVocabularyItemData struct {
+	DisplayAs types.String `tfsdk:"display_as"`
+	Phrase    types.String `tfsdk:"phrase"`
+	Weight    types.Int64  `tfsdk:"weight"`
+}
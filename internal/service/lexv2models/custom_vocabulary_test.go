@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsCustomVocabulary_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var customVocabulary lexmodelsv2.DescribeCustomVocabularyMetadataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_custom_vocabulary.test"
+	botLocaleResourceName := "aws_lexv2models_bot_locale.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomVocabularyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &customVocabulary),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", botLocaleResourceName, "bot_id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "custom_vocabulary_status", string(types.CustomVocabularyStatusReady)),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "item.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "item.*", map[string]string{
+						"phrase": "Terraform",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsCustomVocabulary_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var customVocabulary lexmodelsv2.DescribeCustomVocabularyMetadataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_custom_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomVocabularyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &customVocabulary),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceCustomVocabulary, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsCustomVocabulary_items(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var customVocabulary lexmodelsv2.DescribeCustomVocabularyMetadataOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_custom_vocabulary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomVocabularyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomVocabularyConfig_items(rName, "Terraform", "1", "Bucket", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &customVocabulary),
+					resource.TestCheckResourceAttr(resourceName, "item.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "item.*", map[string]string{
+						"phrase": "Terraform",
+						"weight": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "item.*", map[string]string{
+						"phrase": "Bucket",
+						"weight": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccCustomVocabularyConfig_items(rName, "Terraform", "3", "Instance", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomVocabularyExists(ctx, resourceName, &customVocabulary),
+					resource.TestCheckResourceAttr(resourceName, "item.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "item.*", map[string]string{
+						"phrase": "Terraform",
+						"weight": "3",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "item.*", map[string]string{
+						"phrase": "Instance",
+						"weight": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomVocabularyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_custom_vocabulary" {
+				continue
+			}
+
+			_, err := tflexv2models.FindCustomVocabularyByID(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.LexV2Models, create.ErrActionCheckingDestroyed, tflexv2models.ResNameCustomVocabulary, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomVocabularyExists(ctx context.Context, name string, customVocabulary *lexmodelsv2.DescribeCustomVocabularyMetadataOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameCustomVocabulary, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameCustomVocabulary, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		resp, err := tflexv2models.FindCustomVocabularyByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameCustomVocabulary, rs.Primary.ID, err)
+		}
+
+		*customVocabulary = *resp
+
+		return nil
+	}
+}
+
+func testAccCustomVocabularyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfig_basic(rName, "en_US", 0.7),
+		`
+resource "aws_lexv2models_custom_vocabulary" "test" {
+  bot_id      = aws_lexv2models_bot_locale.test.bot_id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  item {
+    phrase = "Terraform"
+  }
+}
+`)
+}
+
+func testAccCustomVocabularyConfig_items(rName, phrase1, weight1, phrase2, weight2 string) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfig_basic(rName, "en_US", 0.7),
+		fmt.Sprintf(`
+resource "aws_lexv2models_custom_vocabulary" "test" {
+  bot_id      = aws_lexv2models_bot_locale.test.bot_id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  item {
+    phrase = %[1]q
+    weight = %[2]s
+  }
+
+  item {
+    phrase = %[3]q
+    weight = %[4]s
+  }
+}
+`, phrase1, weight1, phrase2, weight2))
+}
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Export")
+func newResourceExport(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceExport{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameExport = "Export"
+)
+
+type resourceExport struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *resourceExport) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_export"
+}
+
+func (r *resourceExport) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	exportSpecificationNBO := schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+			},
+			"bot_version": schema.StringAttribute{
+				Required: true,
+			},
+			"locale_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"download_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"export_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ExportStatus](),
+				Computed:   true,
+			},
+			"file_format": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ImportExportFileFormat](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"resource_specification": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[exportResourceSpecificationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"bot_export_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[botExportSpecificationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("bot_export_specification"),
+									path.MatchRelative().AtParent().AtName("bot_locale_export_specification"),
+									path.MatchRelative().AtParent().AtName("custom_vocabulary_export_specification"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bot_id": schema.StringAttribute{
+										Required: true,
+									},
+									"bot_version": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"bot_locale_export_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[localeExportSpecificationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: exportSpecificationNBO,
+						},
+						"custom_vocabulary_export_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[localeExportSpecificationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: exportSpecificationNBO,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceExport) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceExportData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.CreateExportInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.CreateExport(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameExport, "", err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.ExportId == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameExport, "", nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.ExportId)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	waitOut, err := waitExportCompleted(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameExport, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.DownloadURL = flex.StringToFramework(ctx, waitOut.DownloadUrl)
+	plan.ExportStatus = fwtypes.StringEnumValue(waitOut.ExportStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceExport) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceExportData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findExportByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameExport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceExport) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceExportData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.DeleteExportInput{
+		ExportId: state.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteExport(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameExport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitExportDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameExport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func waitExportCompleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeExportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ExportStatusInProgress),
+		Target:                    enum.Slice(awstypes.ExportStatusCompleted),
+		Refresh:                   statusExport(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeExportOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitExportDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeExportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ExportStatusDeleting),
+		Target:  []string{},
+		Refresh: statusExport(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeExportOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusExport(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findExportByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.ExportStatus), nil
+	}
+}
+
+func findExportByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeExportOutput, error) {
+	in := &lexmodelsv2.DescribeExportInput{
+		ExportId: aws.String(id),
+	}
+
+	out, err := conn.DescribeExport(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.ExportId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceExportData struct {
+	DownloadURL           types.String                                                     `tfsdk:"download_url"`
+	ExportStatus          fwtypes.StringEnum[awstypes.ExportStatus]                        `tfsdk:"export_status"`
+	FileFormat            fwtypes.StringEnum[awstypes.ImportExportFileFormat]              `tfsdk:"file_format"`
+	FilePassword          types.String                                                     `tfsdk:"file_password"`
+	ID                    types.String                                                     `tfsdk:"id"`
+	ResourceSpecification fwtypes.ListNestedObjectValueOf[exportResourceSpecificationData] `tfsdk:"resource_specification"`
+	Timeouts              timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+type exportResourceSpecificationData struct {
+	BotExportSpecification              fwtypes.ListNestedObjectValueOf[botExportSpecificationData]    `tfsdk:"bot_export_specification"`
+	BotLocaleExportSpecification        fwtypes.ListNestedObjectValueOf[localeExportSpecificationData] `tfsdk:"bot_locale_export_specification"`
+	CustomVocabularyExportSpecification fwtypes.ListNestedObjectValueOf[localeExportSpecificationData] `tfsdk:"custom_vocabulary_export_specification"`
+}
+
+type botExportSpecificationData struct {
+	BotID      types.String `tfsdk:"bot_id"`
+	BotVersion types.String `tfsdk:"bot_version"`
+}
+
+type localeExportSpecificationData struct {
+	BotID      types.String `tfsdk:"bot_id"`
+	BotVersion types.String `tfsdk:"bot_version"`
+	LocaleID   types.String `tfsdk:"locale_id"`
+}
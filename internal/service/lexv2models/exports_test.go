@@ -5,14 +5,26 @@ package lexv2models
 
 // Exports for use in tests only.
 var (
-	ResourceBot        = newResourceBot
-	ResourceBotLocale  = newResourceBotLocale
-	ResourceBotVersion = newResourceBotVersion
-	ResourceIntent     = newResourceIntent
-	ResourceSlot       = newResourceSlot
-	ResourceSlotType   = newResourceSlotType
+	ResourceBot              = newResourceBot
+	ResourceBotLocale        = newResourceBotLocale
+	ResourceBotReplica       = newResourceBotReplica
+	ResourceBotVersion       = newResourceBotVersion
+	ResourceCustomVocabulary = newResourceCustomVocabulary
+	ResourceExport           = newResourceExport
+	ResourceImport           = newResourceImport
+	ResourceIntent           = newResourceIntent
+	ResourceSlot             = newResourceSlot
+	ResourceSlotType         = newResourceSlotType
+	ResourceTestExecution    = newResourceTestExecution
+	ResourceTestSet          = newResourceTestSet
 
-	FindSlotByID = findSlotByID
+	FindBotReplicaByID       = findBotReplicaByID
+	FindCustomVocabularyByID = findCustomVocabularyByID
+	FindExportByID           = findExportByID
+	FindImportByID           = findImportByID
+	FindSlotByID             = findSlotByID
+	FindTestExecutionByID    = findTestExecutionByID
+	FindTestSetByID          = findTestSetByID
 
 	IntentFlexOpt = intentFlexOpt
 )
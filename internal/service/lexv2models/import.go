@@ -0,0 +1,528 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Import")
+func newResourceImport(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceImport{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameImport = "Import"
+)
+
+type resourceImport struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *resourceImport) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_import"
+}
+
+func (r *resourceImport) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"file_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"import_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ImportStatus](),
+				Computed:   true,
+			},
+			"imported_resource_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"imported_resource_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"merge_strategy": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MergeStrategy](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_specification": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[importResourceSpecificationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"bot_import_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[botImportSpecificationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("bot_import_specification"),
+									path.MatchRelative().AtParent().AtName("bot_locale_import_specification"),
+									path.MatchRelative().AtParent().AtName("custom_vocabulary_import_specification"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bot_name": schema.StringAttribute{
+										Required: true,
+									},
+									"bot_tags": schema.MapAttribute{
+										CustomType:  fwtypes.NewMapTypeOf[types.String](ctx),
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"idle_session_ttl_in_seconds": schema.Int64Attribute{
+										Optional: true,
+									},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"test_bot_alias_tags": schema.MapAttribute{
+										CustomType:  fwtypes.NewMapTypeOf[types.String](ctx),
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"data_privacy": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dataPrivacyData](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"child_directed": schema.BoolAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"bot_locale_import_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[botLocaleImportSpecificationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bot_id": schema.StringAttribute{
+										Required: true,
+									},
+									"bot_version": schema.StringAttribute{
+										Required: true,
+									},
+									"locale_id": schema.StringAttribute{
+										Required: true,
+									},
+									"nlu_intent_confidence_threshold": schema.Float64Attribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"voice_settings": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[importVoiceSettingsData](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrEngine: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.VoiceEngine](),
+													Optional:   true,
+												},
+												"voice_id": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"custom_vocabulary_import_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[localeExportSpecificationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bot_id": schema.StringAttribute{
+										Required: true,
+									},
+									"bot_version": schema.StringAttribute{
+										Required: true,
+									},
+									"locale_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceImport) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceImportData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filename := plan.Filename.ValueString()
+	body, err := os.ReadFile(filename)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameImport, filename, err),
+			err.Error(),
+		)
+		return
+	}
+
+	uploadOut, err := conn.CreateUploadUrl(ctx, &lexmodelsv2.CreateUploadUrlInput{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameImport, filename, err),
+			err.Error(),
+		)
+		return
+	}
+	if uploadOut == nil || uploadOut.ImportId == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameImport, filename, nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	if err := uploadImportFile(ctx, aws.ToString(uploadOut.UploadUrl), body); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameImport, filename, err),
+			err.Error(),
+		)
+		return
+	}
+
+	in := &lexmodelsv2.StartImportInput{
+		ImportId: uploadOut.ImportId,
+	}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.StartImport(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameImport, filename, err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.ImportId == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameImport, filename, nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.ImportId)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	waitOut, err := waitImportCompleted(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameImport, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.refreshFromOutput(ctx, waitOut)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceImport) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceImportData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findImportByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameImport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.refreshFromOutput(ctx, out)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceImport) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceImportData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting an import removes the import record only, not the imported resource.
+	in := &lexmodelsv2.DeleteImportInput{
+		ImportId: state.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteImport(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameImport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitImportDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameImport, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func uploadImportFile(ctx context.Context, url string, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("uploading import file: %s", response.Status)
+	}
+
+	return nil
+}
+
+func waitImportCompleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeImportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ImportStatusInProgress),
+		Target:                    enum.Slice(awstypes.ImportStatusCompleted),
+		Refresh:                   statusImport(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeImportOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitImportDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeImportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ImportStatusDeleting),
+		Target:  []string{},
+		Refresh: statusImport(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeImportOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusImport(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findImportByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.ImportStatus), nil
+	}
+}
+
+func findImportByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeImportOutput, error) {
+	in := &lexmodelsv2.DescribeImportInput{
+		ImportId: aws.String(id),
+	}
+
+	out, err := conn.DescribeImport(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.ImportId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceImportData struct {
+	FilePassword          types.String                                                     `tfsdk:"file_password"`
+	Filename              types.String                                                     `tfsdk:"filename"`
+	ID                    types.String                                                     `tfsdk:"id"`
+	ImportStatus          fwtypes.StringEnum[awstypes.ImportStatus]                        `tfsdk:"import_status"`
+	ImportedResourceID    types.String                                                     `tfsdk:"imported_resource_id"`
+	ImportedResourceName  types.String                                                     `tfsdk:"imported_resource_name"`
+	MergeStrategy         fwtypes.StringEnum[awstypes.MergeStrategy]                       `tfsdk:"merge_strategy"`
+	ResourceSpecification fwtypes.ListNestedObjectValueOf[importResourceSpecificationData] `tfsdk:"resource_specification"`
+	Timeouts              timeouts.Value                                                   `tfsdk:"timeouts"`
+}
+
+// refreshFromOutput sets only the attributes reported by DescribeImport so that the
+// configured resource specification is left as-is.
+func (rd *resourceImportData) refreshFromOutput(ctx context.Context, out *lexmodelsv2.DescribeImportOutput) {
+	if out == nil {
+		return
+	}
+
+	rd.ImportStatus = fwtypes.StringEnumValue(out.ImportStatus)
+	rd.ImportedResourceID = flex.StringToFramework(ctx, out.ImportedResourceId)
+	rd.ImportedResourceName = flex.StringToFramework(ctx, out.ImportedResourceName)
+	rd.MergeStrategy = fwtypes.StringEnumValue(out.MergeStrategy)
+}
+
+type importResourceSpecificationData struct {
+	BotImportSpecification              fwtypes.ListNestedObjectValueOf[botImportSpecificationData]       `tfsdk:"bot_import_specification"`
+	BotLocaleImportSpecification        fwtypes.ListNestedObjectValueOf[botLocaleImportSpecificationData] `tfsdk:"bot_locale_import_specification"`
+	CustomVocabularyImportSpecification fwtypes.ListNestedObjectValueOf[localeExportSpecificationData]    `tfsdk:"custom_vocabulary_import_specification"`
+}
+
+type botImportSpecificationData struct {
+	BotName                 types.String                                     `tfsdk:"bot_name"`
+	BotTags                 fwtypes.MapValueOf[types.String]                 `tfsdk:"bot_tags"`
+	DataPrivacy             fwtypes.ListNestedObjectValueOf[dataPrivacyData] `tfsdk:"data_privacy"`
+	IdleSessionTTLInSeconds types.Int64                                      `tfsdk:"idle_session_ttl_in_seconds"`
+	RoleARN                 fwtypes.ARN                                      `tfsdk:"role_arn"`
+	TestBotAliasTags        fwtypes.MapValueOf[types.String]                 `tfsdk:"test_bot_alias_tags"`
+}
+
+type botLocaleImportSpecificationData struct {
+	BotID                        types.String                                             `tfsdk:"bot_id"`
+	BotVersion                   types.String                                             `tfsdk:"bot_version"`
+	LocaleID                     types.String                                             `tfsdk:"locale_id"`
+	NluIntentConfidenceThreshold types.Float64                                            `tfsdk:"nlu_intent_confidence_threshold"`
+	VoiceSettings                fwtypes.ListNestedObjectValueOf[importVoiceSettingsData] `tfsdk:"voice_settings"`
+}
+
+type importVoiceSettingsData struct {
+	Engine  fwtypes.StringEnum[awstypes.VoiceEngine] `tfsdk:"engine"`
+	VoiceID types.String                             `tfsdk:"voice_id"`
+}
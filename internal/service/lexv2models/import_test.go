@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsImport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var lexImport lexmodelsv2.DescribeImportOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_import.test"
+	botResourceName := "aws_lexv2models_bot.test"
+	filename := filepath.Join(t.TempDir(), "export.zip")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImportDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportDownloadToFile(ctx, "aws_lexv2models_export.test", filename),
+				),
+			},
+			{
+				Config: testAccImportConfig_basic(rName, filename),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImportExists(ctx, resourceName, &lexImport),
+					resource.TestCheckResourceAttr(resourceName, "import_status", string(types.ImportStatusCompleted)),
+					resource.TestCheckResourceAttrPair(resourceName, "imported_resource_id", botResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "merge_strategy", string(types.MergeStrategyOverwrite)),
+					resource.TestCheckResourceAttr(resourceName, "resource_specification.0.bot_locale_import_specification.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "resource_specification"},
+			},
+		},
+	})
+}
+
+func testAccCheckImportDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_import" {
+				continue
+			}
+
+			_, err := tflexv2models.FindImportByID(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.LexV2Models, create.ErrActionCheckingDestroyed, tflexv2models.ResNameImport, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckImportExists(ctx context.Context, name string, lexImport *lexmodelsv2.DescribeImportOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameImport, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameImport, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		resp, err := tflexv2models.FindImportByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameImport, rs.Primary.ID, err)
+		}
+
+		*lexImport = *resp
+
+		return nil
+	}
+}
+
+// testAccCheckExportDownloadToFile downloads the exported artifact to the specified local file.
+func testAccCheckExportDownloadToFile(ctx context.Context, name, filename string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		output, err := tflexv2models.FindExportByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, aws.ToString(output.DownloadUrl), nil)
+		if err != nil {
+			return err
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("downloading Lex V2 Models Export (%s): %s", rs.Primary.ID, response.Status)
+		}
+
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		return os.WriteFile(filename, body, 0600)
+	}
+}
+
+func testAccImportConfig_basic(rName, filename string) string {
+	return acctest.ConfigCompose(
+		testAccExportConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_lexv2models_import" "test" {
+  filename       = %[1]q
+  merge_strategy = "Overwrite"
+
+  resource_specification {
+    bot_locale_import_specification {
+      bot_id      = aws_lexv2models_bot_locale.test.bot_id
+      bot_version = aws_lexv2models_bot_locale.test.bot_version
+      locale_id   = aws_lexv2models_bot_locale.test.locale_id
+    }
+  }
+}
+`, filename))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -127,6 +128,140 @@ func (r *resourceIntent) Schema(ctx context.Context, req resource.SchemaRequest,
 		},
 	}
 
+	qnAIntentConfigurationLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[QnAIntentConfiguration](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"bedrock_model_configuration": schema.ListNestedBlock{
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					CustomType: fwtypes.NewListNestedObjectTypeOf[BedrockModelSpecification](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"model_arn": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				"data_source_configuration": schema.ListNestedBlock{
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					CustomType: fwtypes.NewListNestedObjectTypeOf[DataSourceConfiguration](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"bedrock_knowledge_store_configuration": schema.ListNestedBlock{
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ExactlyOneOf(
+										path.MatchRelative().AtParent().AtName("kendra_configuration"),
+										path.MatchRelative().AtParent().AtName("opensearch_configuration"),
+									),
+								},
+								CustomType: fwtypes.NewListNestedObjectTypeOf[BedrockKnowledgeStoreConfiguration](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"bedrock_knowledge_base_arn": schema.StringAttribute{
+											Required: true,
+										},
+										"exact_response": schema.BoolAttribute{
+											Optional: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"exact_response_fields": schema.ListNestedBlock{
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											CustomType: fwtypes.NewListNestedObjectTypeOf[BedrockKnowledgeStoreExactResponseFields](ctx),
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"answer_field": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"kendra_configuration": schema.ListNestedBlock{
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								CustomType: fwtypes.NewListNestedObjectTypeOf[QnAKendraConfiguration](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"exact_response": schema.BoolAttribute{
+											Optional: true,
+										},
+										"kendra_index": schema.StringAttribute{
+											Required: true,
+										},
+										"query_filter_string": schema.StringAttribute{
+											Optional: true,
+										},
+										"query_filter_string_enabled": schema.BoolAttribute{
+											Optional: true,
+										},
+									},
+								},
+							},
+							"opensearch_configuration": schema.ListNestedBlock{
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								CustomType: fwtypes.NewListNestedObjectTypeOf[OpensearchConfiguration](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"domain_endpoint": schema.StringAttribute{
+											Required: true,
+										},
+										"exact_response": schema.BoolAttribute{
+											Optional: true,
+										},
+										"include_fields": schema.ListAttribute{
+											CustomType: fwtypes.ListOfStringType,
+											Optional:   true,
+										},
+										"index_name": schema.StringAttribute{
+											Required: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"exact_response_fields": schema.ListNestedBlock{
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											CustomType: fwtypes.NewListNestedObjectTypeOf[ExactResponseFields](ctx),
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"answer_field": schema.StringAttribute{
+														Required: true,
+													},
+													"question_field": schema.StringAttribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
 	customPayloadLNB := schema.ListNestedBlock{
 		Validators: []validator.List{
 			listvalidator.SizeAtMost(1),
@@ -880,16 +1015,17 @@ func (r *resourceIntent) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"dialog_code_hook":         dialogCodeHookLNB,
-			"fulfillment_code_hook":    fulfillmentCodeHookLNB,
-			"initial_response_setting": initialResponseSettingLNB,
-			"input_context":            inputContextLNB,
-			"closing_setting":          closingSettingLNB,
-			"confirmation_setting":     confirmationSettingLNB,
-			"kendra_configuration":     kendraConfigurationLNB,
-			"output_context":           outputContextLNB,
-			"sample_utterance":         sampleUtteranceLNB,
-			"slot_priority":            slotPriorityLNB,
+			"dialog_code_hook":          dialogCodeHookLNB,
+			"fulfillment_code_hook":     fulfillmentCodeHookLNB,
+			"initial_response_setting":  initialResponseSettingLNB,
+			"input_context":             inputContextLNB,
+			"closing_setting":           closingSettingLNB,
+			"confirmation_setting":      confirmationSettingLNB,
+			"kendra_configuration":      kendraConfigurationLNB,
+			"output_context":            outputContextLNB,
+			"qn_a_intent_configuration": qnAIntentConfigurationLNB,
+			"sample_utterance":          sampleUtteranceLNB,
+			"slot_priority":             slotPriorityLNB,
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -1043,6 +1179,9 @@ func (r *resourceIntent) Update(ctx context.Context, req resource.UpdateRequest,
 	if !new.ParentIntentSignature.Equal(old.ParentIntentSignature) {
 		change = true
 	}
+	if !new.QnAIntentConfiguration.Equal(old.QnAIntentConfiguration) {
+		change = true
+	}
 	if !new.SampleUtterance.Equal(old.SampleUtterance) {
 		change = true
 	}
@@ -1275,6 +1414,51 @@ type KendraConfiguration struct {
 	QueryFilterStringEnabled types.Bool   `tfsdk:"query_filter_string_enabled"`
 }
 
+type QnAIntentConfiguration struct {
+	BedrockModelConfiguration fwtypes.ListNestedObjectValueOf[BedrockModelSpecification] `tfsdk:"bedrock_model_configuration"`
+	DataSourceConfiguration   fwtypes.ListNestedObjectValueOf[DataSourceConfiguration]   `tfsdk:"data_source_configuration"`
+}
+
+type BedrockModelSpecification struct {
+	ModelARN types.String `tfsdk:"model_arn"`
+}
+
+type DataSourceConfiguration struct {
+	BedrockKnowledgeStoreConfiguration fwtypes.ListNestedObjectValueOf[BedrockKnowledgeStoreConfiguration] `tfsdk:"bedrock_knowledge_store_configuration"`
+	KendraConfiguration                fwtypes.ListNestedObjectValueOf[QnAKendraConfiguration]             `tfsdk:"kendra_configuration"`
+	OpensearchConfiguration            fwtypes.ListNestedObjectValueOf[OpensearchConfiguration]            `tfsdk:"opensearch_configuration"`
+}
+
+type BedrockKnowledgeStoreConfiguration struct {
+	BedrockKnowledgeBaseARN types.String                                                              `tfsdk:"bedrock_knowledge_base_arn"`
+	ExactResponse           types.Bool                                                                `tfsdk:"exact_response"`
+	ExactResponseFields     fwtypes.ListNestedObjectValueOf[BedrockKnowledgeStoreExactResponseFields] `tfsdk:"exact_response_fields"`
+}
+
+type BedrockKnowledgeStoreExactResponseFields struct {
+	AnswerField types.String `tfsdk:"answer_field"`
+}
+
+type QnAKendraConfiguration struct {
+	ExactResponse            types.Bool   `tfsdk:"exact_response"`
+	KendraIndex              types.String `tfsdk:"kendra_index"`
+	QueryFilterString        types.String `tfsdk:"query_filter_string"`
+	QueryFilterStringEnabled types.Bool   `tfsdk:"query_filter_string_enabled"`
+}
+
+type OpensearchConfiguration struct {
+	DomainEndpoint      types.String                                         `tfsdk:"domain_endpoint"`
+	ExactResponse       types.Bool                                           `tfsdk:"exact_response"`
+	ExactResponseFields fwtypes.ListNestedObjectValueOf[ExactResponseFields] `tfsdk:"exact_response_fields"`
+	IncludeFields       fwtypes.ListValueOf[types.String]                    `tfsdk:"include_fields"`
+	IndexName           types.String                                         `tfsdk:"index_name"`
+}
+
+type ExactResponseFields struct {
+	AnswerField   types.String `tfsdk:"answer_field"`
+	QuestionField types.String `tfsdk:"question_field"`
+}
+
 type CustomPayload struct {
 	Value types.String `tfsdk:"value"`
 }
@@ -1516,6 +1700,7 @@ type ResourceIntentData struct {
 	Name                   types.String                                                 `tfsdk:"name"`
 	OutputContext          fwtypes.ListNestedObjectValueOf[OutputContext]               `tfsdk:"output_context"`
 	ParentIntentSignature  types.String                                                 `tfsdk:"parent_intent_signature"`
+	QnAIntentConfiguration fwtypes.ListNestedObjectValueOf[QnAIntentConfiguration]      `tfsdk:"qn_a_intent_configuration"`
 	SampleUtterance        fwtypes.ListNestedObjectValueOf[SampleUtterance]             `tfsdk:"sample_utterance"`
 	SlotPriority           fwtypes.ListNestedObjectValueOf[SlotPriority]                `tfsdk:"slot_priority"`
 	Timeouts               timeouts.Value                                               `tfsdk:"timeouts"`
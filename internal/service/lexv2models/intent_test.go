@@ -526,6 +526,37 @@ func TestIntentAutoFlex(t *testing.T) {
 		QueryFilterStringEnabled: true,
 	}
 
+	qnAIntentConfigurationTF := tflexv2models.QnAIntentConfiguration{
+		BedrockModelConfiguration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tflexv2models.BedrockModelSpecification{
+			ModelARN: types.StringValue(testString),
+		}),
+		DataSourceConfiguration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tflexv2models.DataSourceConfiguration{
+			BedrockKnowledgeStoreConfiguration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tflexv2models.BedrockKnowledgeStoreConfiguration{
+				BedrockKnowledgeBaseARN: types.StringValue(testString),
+				ExactResponse:           types.BoolValue(true),
+				ExactResponseFields: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tflexv2models.BedrockKnowledgeStoreExactResponseFields{
+					AnswerField: types.StringValue(testString),
+				}),
+			}),
+			KendraConfiguration:     fwtypes.NewListNestedObjectValueOfNull[tflexv2models.QnAKendraConfiguration](ctx),
+			OpensearchConfiguration: fwtypes.NewListNestedObjectValueOfNull[tflexv2models.OpensearchConfiguration](ctx),
+		}),
+	}
+	qnAIntentConfigurationAWS := lextypes.QnAIntentConfiguration{
+		BedrockModelConfiguration: &lextypes.BedrockModelSpecification{
+			ModelArn: aws.String(testString),
+		},
+		DataSourceConfiguration: &lextypes.DataSourceConfiguration{
+			BedrockKnowledgeStoreConfiguration: &lextypes.BedrockKnowledgeStoreConfiguration{
+				BedrockKnowledgeBaseArn: aws.String(testString),
+				ExactResponse:           true,
+				ExactResponseFields: &lextypes.BedrockKnowledgeStoreExactResponseFields{
+					AnswerField: aws.String(testString),
+				},
+			},
+		},
+	}
+
 	outputContextTF := tflexv2models.OutputContext{
 		Name:                types.StringValue(testString),
 		TimeToLiveInSeconds: types.Int64Value(1),
@@ -770,6 +801,13 @@ func TestIntentAutoFlex(t *testing.T) {
 			AWSFull:  &slotValueAWS,
 			AWSEmpty: &lextypes.SlotValue{},
 		},
+		{
+			TestName: "qnAIntentConfiguration",
+			TFFull:   &qnAIntentConfigurationTF,
+			TFEmpty:  &tflexv2models.QnAIntentConfiguration{},
+			AWSFull:  &qnAIntentConfigurationAWS,
+			AWSEmpty: &lextypes.QnAIntentConfiguration{},
+		},
 		{
 			TestName: "create intent",
 			TFFull:   &intentCreateTF,
@@ -800,11 +838,15 @@ func TestIntentAutoFlex(t *testing.T) {
 		lextypes.AllowedInputTypes{},
 		lextypes.AudioAndDTMFInputSpecification{},
 		lextypes.AudioSpecification{},
+		lextypes.BedrockKnowledgeStoreConfiguration{},
+		lextypes.BedrockKnowledgeStoreExactResponseFields{},
+		lextypes.BedrockModelSpecification{},
 		lextypes.Button{},
 		lextypes.Condition{},
 		lextypes.ConditionalBranch{},
 		lextypes.ConditionalSpecification{},
 		lextypes.CustomPayload{},
+		lextypes.DataSourceConfiguration{},
 		lextypes.DefaultConditionalBranch{},
 		lextypes.DialogAction{},
 		lextypes.DialogCodeHookInvocationSetting{},
@@ -831,6 +873,7 @@ func TestIntentAutoFlex(t *testing.T) {
 		lextypes.PostFulfillmentStatusSpecification{},
 		lextypes.PromptAttemptSpecification{},
 		lextypes.PromptSpecification{},
+		lextypes.QnAIntentConfiguration{},
 		lextypes.ResponseSpecification{},
 		lextypes.SampleUtterance{},
 		lextypes.SlotPriority{},
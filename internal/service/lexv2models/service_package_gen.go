@@ -15,7 +15,16 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceBot,
+			Name:    "Bot",
+		},
+		{
+			Factory: newDataSourceBots,
+			Name:    "Bots",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -31,10 +40,26 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceBotLocale,
 			Name:    "Bot Locale",
 		},
+		{
+			Factory: newResourceBotReplica,
+			Name:    "Bot Replica",
+		},
 		{
 			Factory: newResourceBotVersion,
 			Name:    "Bot Version",
 		},
+		{
+			Factory: newResourceCustomVocabulary,
+			Name:    "Custom Vocabulary",
+		},
+		{
+			Factory: newResourceExport,
+			Name:    "Export",
+		},
+		{
+			Factory: newResourceImport,
+			Name:    "Import",
+		},
 		{
 			Factory: newResourceIntent,
 			Name:    "Intent",
@@ -47,6 +72,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceSlotType,
 			Name:    "Slot Type",
 		},
+		{
+			Factory: newResourceTestExecution,
+			Name:    "Test Execution",
+		},
+		{
+			Factory: newResourceTestSet,
+			Name:    "Test Set",
+		},
 	}
 }
 
@@ -495,6 +495,250 @@ func (r *resourceSlot) Schema(ctx context.Context, req resource.SchemaRequest, r
 		},
 	}
 
+	slotValueLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[SlotValue](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"interpreted_value": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+
+	slotValueOverrideLNB := schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[SlotValueOverride](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{ // nosemgrep:ci.semgrep.framework.map_block_key-meaningful-names
+				"map_block_key": schema.StringAttribute{
+					Required: true,
+				},
+				"shape": schema.StringAttribute{
+					Optional:   true,
+					CustomType: fwtypes.StringEnumType[awstypes.SlotShape](),
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrValue: slotValueLNB,
+			},
+		},
+	}
+
+	dialogActionLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[DialogAction](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrType: schema.StringAttribute{
+					Required:   true,
+					CustomType: fwtypes.StringEnumType[awstypes.DialogActionType](),
+				},
+				"slot_to_elicit": schema.StringAttribute{
+					Optional: true,
+				},
+				"suppress_next_message": schema.BoolAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	intentOverrideLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[IntentOverride](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"slot": slotValueOverrideLNB,
+			},
+		},
+	}
+
+	dialogStateNBO := schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"session_attributes": schema.MapAttribute{
+				ElementType: types.StringType,
+				CustomType:  fwtypes.NewMapTypeOf[types.String](ctx),
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dialog_action": dialogActionLNB,
+			"intent":        intentOverrideLNB,
+		},
+	}
+
+	nextStepLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType:   fwtypes.NewListNestedObjectTypeOf[DialogState](ctx),
+		NestedObject: dialogStateNBO,
+	}
+
+	conditionLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[Condition](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"expression_string": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	conditionalBranchLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[ConditionalBranch](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrCondition: conditionLNB,
+				"next_step": schema.ListNestedBlock{
+					Validators: []validator.List{
+						listvalidator.SizeBetween(1, 1),
+					},
+					CustomType:   fwtypes.NewListNestedObjectTypeOf[DialogState](ctx),
+					NestedObject: dialogStateNBO,
+				},
+				"response": responseSpecificationLNB,
+			},
+		},
+	}
+
+	defaultBranchLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[DefaultConditionalBranch](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"next_step": nextStepLNB,
+				"response":  responseSpecificationLNB,
+			},
+		},
+	}
+
+	conditionalSpecificationLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[ConditionalSpecification](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"active": schema.BoolAttribute{
+					Required: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"conditional_branch": conditionalBranchLNB,
+				"default_branch":     defaultBranchLNB,
+			},
+		},
+	}
+
+	postCodeHookSpecificationLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[FailureSuccessTimeout](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"failure_conditional": conditionalSpecificationLNB,
+				"failure_next_step":   nextStepLNB,
+				"failure_response":    responseSpecificationLNB,
+				"success_conditional": conditionalSpecificationLNB,
+				"success_next_step":   nextStepLNB,
+				"success_response":    responseSpecificationLNB,
+				"timeout_conditional": conditionalSpecificationLNB,
+				"timeout_next_step":   nextStepLNB,
+				"timeout_response":    responseSpecificationLNB,
+			},
+		},
+	}
+
+	codeHookLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[DialogCodeHookInvocationSetting](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"active": schema.BoolAttribute{
+					Required: true,
+				},
+				"enable_code_hook_invocation": schema.BoolAttribute{
+					Required: true,
+				},
+				"invocation_label": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"post_code_hook_specification": postCodeHookSpecificationLNB,
+			},
+		},
+	}
+
+	elicitationCodeHookLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[ElicitationCodeHookInvocationSetting](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"enable_code_hook_invocation": schema.BoolAttribute{
+					Optional: true,
+				},
+				"invocation_label": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	slotCaptureSettingLNB := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		CustomType: fwtypes.NewListNestedObjectTypeOf[SlotCaptureSettingData](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"capture_conditional":   conditionalSpecificationLNB,
+				"capture_next_step":     nextStepLNB,
+				"capture_response":      responseSpecificationLNB,
+				"code_hook":             codeHookLNB,
+				"elicitation_code_hook": elicitationCodeHookLNB,
+				"failure_conditional":   conditionalSpecificationLNB,
+				"failure_next_step":     nextStepLNB,
+				"failure_response":      responseSpecificationLNB,
+			},
+		},
+	}
+
 	valueElicitationSettingLNB := schema.ListNestedBlock{
 		CustomType: fwtypes.NewListNestedObjectTypeOf[ValueElicitationSettingData](ctx),
 		Validators: []validator.List{
@@ -514,6 +758,7 @@ func (r *resourceSlot) Schema(ctx context.Context, req resource.SchemaRequest, r
 				"default_value_specification":     defaultValueSpecificationLNB,
 				"prompt_specification":            promptSpecificationLNB,
 				"sample_utterance":                sampleUtteranceLNB,
+				"slot_capture_setting":            slotCaptureSettingLNB,
 				"slot_resolution_setting":         slotResolutionSettingLNB,
 				"wait_and_continue_specification": waitAndContinueSpecificationLNB,
 			},
@@ -599,7 +844,10 @@ func (r *resourceSlot) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	out, err := conn.CreateSlot(ctx, in)
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, createTimeout, func() (interface{}, error) {
+		return conn.CreateSlot(ctx, in)
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameSlot, plan.Name.String(), err),
@@ -607,7 +855,8 @@ func (r *resourceSlot) Create(ctx context.Context, req resource.CreateRequest, r
 		)
 		return
 	}
-	if out == nil {
+	out, ok := outputRaw.(*lexmodelsv2.CreateSlotOutput)
+	if !ok || out == nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameSlot, plan.Name.String(), nil),
 			errors.New("empty output").Error(),
@@ -633,6 +882,14 @@ func (r *resourceSlot) Create(ctx context.Context, req resource.CreateRequest, r
 
 	plan.ID = types.StringValue(id)
 
+	if err := waitSlotStable(ctx, conn, id, createTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameSlot, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
 	resp.Diagnostics.Append(flex.Flatten(ctx, out, &plan, slotFlexOpt)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -689,7 +946,10 @@ func (r *resourceSlot) Update(ctx context.Context, req resource.UpdateRequest, r
 			return
 		}
 
-		out, err := conn.UpdateSlot(ctx, input)
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, updateTimeout, func() (interface{}, error) {
+			return conn.UpdateSlot(ctx, input)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameSlot, plan.ID.String(), err),
@@ -697,7 +957,8 @@ func (r *resourceSlot) Update(ctx context.Context, req resource.UpdateRequest, r
 			)
 			return
 		}
-		if out == nil {
+		out, ok := outputRaw.(*lexmodelsv2.UpdateSlotOutput)
+		if !ok || out == nil {
 			resp.Diagnostics.AddError(
 				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameSlot, plan.ID.String(), nil),
 				errors.New("empty output").Error(),
@@ -705,7 +966,15 @@ func (r *resourceSlot) Update(ctx context.Context, req resource.UpdateRequest, r
 			return
 		}
 
-		resp.Diagnostics.Append(flex.Flatten(ctx, input, &plan, slotFlexOpt)...)
+		if err := waitSlotStable(ctx, conn, plan.ID.ValueString(), updateTimeout); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameSlot, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(flex.Flatten(ctx, out, &plan, slotFlexOpt)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -750,6 +1019,32 @@ func (r *resourceSlot) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// waitSlotStable waits for a created or updated slot to be readable and for
+// its bot locale to settle, so that subsequent mutations of sibling resources
+// don't fail with a ConflictException.
+func waitSlotStable(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) error {
+	parts, err := intflex.ExpandResourceId(id, slotIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	_, err = tfresource.RetryWhenNotFound(ctx, timeout, func() (interface{}, error) {
+		return findSlotByID(ctx, conn, id)
+	})
+	if err != nil {
+		return err
+	}
+
+	botLocaleID, err := intflex.FlattenResourceId([]string{parts[3], parts[0], parts[1]}, botLocaleIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	_, err = waitBotLocaleStable(ctx, conn, botLocaleID, timeout)
+
+	return err
+}
+
 func findSlotByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeSlotOutput, error) {
 	parts, err := intflex.ExpandResourceId(id, slotIDPartCount, false)
 	if err != nil {
@@ -835,6 +1130,17 @@ type DefaultValueData struct {
 	DefaultValue types.String `tfsdk:"default_value"`
 }
 
+type SlotCaptureSettingData struct {
+	CaptureConditional  fwtypes.ListNestedObjectValueOf[ConditionalSpecification]             `tfsdk:"capture_conditional"`
+	CaptureNextStep     fwtypes.ListNestedObjectValueOf[DialogState]                          `tfsdk:"capture_next_step"`
+	CaptureResponse     fwtypes.ListNestedObjectValueOf[ResponseSpecification]                `tfsdk:"capture_response"`
+	CodeHook            fwtypes.ListNestedObjectValueOf[DialogCodeHookInvocationSetting]      `tfsdk:"code_hook"`
+	ElicitationCodeHook fwtypes.ListNestedObjectValueOf[ElicitationCodeHookInvocationSetting] `tfsdk:"elicitation_code_hook"`
+	FailureConditional  fwtypes.ListNestedObjectValueOf[ConditionalSpecification]             `tfsdk:"failure_conditional"`
+	FailureNextStep     fwtypes.ListNestedObjectValueOf[DialogState]                          `tfsdk:"failure_next_step"`
+	FailureResponse     fwtypes.ListNestedObjectValueOf[ResponseSpecification]                `tfsdk:"failure_response"`
+}
+
 type SlotResolutionSettingData struct {
 	SlotResolutionStrategy fwtypes.StringEnum[awstypes.SlotResolutionStrategy] `tfsdk:"slot_resolution_strategy"`
 }
@@ -858,6 +1164,7 @@ type ValueElicitationSettingData struct {
 	DefaultValueSpecification    fwtypes.ListNestedObjectValueOf[DefaultValueSpecificationData]    `tfsdk:"default_value_specification"`
 	PromptSpecification          fwtypes.ListNestedObjectValueOf[PromptSpecification]              `tfsdk:"prompt_specification"`
 	SampleUtterance              fwtypes.ListNestedObjectValueOf[SampleUtterance]                  `tfsdk:"sample_utterance"`
+	SlotCaptureSetting           fwtypes.ListNestedObjectValueOf[SlotCaptureSettingData]           `tfsdk:"slot_capture_setting"`
 	SlotResolutionSetting        fwtypes.ListNestedObjectValueOf[SlotResolutionSettingData]        `tfsdk:"slot_resolution_setting"`
 	WaitAndContinueSpecification fwtypes.ListNestedObjectValueOf[WaitAndContinueSpecificationData] `tfsdk:"wait_and_continue_specification"`
 }
@@ -865,5 +1172,9 @@ type ValueElicitationSettingData struct {
 func slotHasChanges(_ context.Context, plan, state resourceSlotData) bool {
 	return !plan.Description.Equal(state.Description) ||
 		!plan.MultipleValuesSetting.Equal(state.MultipleValuesSetting) ||
-		!plan.SlotTypeID.Equal(state.SlotTypeID)
+		!plan.Name.Equal(state.Name) ||
+		!plan.ObfuscationSetting.Equal(state.ObfuscationSetting) ||
+		!plan.SlotTypeID.Equal(state.SlotTypeID) ||
+		!plan.SubSlotSetting.Equal(state.SubSlotSetting) ||
+		!plan.ValueElicitationSetting.Equal(state.ValueElicitationSetting)
 }
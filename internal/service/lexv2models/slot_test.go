@@ -139,6 +139,89 @@ func TestAccLexV2ModelsSlot_obfuscationSetting(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "obfuscation_setting.0.obfuscation_setting_type", "DefaultObfuscation"),
 				),
 			},
+			{
+				Config: testAccSlotConfig_updateObfuscationSetting(rName, "None"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName, &slot),
+					resource.TestCheckResourceAttr(resourceName, "obfuscation_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "obfuscation_setting.0.obfuscation_setting_type", "None"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsSlot_updateValueElicitationSetting(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var slot lexmodelsv2.DescribeSlotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_slot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotConfig_updateValueElicitationSetting(rName, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName, &slot),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.default_value_specification.0.default_value_list.0.default_value", "default"),
+				),
+			},
+			{
+				Config: testAccSlotConfig_updateValueElicitationSetting(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName, &slot),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.default_value_specification.0.default_value_list.0.default_value", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsSlot_slotCaptureSetting(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var slot lexmodelsv2.DescribeSlotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_slot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotConfig_slotCaptureSetting(rName, "captured"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName, &slot),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.slot_capture_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.slot_capture_setting.0.capture_response.0.message_group.0.message.0.plain_text_message.0.value", "captured"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.slot_capture_setting.0.capture_next_step.0.dialog_action.0.type", "ElicitIntent"),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.slot_capture_setting.0.elicitation_code_hook.0.enable_code_hook_invocation", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccSlotConfig_slotCaptureSetting(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName, &slot),
+					resource.TestCheckResourceAttr(resourceName, "value_elicitation_setting.0.slot_capture_setting.0.capture_response.0.message_group.0.message.0.plain_text_message.0.value", "updated"),
+				),
+			},
 		},
 	})
 }
@@ -162,7 +245,7 @@ func TestAccLexV2ModelsSlot_subSlotSetting(t *testing.T) {
 		CheckDestroy:             testAccCheckSlotDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSlotConfig_subSlotSetting(rName, true),
+				Config: testAccSlotConfig_subSlotSetting(rName, true, "test"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSlotExists(ctx, resourceName, &slot),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
@@ -172,6 +255,52 @@ func TestAccLexV2ModelsSlot_subSlotSetting(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "sub_slot_setting.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "sub_slot_setting.0.expression", "string"),
 					resource.TestCheckResourceAttr(resourceName, "sub_slot_setting.0.slot_specification.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "sub_slot_setting.0.slot_specification.*", map[string]string{
+						"map_block_key": "Initial",
+						"value_elicitation_setting.0.prompt_specification.0.message_group.0.message.0.plain_text_message.0.value": "test",
+					}),
+				),
+			},
+			{
+				Config: testAccSlotConfig_subSlotSetting(rName, true, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName, &slot),
+					resource.TestCheckResourceAttr(resourceName, "sub_slot_setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sub_slot_setting.0.slot_specification.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "sub_slot_setting.0.slot_specification.*", map[string]string{
+						"map_block_key": "Initial",
+						"value_elicitation_setting.0.prompt_specification.0.message_group.0.message.0.plain_text_message.0.value": "updated",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsSlot_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var slot lexmodelsv2.DescribeSlotOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_slot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlotDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotConfig_multiple(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotExists(ctx, resourceName+".0", &slot),
+					testAccCheckSlotExists(ctx, resourceName+".4", &slot),
 				),
 			},
 		},
@@ -341,6 +470,31 @@ resource "aws_lexv2models_slot" "test" {
 `, rName))
 }
 
+func testAccSlotConfig_multiple(rName string, count int) string {
+	return acctest.ConfigCompose(
+		testAccSlotConfig_base(rName, 60, true),
+		fmt.Sprintf(`
+resource "aws_lexv2models_slot" "test" {
+  count = %[2]d
+
+  bot_id      = aws_lexv2models_bot.test.id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  intent_id   = aws_lexv2models_intent.test.intent_id
+  name        = "%[1]s_${count.index}"
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  value_elicitation_setting {
+    slot_constraint = "Optional"
+    default_value_specification {
+      default_value_list {
+        default_value = "default"
+      }
+    }
+  }
+}
+`, rName, count))
+}
+
 func testAccSlotConfig_updateMultipleValuesSetting(rName string, allow bool) string {
 	return acctest.ConfigCompose(
 		testAccSlotConfig_base(rName, 60, true),
@@ -395,7 +549,75 @@ resource "aws_lexv2models_slot" "test" {
 `, rName, settingType))
 }
 
-func testAccSlotConfig_subSlotSetting(rName string, allow bool) string {
+func testAccSlotConfig_updateValueElicitationSetting(rName, defaultValue string) string {
+	return acctest.ConfigCompose(
+		testAccSlotConfig_base(rName, 60, true),
+		fmt.Sprintf(`
+resource "aws_lexv2models_slot" "test" {
+  bot_id      = aws_lexv2models_bot.test.id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  intent_id   = aws_lexv2models_intent.test.intent_id
+  name        = %[1]q
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  value_elicitation_setting {
+    slot_constraint = "Optional"
+    default_value_specification {
+      default_value_list {
+        default_value = %[2]q
+      }
+    }
+  }
+}
+`, rName, defaultValue))
+}
+
+func testAccSlotConfig_slotCaptureSetting(rName, message string) string {
+	return acctest.ConfigCompose(
+		testAccSlotConfig_base(rName, 60, true),
+		fmt.Sprintf(`
+resource "aws_lexv2models_slot" "test" {
+  bot_id      = aws_lexv2models_bot.test.id
+  bot_version = aws_lexv2models_bot_locale.test.bot_version
+  intent_id   = aws_lexv2models_intent.test.intent_id
+  name        = %[1]q
+  locale_id   = aws_lexv2models_bot_locale.test.locale_id
+
+  value_elicitation_setting {
+    slot_constraint = "Optional"
+    default_value_specification {
+      default_value_list {
+        default_value = "default"
+      }
+    }
+
+    slot_capture_setting {
+      capture_response {
+        message_group {
+          message {
+            plain_text_message {
+              value = %[2]q
+            }
+          }
+        }
+      }
+
+      capture_next_step {
+        dialog_action {
+          type = "ElicitIntent"
+        }
+      }
+
+      elicitation_code_hook {
+        enable_code_hook_invocation = true
+      }
+    }
+  }
+}
+`, rName, message))
+}
+
+func testAccSlotConfig_subSlotSetting(rName string, allow bool, message string) string {
 	return acctest.ConfigCompose(
 		testAccSlotConfig_base(rName, 60, true),
 		fmt.Sprintf(`
@@ -442,7 +664,7 @@ resource "aws_lexv2models_slot" "test" {
           message_group {
             message {
               plain_text_message {
-                value = "test"
+                value = %[3]q
               }
             }
           }
@@ -510,5 +732,5 @@ resource "aws_lexv2models_slot" "test" {
     }
   }
 }
-`, rName, allow))
+`, rName, allow, message))
 }
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Test Execution")
+func newResourceTestExecution(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTestExecution{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameTestExecution = "Test Execution"
+)
+
+var testExecutionFlexOpt = flex.WithFieldNamePrefix("TestExecution")
+
+type resourceTestExecution struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *resourceTestExecution) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_test_execution"
+}
+
+func (r *resourceTestExecution) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestExecutionApiMode](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"failure_reasons": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"test_execution_modality": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestExecutionModality](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"test_execution_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestExecutionStatus](),
+				Computed:   true,
+			},
+			"test_set_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"test_set_name": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"target": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testExecutionTargetData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"bot_alias_target": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[botAliasTestExecutionTargetData](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bot_alias_id": schema.StringAttribute{
+										Required: true,
+									},
+									"bot_id": schema.StringAttribute{
+										Required: true,
+									},
+									"locale_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceTestExecution) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceTestExecutionData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.StartTestExecutionInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.StartTestExecution(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameTestExecution, plan.TestSetID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.TestExecutionId == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameTestExecution, plan.TestSetID.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.TestExecutionId)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	waitOut, err := waitTestExecutionCompleted(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameTestExecution, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, waitOut, &plan, testExecutionFlexOpt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTestExecution) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceTestExecutionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findTestExecutionByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameTestExecution, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state, testExecutionFlexOpt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete stops the test execution if it is still running. Test executions
+// cannot be deleted, so the execution history is retained by Lex.
+func (r *resourceTestExecution) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceTestExecutionData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findTestExecutionByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameTestExecution, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	switch out.TestExecutionStatus {
+	case awstypes.TestExecutionStatusPending, awstypes.TestExecutionStatusWaiting, awstypes.TestExecutionStatusInProgress:
+	default:
+		return
+	}
+
+	// Test executions can be neither deleted nor stopped, so wait for the execution to finish.
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitTestExecutionStopped(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameTestExecution, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func waitTestExecutionCompleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.TestExecutionStatusPending, awstypes.TestExecutionStatusWaiting, awstypes.TestExecutionStatusInProgress),
+		Target:     enum.Slice(awstypes.TestExecutionStatusCompleted),
+		Refresh:    statusTestExecution(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeTestExecutionOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitTestExecutionStopped(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TestExecutionStatusPending, awstypes.TestExecutionStatusWaiting, awstypes.TestExecutionStatusInProgress, awstypes.TestExecutionStatusStopping),
+		Target:  enum.Slice(awstypes.TestExecutionStatusStopped, awstypes.TestExecutionStatusCompleted, awstypes.TestExecutionStatusFailed),
+		Refresh: statusTestExecution(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeTestExecutionOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusTestExecution(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findTestExecutionByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.TestExecutionStatus), nil
+	}
+}
+
+func findTestExecutionByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeTestExecutionOutput, error) {
+	in := &lexmodelsv2.DescribeTestExecutionInput{
+		TestExecutionId: aws.String(id),
+	}
+
+	out, err := conn.DescribeTestExecution(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.TestExecutionId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceTestExecutionData struct {
+	APIMode               fwtypes.StringEnum[awstypes.TestExecutionApiMode]        `tfsdk:"api_mode"`
+	CreationDateTime      timetypes.RFC3339                                        `tfsdk:"creation_date_time"`
+	FailureReasons        fwtypes.ListValueOf[types.String]                        `tfsdk:"failure_reasons"`
+	ID                    types.String                                             `tfsdk:"id"`
+	LastUpdatedDateTime   timetypes.RFC3339                                        `tfsdk:"last_updated_date_time"`
+	Target                fwtypes.ListNestedObjectValueOf[testExecutionTargetData] `tfsdk:"target"`
+	TestExecutionModality fwtypes.StringEnum[awstypes.TestExecutionModality]       `tfsdk:"test_execution_modality"`
+	TestExecutionStatus   fwtypes.StringEnum[awstypes.TestExecutionStatus]         `tfsdk:"test_execution_status"`
+	TestSetID             types.String                                             `tfsdk:"test_set_id"`
+	TestSetName           types.String                                             `tfsdk:"test_set_name"`
+	Timeouts              timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+type testExecutionTargetData struct {
+	BotAliasTarget fwtypes.ListNestedObjectValueOf[botAliasTestExecutionTargetData] `tfsdk:"bot_alias_target"`
+}
+
+type botAliasTestExecutionTargetData struct {
+	BotAliasID types.String `tfsdk:"bot_alias_id"`
+	BotID      types.String `tfsdk:"bot_id"`
+	LocaleID   types.String `tfsdk:"locale_id"`
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsTestExecution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var testExecution lexmodelsv2.DescribeTestExecutionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_test_execution.test"
+	testSetResourceName := "aws_lexv2models_test_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTestSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestExecutionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTestExecutionExists(ctx, resourceName, &testExecution),
+					resource.TestCheckResourceAttr(resourceName, "api_mode", string(types.TestExecutionApiModeNonStreaming)),
+					resource.TestCheckResourceAttr(resourceName, "test_execution_status", string(types.TestExecutionStatusCompleted)),
+					resource.TestCheckResourceAttrPair(resourceName, "test_set_id", testSetResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "test_set_name", testSetResourceName, names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTestExecutionExists(ctx context.Context, name string, testExecution *lexmodelsv2.DescribeTestExecutionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameTestExecution, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameTestExecution, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		resp, err := tflexv2models.FindTestExecutionByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameTestExecution, rs.Primary.ID, err)
+		}
+
+		*testExecution = *resp
+
+		return nil
+	}
+}
+
+func testAccTestExecutionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTestSetConfig_basic(rName, "test"),
+		`
+resource "aws_lexv2models_bot" "test" {
+  name                        = aws_lexv2models_test_set.test.name
+  idle_session_ttl_in_seconds = 60
+  role_arn                    = aws_iam_role.test.arn
+
+  data_privacy {
+    child_directed = true
+  }
+}
+
+resource "aws_lexv2models_bot_locale" "test" {
+  locale_id                        = "en_US"
+  bot_id                           = aws_lexv2models_bot.test.id
+  bot_version                      = "DRAFT"
+  n_lu_intent_confidence_threshold = 0.7
+}
+
+resource "aws_lexv2models_test_execution" "test" {
+  test_set_id = aws_lexv2models_test_set.test.id
+  api_mode    = "NonStreaming"
+
+  target {
+    bot_alias_target {
+      bot_alias_id = "TSTALIASID"
+      bot_id       = aws_lexv2models_bot_locale.test.bot_id
+      locale_id    = aws_lexv2models_bot_locale.test.locale_id
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Test Set")
+func newResourceTestSet(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTestSet{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameTestSet = "Test Set"
+)
+
+var testSetFlexOpt = flex.WithFieldNamePrefix("TestSet")
+
+type resourceTestSet struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceTestSet) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_lexv2models_test_set"
+}
+
+func (r *resourceTestSet) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"creation_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"modality": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestSetModality](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"num_turns": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TestSetStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"import_input_location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testSetImportInputLocationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_bucket_name": schema.StringAttribute{
+							Required: true,
+						},
+						"s3_path": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"storage_location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[testSetStorageLocationData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+						"s3_bucket_name": schema.StringAttribute{
+							Required: true,
+						},
+						"s3_path": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceTestSet) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceTestSetData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec := &awstypes.TestSetImportResourceSpecification{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, spec, testSetFlexOpt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Test sets are imported from S3, but StartImport still requires an import ID.
+	uploadOut, err := conn.CreateUploadUrl(ctx, &lexmodelsv2.CreateUploadUrlInput{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameTestSet, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if uploadOut == nil || uploadOut.ImportId == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameTestSet, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	in := &lexmodelsv2.StartImportInput{
+		ImportId:      uploadOut.ImportId,
+		MergeStrategy: awstypes.MergeStrategyFailOnConflict,
+		ResourceSpecification: &awstypes.ImportResourceSpecification{
+			TestSetImportResourceSpecification: spec,
+		},
+	}
+
+	_, err = conn.StartImport(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameTestSet, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	importOut, err := waitImportCompleted(ctx, conn, aws.ToString(uploadOut.ImportId), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameTestSet, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, importOut.ImportedResourceId)
+
+	waitOut, err := waitTestSetReady(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameTestSet, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, waitOut, &plan, testSetFlexOpt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTestSet) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceTestSetData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findTestSetByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameTestSet, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state, testSetFlexOpt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTestSet) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan, state resourceTestSetData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		in := &lexmodelsv2.UpdateTestSetInput{
+			Description: plan.Description.ValueStringPointer(),
+			TestSetId:   plan.ID.ValueStringPointer(),
+			TestSetName: plan.Name.ValueStringPointer(),
+		}
+
+		_, err := conn.UpdateTestSet(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameTestSet, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+	waitOut, err := waitTestSetReady(ctx, conn, plan.ID.ValueString(), updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameTestSet, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, waitOut, &plan, testSetFlexOpt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceTestSet) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceTestSetData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.DeleteTestSetInput{
+		TestSetId: state.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteTestSet(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameTestSet, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitTestSetDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameTestSet, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func waitTestSetReady(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestSetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.TestSetStatusImporting),
+		Target:                    enum.Slice(awstypes.TestSetStatusReady),
+		Refresh:                   statusTestSet(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeTestSetOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitTestSetDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeTestSetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TestSetStatusDeleting),
+		Target:  []string{},
+		Refresh: statusTestSet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeTestSetOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusTestSet(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findTestSetByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findTestSetByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeTestSetOutput, error) {
+	in := &lexmodelsv2.DescribeTestSetInput{
+		TestSetId: aws.String(id),
+	}
+
+	out, err := conn.DescribeTestSet(ctx, in)
+	if err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.TestSetId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceTestSetData struct {
+	CreationDateTime    timetypes.RFC3339                                               `tfsdk:"creation_date_time"`
+	Description         types.String                                                    `tfsdk:"description"`
+	ID                  types.String                                                    `tfsdk:"id"`
+	ImportInputLocation fwtypes.ListNestedObjectValueOf[testSetImportInputLocationData] `tfsdk:"import_input_location"`
+	LastUpdatedDateTime timetypes.RFC3339                                               `tfsdk:"last_updated_date_time"`
+	Modality            fwtypes.StringEnum[awstypes.TestSetModality]                    `tfsdk:"modality"`
+	Name                types.String                                                    `tfsdk:"name"`
+	NumTurns            types.Int64                                                     `tfsdk:"num_turns"`
+	RoleARN             fwtypes.ARN                                                     `tfsdk:"role_arn"`
+	Status              fwtypes.StringEnum[awstypes.TestSetStatus]                      `tfsdk:"status"`
+	StorageLocation     fwtypes.ListNestedObjectValueOf[testSetStorageLocationData]     `tfsdk:"storage_location"`
+	Timeouts            timeouts.Value                                                  `tfsdk:"timeouts"`
+}
+
+type testSetImportInputLocationData struct {
+	S3BucketName types.String `tfsdk:"s3_bucket_name"`
+	S3Path       types.String `tfsdk:"s3_path"`
+}
+
+type testSetStorageLocationData struct {
+	KMSKeyARN    fwtypes.ARN  `tfsdk:"kms_key_arn"`
+	S3BucketName types.String `tfsdk:"s3_bucket_name"`
+	S3Path       types.String `tfsdk:"s3_path"`
+}
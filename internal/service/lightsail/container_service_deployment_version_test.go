@@ -482,6 +482,45 @@ func TestAccLightsailContainerServiceDeploymentVersion_Container_enableService(t
 	})
 }
 
+func TestAccLightsailContainerServiceDeploymentVersion_Container_ecrImageDigest(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	containerName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lightsail_container_service_deployment_version.test"
+	imageDataSourceName := "data.aws_ecr_image.test"
+	// The repository is not managed by the test. Its policy must already allow image pulls by
+	// Lightsail container service ECR image puller roles (arn:aws:iam::*:role/amazon/lightsail/*).
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, "LIGHTSAIL_ECR_REPOSITORY_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerServiceDeploymentVersionConfig_Container_ecrImageDigest(rName, containerName, repositoryName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerServiceDeploymentVersionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.ContainerServiceDeploymentStateActive)),
+					resource.TestCheckResourceAttr(resourceName, "container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.container_name", containerName),
+					resource.TestCheckResourceAttrPair(resourceName, "container.0.image", imageDataSourceName, "image_uri"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckContainerServiceDeploymentVersionExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -724,3 +763,33 @@ resource "aws_lightsail_container_service_deployment_version" "test" {
 }
 `, rName, isDisabled, containerName)
 }
+
+func testAccContainerServiceDeploymentVersionConfig_Container_ecrImageDigest(rName, containerName, repositoryName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_container_service" "test" {
+  name  = %[1]q
+  power = "nano"
+  scale = 1
+
+  private_registry_access {
+    ecr_image_puller_role {
+      is_active = true
+    }
+  }
+}
+
+data "aws_ecr_image" "test" {
+  repository_name = %[3]q
+  image_tag       = "latest"
+}
+
+resource "aws_lightsail_container_service_deployment_version" "test" {
+  container {
+    container_name = %[2]q
+    image          = data.aws_ecr_image.test.image_uri
+  }
+
+  service_name = aws_lightsail_container_service.test.name
+}
+`, rName, containerName, repositoryName)
+}
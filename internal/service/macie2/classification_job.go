@@ -554,6 +554,11 @@ func resourceClassificationJob() *schema.Resource {
 			},
 		},
 		CustomizeDiff: resourceClassificationJobCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -637,6 +642,13 @@ func resourceClassificationJobCreate(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(aws.ToString(output.JobId))
 
+	// Jobs are always created in a running state. Pause the job if requested.
+	if v, ok := d.GetOk("job_status"); ok && v.(string) == string(awstypes.JobStatusUserPaused) {
+		if err := updateClassificationJobStatus(ctx, conn, d.Id(), awstypes.JobStatusUserPaused, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceClassificationJobRead(ctx, d, meta)...)
 }
 
@@ -645,15 +657,9 @@ func resourceClassificationJobRead(ctx context.Context, d *schema.ResourceData,
 
 	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
 
-	input := &macie2.DescribeClassificationJobInput{
-		JobId: aws.String(d.Id()),
-	}
-
-	resp, err := conn.DescribeClassificationJob(ctx, input)
+	resp, err := findClassificationJobByID(ctx, conn, d.Id())
 
-	if !d.IsNewResource() && (errs.IsA[*awstypes.ResourceNotFoundException](err) ||
-		errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "Macie is not enabled") ||
-		errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "cannot update cancelled job for job")) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Macie ClassificationJob (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
@@ -701,26 +707,46 @@ func resourceClassificationJobUpdate(ctx context.Context, d *schema.ResourceData
 
 	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
 
-	input := &macie2.UpdateClassificationJobInput{
-		JobId: aws.String(d.Id()),
-	}
-
 	if d.HasChange("job_status") {
-		status := d.Get("job_status").(string)
+		status := awstypes.JobStatus(d.Get("job_status").(string))
 
-		if status == string(awstypes.JobStatusCancelled) {
+		if status == awstypes.JobStatusCancelled {
 			return sdkdiag.AppendErrorf(diags, "updating Macie ClassificationJob (%s): %s", d.Id(), fmt.Sprintf("%s cannot be set", awstypes.JobStatusCancelled))
 		}
 
-		input.JobStatus = awstypes.JobStatus(status)
+		if err := updateClassificationJobStatus(ctx, conn, d.Id(), status, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceClassificationJobRead(ctx, d, meta)...)
+}
+
+// updateClassificationJobStatus pauses or resumes a classification job and waits for the new status to take effect.
+func updateClassificationJobStatus(ctx context.Context, conn *macie2.Client, id string, status awstypes.JobStatus, timeout time.Duration) error {
+	input := &macie2.UpdateClassificationJobInput{
+		JobId:     aws.String(id),
+		JobStatus: status,
 	}
 
 	_, err := conn.UpdateClassificationJob(ctx, input)
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating Macie ClassificationJob (%s): %s", d.Id(), err)
+		return fmt.Errorf("updating Macie ClassificationJob (%s): %w", id, err)
 	}
 
-	return append(diags, resourceClassificationJobRead(ctx, d, meta)...)
+	switch status {
+	case awstypes.JobStatusRunning:
+		if _, err := waitClassificationJobRunning(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for Macie ClassificationJob (%s) resume: %w", id, err)
+		}
+	case awstypes.JobStatusUserPaused:
+		if _, err := waitClassificationJobUserPaused(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for Macie ClassificationJob (%s) pause: %w", id, err)
+		}
+	}
+
+	return nil
 }
 
 func resourceClassificationJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -172,6 +172,14 @@ func testAccClassificationJob_Status(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccClassificationJobConfig_status(bucketName, string(awstypes.JobStatusRunning)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output2),
+					testAccCheckClassificationJobNotRecreated(&macie2Output, &macie2Output2),
+					resource.TestCheckResourceAttr(resourceName, "job_status", string(awstypes.JobStatusRunning)),
+				),
+			},
 		},
 	})
 }
@@ -400,18 +408,13 @@ func testAccCheckClassificationJobExists(ctx context.Context, resourceName strin
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Client(ctx)
-		input := &macie2.DescribeClassificationJobInput{JobId: aws.String(rs.Primary.ID)}
 
-		resp, err := conn.DescribeClassificationJob(ctx, input)
+		resp, err := tfmacie2.FindClassificationJobByID(ctx, conn, rs.Primary.ID)
 
 		if err != nil {
 			return err
 		}
 
-		if resp == nil {
-			return fmt.Errorf("macie ClassificationJob %q does not exist", rs.Primary.ID)
-		}
-
 		*macie2Session = *resp
 
 		return nil
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_macie2_custom_data_identifiers", name="Custom Data Identifiers")
+func dataSourceCustomDataIdentifiers() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCustomDataIdentifiersRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrNamePrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCustomDataIdentifiersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	namePrefix := d.Get(names.AttrNamePrefix).(string)
+	nameRegex := d.Get("name_regex").(string)
+
+	input := &macie2.ListCustomDataIdentifiersInput{}
+	output, err := findCustomDataIdentifiers(ctx, conn, input, func(v *awstypes.CustomDataIdentifierSummary) bool {
+		name := aws.ToString(v.Name)
+
+		if namePrefix != "" && !strings.HasPrefix(name, namePrefix) {
+			return false
+		}
+
+		if nameRegex != "" && !regexache.MustCompile(nameRegex).MatchString(name) {
+			return false
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Custom Data Identifiers: %s", err)
+	}
+
+	var arns, ids, nms []string
+
+	for _, v := range output {
+		arns = append(arns, aws.ToString(v.Arn))
+		ids = append(ids, aws.ToString(v.Id))
+		nms = append(nms, aws.ToString(v.Name))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrIDs, ids)
+	d.Set(names.AttrNames, nms)
+
+	return diags
+}
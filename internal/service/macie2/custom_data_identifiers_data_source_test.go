@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCustomDataIdentifiersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_macie2_custom_data_identifier.test"
+	dataSourceName := "data.aws_macie2_custom_data_identifiers.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	regex := "[0-9]{3}-[0-9]{2}-[0-9]{4}"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDataIdentifierDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDataIdentifiersDataSourceConfig_basic(rName, regex),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomDataIdentifiersDataSourceConfig_basic(rName, regex string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_custom_data_identifier" "test" {
+  name  = %[1]q
+  regex = %[2]q
+
+  depends_on = [aws_macie2_account.test]
+}
+
+data "aws_macie2_custom_data_identifiers" "test" {
+  name_prefix = %[1]q
+
+  depends_on = [aws_macie2_custom_data_identifier.test]
+}
+`, rName, regex)
+}
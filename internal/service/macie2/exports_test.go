@@ -14,5 +14,7 @@ var (
 	ResourceMember                            = resourceMember
 	ResourceOrganizationAdminAccount          = resourceOrganizationAdminAccount
 
-	FindMemberByID = findMemberByID
+	FindClassificationJobByID        = findClassificationJobByID
+	FindMemberByID                   = findMemberByID
+	FindOrganizationAdminAccountByID = findOrganizationAdminAccountByID
 )
@@ -9,6 +9,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/macie2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func findInvitationByAccount(ctx context.Context, conn *macie2.Client, accountID string) (string, error) {
@@ -53,3 +57,97 @@ func findMemberNotAssociated(ctx context.Context, conn *macie2.Client, accountID
 
 	return nil, nil
 }
+
+func findClassificationJobByID(ctx context.Context, conn *macie2.Client, id string) (*macie2.DescribeClassificationJobOutput, error) {
+	input := &macie2.DescribeClassificationJobInput{
+		JobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeClassificationJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) ||
+		errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "Macie is not enabled") ||
+		errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "cannot update cancelled job for job") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findCustomDataIdentifiers(ctx context.Context, conn *macie2.Client, input *macie2.ListCustomDataIdentifiersInput, filter tfslices.Predicate[*awstypes.CustomDataIdentifierSummary]) ([]awstypes.CustomDataIdentifierSummary, error) {
+	var output []awstypes.CustomDataIdentifierSummary
+
+	pages := macie2.NewListCustomDataIdentifiersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findOrganizationAdminAccountByID(ctx context.Context, conn *macie2.Client, adminAccountID string) (*awstypes.AdminAccount, error) {
+	input := &macie2.ListOrganizationAdminAccountsInput{}
+
+	return findOrganizationAdminAccount(ctx, conn, input, func(v *awstypes.AdminAccount) bool {
+		return aws.ToString(v.AccountId) == adminAccountID
+	})
+}
+
+func findOrganizationAdminAccount(ctx context.Context, conn *macie2.Client, input *macie2.ListOrganizationAdminAccountsInput, filter tfslices.Predicate[*awstypes.AdminAccount]) (*awstypes.AdminAccount, error) {
+	output, err := findOrganizationAdminAccounts(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findOrganizationAdminAccounts(ctx context.Context, conn *macie2.Client, input *macie2.ListOrganizationAdminAccountsInput, filter tfslices.Predicate[*awstypes.AdminAccount]) ([]awstypes.AdminAccount, error) {
+	var output []awstypes.AdminAccount
+
+	pages := macie2.NewListOrganizationAdminAccountsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "Macie is not enabled") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AdminAccounts {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_macie2_finding_statistics", name="Finding Statistics")
+func dataSourceFindingStatistics() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"counts_by_group": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"group_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"finding_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     findingCriteriaSchema(),
+			},
+			"group_by": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.GroupBy](),
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"sort_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.FindingStatisticsSortAttributeName](),
+						},
+						"order_by": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.OrderBy](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFindingStatisticsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	groupBy := d.Get("group_by").(string)
+	input := &macie2.GetFindingStatisticsInput{
+		GroupBy: awstypes.GroupBy(groupBy),
+	}
+
+	findingCriteria, err := expandFindingCriteriaFilter(d.Get("finding_criteria").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Finding Statistics (%s): %s", groupBy, err)
+	}
+	input.FindingCriteria = findingCriteria
+
+	if v, ok := d.GetOk("size"); ok {
+		input.Size = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("sort_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.SortCriteria = &awstypes.FindingStatisticsSortCriteria{
+			AttributeName: awstypes.FindingStatisticsSortAttributeName(tfMap["attribute_name"].(string)),
+		}
+
+		if v, ok := tfMap["order_by"].(string); ok && v != "" {
+			input.SortCriteria.OrderBy = awstypes.OrderBy(v)
+		}
+	}
+
+	output, err := conn.GetFindingStatistics(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Finding Statistics (%s): %s", groupBy, err)
+	}
+
+	d.SetId(groupBy)
+	if err := d.Set("counts_by_group", flattenGroupCounts(output.CountsByGroup)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting counts_by_group: %s", err)
+	}
+
+	return diags
+}
+
+func flattenGroupCounts(apiObjects []awstypes.GroupCount) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"count":     aws.ToInt64(apiObject.Count),
+			"group_key": aws.ToString(apiObject.GroupKey),
+		})
+	}
+
+	return tfList
+}
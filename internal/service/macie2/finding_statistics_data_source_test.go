@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFindingStatisticsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_macie2_finding_statistics.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingStatisticsDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "group_by", "severity.description"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "counts_by_group.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingStatisticsDataSourceConfig_basic() string {
+	return `
+resource "aws_macie2_account" "test" {}
+
+data "aws_macie2_finding_statistics" "test" {
+  group_by = "severity.description"
+
+  finding_criteria {
+    criterion {
+      field = "archived"
+      eq    = ["false"]
+    }
+  }
+
+  sort_criteria {
+    attribute_name = "count"
+    order_by       = "DESC"
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// GetFindings accepts at most 50 finding IDs per request.
+	getFindingsBatchSize = 50
+)
+
+// @SDKDataSource("aws_macie2_findings", name="Findings")
+func dataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"finding_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     findingCriteriaSchema(),
+			},
+			"finding_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAccountID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"archived": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sample": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDescription: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"score": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"sort_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"order_by": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.OrderBy](),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
+
+	input := &macie2.ListFindingsInput{}
+
+	findingCriteria, err := expandFindingCriteriaFilter(d.Get("finding_criteria").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Findings: %s", err)
+	}
+	input.FindingCriteria = findingCriteria
+
+	if v, ok := d.GetOk("sort_criteria"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.SortCriteria = &awstypes.SortCriteria{
+			AttributeName: aws.String(tfMap["attribute_name"].(string)),
+		}
+
+		if v, ok := tfMap["order_by"].(string); ok && v != "" {
+			input.SortCriteria.OrderBy = awstypes.OrderBy(v)
+		}
+	}
+
+	findingIDs, err := findFindingIDs(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Findings: %s", err)
+	}
+
+	findings, err := findFindingsByIDs(ctx, conn, findingIDs, input.SortCriteria)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Findings: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	d.Set("finding_ids", findingIDs)
+	if err := d.Set("findings", flattenFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+
+	return diags
+}
+
+func findFindingIDs(ctx context.Context, conn *macie2.Client, input *macie2.ListFindingsInput) ([]string, error) {
+	var output []string
+
+	pages := macie2.NewListFindingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.FindingIds...)
+	}
+
+	return output, nil
+}
+
+func findFindingsByIDs(ctx context.Context, conn *macie2.Client, ids []string, sortCriteria *awstypes.SortCriteria) ([]awstypes.Finding, error) {
+	var output []awstypes.Finding
+
+	for chunk := range slices.Chunk(ids, getFindingsBatchSize) {
+		input := &macie2.GetFindingsInput{
+			FindingIds:   chunk,
+			SortCriteria: sortCriteria,
+		}
+
+		page, err := conn.GetFindings(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func flattenFindings(apiObjects []awstypes.Finding) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrAccountID:   aws.ToString(apiObject.AccountId),
+			"archived":            aws.ToBool(apiObject.Archived),
+			"category":            string(apiObject.Category),
+			"count":               aws.ToInt64(apiObject.Count),
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			names.AttrID:          aws.ToString(apiObject.Id),
+			names.AttrRegion:      aws.ToString(apiObject.Region),
+			"sample":              aws.ToBool(apiObject.Sample),
+			"title":               aws.ToString(apiObject.Title),
+			names.AttrType:        string(apiObject.Type),
+		}
+
+		if v := apiObject.CreatedAt; v != nil {
+			tfMap[names.AttrCreatedAt] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.Severity; v != nil {
+			tfMap["severity"] = []interface{}{
+				map[string]interface{}{
+					names.AttrDescription: string(v.Description),
+					"score":               aws.ToInt64(v.Score),
+				},
+			}
+		}
+
+		if v := apiObject.UpdatedAt; v != nil {
+			tfMap["updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFindingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_macie2_findings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "finding_ids.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFindingsDataSourceConfig_basic() string {
+	return `
+resource "aws_macie2_account" "test" {}
+
+data "aws_macie2_findings" "test" {
+  finding_criteria {
+    criterion {
+      field = "archived"
+      eq    = ["false"]
+    }
+  }
+
+  sort_criteria {
+    attribute_name = "updatedAt"
+    order_by       = "DESC"
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`
+}
@@ -48,58 +48,7 @@ func resourceFindingsFilter() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"criterion": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							MinItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrField: {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"eq_exact_match": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"eq": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"neq": {
-										Type:     schema.TypeSet,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"lt": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidStringDateOrPositiveInt,
-									},
-									"lte": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidStringDateOrPositiveInt,
-									},
-									"gt": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidStringDateOrPositiveInt,
-									},
-									"gte": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ValidateFunc: verify.ValidStringDateOrPositiveInt,
-									},
-								},
-							},
-						},
-					},
-				},
+				Elem:     findingCriteriaSchema(),
 			},
 			names.AttrName: {
 				Type:          schema.TypeString,
@@ -288,6 +237,61 @@ func resourceFindingsFilterDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func findingCriteriaSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"criterion": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrField: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"eq_exact_match": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"eq": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"neq": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"lt": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidStringDateOrPositiveInt,
+						},
+						"lte": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidStringDateOrPositiveInt,
+						},
+						"gt": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidStringDateOrPositiveInt,
+						},
+						"gte": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidStringDateOrPositiveInt,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func expandFindingCriteriaFilter(findingCriterias []interface{}) (*awstypes.FindingCriteria, error) {
 	if len(findingCriterias) == 0 {
 		return nil, nil
@@ -41,6 +41,15 @@ func TestAccMacie2_serial(t *testing.T) {
 			"classification_job": testAccCustomDataIdentifier_WithClassificationJob,
 			"tags":               testAccCustomDataIdentifier_WithTags,
 		},
+		"CustomDataIdentifiersDataSource": {
+			acctest.CtBasic: testAccCustomDataIdentifiersDataSource_basic,
+		},
+		"FindingStatisticsDataSource": {
+			acctest.CtBasic: testAccFindingStatisticsDataSource_basic,
+		},
+		"FindingsDataSource": {
+			acctest.CtBasic: testAccFindingsDataSource_basic,
+		},
 		"FindingsFilter": {
 			acctest.CtBasic:      testAccFindingsFilter_basic,
 			"name_generated":     testAccFindingsFilter_Name_Generated,
@@ -55,6 +64,9 @@ func TestAccMacie2_serial(t *testing.T) {
 			acctest.CtBasic:      testAccOrganizationAdminAccount_basic,
 			acctest.CtDisappears: testAccOrganizationAdminAccount_disappears,
 		},
+		"OrganizationAdminAccountDataSource": {
+			acctest.CtBasic: testAccOrganizationAdminAccountDataSource_basic,
+		},
 		"Member": {
 			acctest.CtBasic:                         testAccMember_basic,
 			acctest.CtDisappears:                    testAccMember_disappears,
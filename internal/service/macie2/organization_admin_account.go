@@ -78,6 +78,10 @@ func resourceOrganizationAdminAccountCreate(ctx context.Context, d *schema.Resou
 
 	d.SetId(adminAccountID)
 
+	if _, err := waitOrganizationAdminAccountEnabled(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Macie OrganizationAdminAccount (%s) create: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceOrganizationAdminAccountRead(ctx, d, meta)...)
 }
 
@@ -86,10 +90,9 @@ func resourceOrganizationAdminAccountRead(ctx context.Context, d *schema.Resourc
 
 	conn := meta.(*conns.AWSClient).Macie2Client(ctx)
 
-	res, err := GetOrganizationAdminAccount(ctx, conn, d.Id())
+	res, err := findOrganizationAdminAccountByID(ctx, conn, d.Id())
 
-	if !d.IsNewResource() && (errs.IsA[*awstypes.ResourceNotFoundException](err) ||
-		errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "Macie is not enabled")) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
@@ -99,16 +102,6 @@ func resourceOrganizationAdminAccountRead(ctx context.Context, d *schema.Resourc
 		return sdkdiag.AppendErrorf(diags, "reading Macie OrganizationAdminAccount (%s): %s", d.Id(), err)
 	}
 
-	if res == nil {
-		if !d.IsNewResource() {
-			log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return diags
-		}
-
-		return sdkdiag.AppendFromErr(diags, &retry.NotFoundError{})
-	}
-
 	d.Set("admin_account_id", res.AccountId)
 
 	return diags
@@ -131,25 +124,10 @@ func resourceOrganizationAdminAccountDelete(ctx context.Context, d *schema.Resou
 		}
 		return sdkdiag.AppendErrorf(diags, "deleting Macie OrganizationAdminAccount (%s): %s", d.Id(), err)
 	}
-	return diags
-}
-
-func GetOrganizationAdminAccount(ctx context.Context, conn *macie2.Client, adminAccountID string) (*awstypes.AdminAccount, error) {
-	input := &macie2.ListOrganizationAdminAccountsInput{}
 
-	pages := macie2.NewListOrganizationAdminAccountsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, adminAccount := range page.AdminAccounts {
-			if aws.ToString(adminAccount.AccountId) == adminAccountID {
-				return &adminAccount, nil
-			}
-		}
+	if _, err := waitOrganizationAdminAccountDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Macie OrganizationAdminAccount (%s) delete: %s", d.Id(), err)
 	}
 
-	return nil, tfresource.NewEmptyResultError(input)
+	return diags
 }
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccOrganizationAdminAccountDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_macie2_organization_admin_account.test"
+	dataSourceName := "data.aws_macie2_organization_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationAdminAccountDestroy(ctx),
+		ErrorCheck:               testAccErrorCheckSkipOrganizationAdminAccount(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAdminAccountDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "admin_account_id", resourceName, "admin_account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, string(awstypes.AdminStatusEnabled)),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationAdminAccountDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccOrganizationAdminAccountConfig_basic(), `
+data "aws_macie2_organization_admin_account" "test" {
+  admin_account_id = aws_macie2_organization_admin_account.test.admin_account_id
+}
+`)
+}
@@ -8,13 +8,12 @@ import (
 	"fmt"
 	"testing"
 
-	awstypes "github.com/aws/aws-sdk-go-v2/service/macie2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccOrganizationAdminAccount_basic(t *testing.T) {
@@ -86,17 +85,9 @@ func testAccCheckOrganizationAdminAccountExists(ctx context.Context, resourceNam
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Client(ctx)
 
-		adminAccount, err := tfmacie2.GetOrganizationAdminAccount(ctx, conn, rs.Primary.ID)
+		_, err := tfmacie2.FindOrganizationAdminAccountByID(ctx, conn, rs.Primary.ID)
 
-		if err != nil {
-			return err
-		}
-
-		if adminAccount == nil {
-			return fmt.Errorf("macie OrganizationAdminAccount (%s) not found", rs.Primary.ID)
-		}
-
-		return nil
+		return err
 	}
 }
 
@@ -109,14 +100,9 @@ func testAccCheckOrganizationAdminAccountDestroy(ctx context.Context) resource.T
 				continue
 			}
 
-			adminAccount, err := tfmacie2.GetOrganizationAdminAccount(ctx, conn, rs.Primary.ID)
-
-			if errs.IsA[*awstypes.ResourceNotFoundException](err) ||
-				errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "Macie is not enabled") {
-				continue
-			}
+			_, err := tfmacie2.FindOrganizationAdminAccountByID(ctx, conn, rs.Primary.ID)
 
-			if adminAccount == nil {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
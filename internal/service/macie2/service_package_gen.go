@@ -23,7 +23,28 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceCustomDataIdentifiers,
+			TypeName: "aws_macie2_custom_data_identifiers",
+			Name:     "Custom Data Identifiers",
+		},
+		{
+			Factory:  dataSourceFindingStatistics,
+			TypeName: "aws_macie2_finding_statistics",
+			Name:     "Finding Statistics",
+		},
+		{
+			Factory:  dataSourceFindings,
+			TypeName: "aws_macie2_findings",
+			Name:     "Findings",
+		},
+		{
+			Factory:  dataSourceOrganizationAdminAccount,
+			TypeName: "aws_macie2_organization_admin_account",
+			Name:     "Organization Admin Account",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
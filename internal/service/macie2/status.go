@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/macie2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // statusMemberRelationship fetches the Member and its relationship status
@@ -26,3 +27,37 @@ func statusMemberRelationship(ctx context.Context, conn *macie2.Client, adminAcc
 		return adminAccount, string(adminAccount.RelationshipStatus), nil
 	}
 }
+
+// statusOrganizationAdminAccount fetches the delegated administrator account and its status
+func statusOrganizationAdminAccount(ctx context.Context, conn *macie2.Client, adminAccountID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findOrganizationAdminAccountByID(ctx, conn, adminAccountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+// statusClassificationJob fetches the classification job and its status
+func statusClassificationJob(ctx context.Context, conn *macie2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClassificationJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
@@ -16,8 +16,49 @@ import (
 const (
 	// Maximum amount of time to wait for the statusMemberRelationship to be Invited, Enabled, or Paused
 	memberInvitedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a delegated administrator account to propagate
+	organizationAdminAccountEnabledTimeout = 5 * time.Minute
+	// Maximum amount of time to wait for a delegated administrator account to be removed
+	organizationAdminAccountDeletedTimeout = 5 * time.Minute
 )
 
+// waitClassificationJobRunning waits for a classification job to be resumed
+func waitClassificationJobRunning(ctx context.Context, conn *macie2.Client, id string, timeout time.Duration) (*macie2.DescribeClassificationJobOutput, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.JobStatusUserPaused, awstypes.JobStatusPaused),
+		Target:  enum.Slice(awstypes.JobStatusRunning, awstypes.JobStatusIdle, awstypes.JobStatusComplete),
+		Refresh: statusClassificationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*macie2.DescribeClassificationJobOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitClassificationJobUserPaused waits for a classification job to be paused
+func waitClassificationJobUserPaused(ctx context.Context, conn *macie2.Client, id string, timeout time.Duration) (*macie2.DescribeClassificationJobOutput, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.JobStatusRunning, awstypes.JobStatusIdle, awstypes.JobStatusPaused),
+		Target:  enum.Slice(awstypes.JobStatusUserPaused),
+		Refresh: statusClassificationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*macie2.DescribeClassificationJobOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // waitMemberInvited waits for an AdminAccount to return Invited, Enabled and Paused
 func waitMemberInvited(ctx context.Context, conn *macie2.Client, adminAccountID string) (*awstypes.Member, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
@@ -35,3 +76,41 @@ func waitMemberInvited(ctx context.Context, conn *macie2.Client, adminAccountID
 
 	return nil, err
 }
+
+// waitOrganizationAdminAccountEnabled waits for a delegated administrator account to return Enabled
+func waitOrganizationAdminAccountEnabled(ctx context.Context, conn *macie2.Client, adminAccountID string) (*awstypes.AdminAccount, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    enum.Slice(awstypes.AdminStatusEnabled),
+		Refresh:                   statusOrganizationAdminAccount(ctx, conn, adminAccountID),
+		Timeout:                   organizationAdminAccountEnabledTimeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitOrganizationAdminAccountDeleted waits for a delegated administrator account to be removed
+func waitOrganizationAdminAccountDeleted(ctx context.Context, conn *macie2.Client, adminAccountID string) (*awstypes.AdminAccount, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AdminStatusEnabled, awstypes.AdminStatusDisablingInProgress),
+		Target:  []string{},
+		Refresh: statusOrganizationAdminAccount(ctx, conn, adminAccountID),
+		Timeout: organizationAdminAccountDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mailmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mailmanager_archive", name="Archive")
+// @Tags(identifierAttribute="arn")
+func newArchiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &archiveResource{}
+
+	return r, nil
+}
+
+type archiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*archiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mailmanager_archive"
+}
+
+func (r *archiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"archive_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 64),
+				},
+			},
+			"archive_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ArchiveState](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retention_period": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RetentionPeriod](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *archiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data archiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	name := data.ArchiveName.ValueString()
+	var input mailmanager.CreateArchiveInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Retention = data.expandRetention()
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateArchive(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Mail Manager Archive (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ArchiveID = fwflex.StringToFramework(ctx, output.ArchiveId)
+
+	archive, err := findArchiveByID(ctx, conn, data.ArchiveID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mail Manager Archive (%s)", data.ArchiveID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, archive)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *archiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data archiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	output, err := findArchiveByID(ctx, conn, data.ArchiveID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mail Manager Archive (%s)", data.ArchiveID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *archiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new archiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	if !new.ArchiveName.Equal(old.ArchiveName) || !new.RetentionPeriod.Equal(old.RetentionPeriod) {
+		input := mailmanager.UpdateArchiveInput{
+			ArchiveId:   new.ArchiveID.ValueStringPointer(),
+			ArchiveName: new.ArchiveName.ValueStringPointer(),
+			Retention:   new.expandRetention(),
+		}
+
+		_, err := conn.UpdateArchive(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Mail Manager Archive (%s)", new.ArchiveID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *archiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data archiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	input := mailmanager.DeleteArchiveInput{
+		ArchiveId: data.ArchiveID.ValueStringPointer(),
+	}
+	_, err := conn.DeleteArchive(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Mail Manager Archive (%s)", data.ArchiveID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *archiveResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findArchiveByID(ctx context.Context, conn *mailmanager.Client, id string) (*mailmanager.GetArchiveOutput, error) {
+	input := mailmanager.GetArchiveInput{
+		ArchiveId: aws.String(id),
+	}
+
+	output, err := conn.GetArchive(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deleted archives remain visible until they are purged.
+	if state := output.ArchiveState; state == awstypes.ArchiveStatePendingDeletion {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type archiveResourceModel struct {
+	ArchiveARN      types.String                                 `tfsdk:"arn"`
+	ArchiveID       types.String                                 `tfsdk:"id"`
+	ArchiveName     types.String                                 `tfsdk:"archive_name"`
+	ArchiveState    fwtypes.StringEnum[awstypes.ArchiveState]    `tfsdk:"archive_state"`
+	KMSKeyARN       fwtypes.ARN                                  `tfsdk:"kms_key_arn"`
+	RetentionPeriod fwtypes.StringEnum[awstypes.RetentionPeriod] `tfsdk:"retention_period"`
+	Tags            tftags.Map                                   `tfsdk:"tags"`
+	TagsAll         tftags.Map                                   `tfsdk:"tags_all"`
+}
+
+func (data *archiveResourceModel) expandRetention() awstypes.ArchiveRetention {
+	if data.RetentionPeriod.IsNull() || data.RetentionPeriod.IsUnknown() {
+		return nil
+	}
+
+	return &awstypes.ArchiveRetentionMemberRetentionPeriod{
+		Value: data.RetentionPeriod.ValueEnum(),
+	}
+}
+
+func (data *archiveResourceModel) flatten(ctx context.Context, output *mailmanager.GetArchiveOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, ok := output.Retention.(*awstypes.ArchiveRetentionMemberRetentionPeriod); ok {
+		data.RetentionPeriod = fwtypes.StringEnumValue(v.Value)
+	}
+
+	return diags
+}
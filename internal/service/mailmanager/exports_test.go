@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+// Exports for use in tests only.
+var (
+	ResourceAddonSubscription = newAddonSubscriptionResource
+	ResourceArchive           = newArchiveResource
+	ResourceIngressPoint      = newIngressPointResource
+	ResourceRuleSet           = newRuleSetResource
+	ResourceTrafficPolicy     = newTrafficPolicyResource
+
+	FindAddonSubscriptionByID = findAddonSubscriptionByID
+	FindArchiveByID           = findArchiveByID
+	FindIngressPointByID      = findIngressPointByID
+	FindRuleSetByID           = findRuleSetByID
+	FindTrafficPolicyByID     = findTrafficPolicyByID
+)
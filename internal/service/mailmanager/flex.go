@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// Mail Manager models most of its rule and policy conditions as unions.
+// Each union is represented as a block containing one nested block per member.
+
+// evaluateAttributeBlock returns the schema for an "evaluate" union whose only member is an email attribute.
+func evaluateAttributeBlock[T any, A enum.Valueser[A]](ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"attribute": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[A](),
+					Required:   true,
+				},
+			},
+		},
+	}
+}
+
+// expandUnionMember expands the single element of a nested block into the value of a union member.
+func expandUnionMember[T any](ctx context.Context, from fwtypes.ListNestedObjectValueOf[T], to any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data, d := from.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Expand(ctx, data, to)...)
+
+	return diags
+}
+
+// flattenUnionMember flattens the value of a union member into a single element nested block.
+func flattenUnionMember[T any](ctx context.Context, from any) (fwtypes.ListNestedObjectValueOf[T], diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model T
+
+	diags.Append(fwflex.Flatten(ctx, from, &model)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[T](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model), diags
+}
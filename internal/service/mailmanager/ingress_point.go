@@ -0,0 +1,544 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mailmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mailmanager_ingress_point", name="Ingress Point")
+// @Tags(identifierAttribute="arn")
+func newIngressPointResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &ingressPointResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type ingressPointResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*ingressPointResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mailmanager_ingress_point"
+}
+
+func (r *ingressPointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"a_record": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"ingress_point_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+				},
+			},
+			"rule_set_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IngressPointStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"traffic_policy_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IngressPointType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ingress_point_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ingressPointConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"secret_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("smtp_password")),
+							},
+						},
+						"smtp_password": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(8, 64),
+							},
+						},
+					},
+				},
+			},
+			"network_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[networkConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"private_network_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[privateNetworkConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("public_network_configuration")),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrVPCEndpointID: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"public_network_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[publicNetworkConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"ip_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.IpType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ingressPointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ingressPointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	name := data.IngressPointName.ValueString()
+	var input mailmanager.CreateIngressPointInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateIngressPoint(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Mail Manager Ingress Point (%s)", name), err.Error())
+
+		return
+	}
+
+	data.IngressPointID = fwflex.StringToFramework(ctx, output.IngressPointId)
+
+	ingressPoint, err := waitIngressPointCreated(ctx, conn, data.IngressPointID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.IngressPointID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mail Manager Ingress Point (%s) create", data.IngressPointID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, ingressPoint)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ingressPointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ingressPointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	output, err := findIngressPointByID(ctx, conn, data.IngressPointID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mail Manager Ingress Point (%s)", data.IngressPointID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ingressPointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new ingressPointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	diff, d := fwflex.Calculate(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input mailmanager.UpdateIngressPointInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Setting a password again creates a new password version, so only send the configuration when it changes.
+		if new.IngressPointConfiguration.Equal(old.IngressPointConfiguration) {
+			input.IngressPointConfiguration = nil
+		}
+
+		_, err := conn.UpdateIngressPoint(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Mail Manager Ingress Point (%s)", new.IngressPointID.ValueString()), err.Error())
+
+			return
+		}
+
+		ingressPoint, err := waitIngressPointUpdated(ctx, conn, new.IngressPointID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Mail Manager Ingress Point (%s) update", new.IngressPointID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(ingressPoint.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ingressPointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ingressPointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MailManagerClient(ctx)
+
+	input := mailmanager.DeleteIngressPointInput{
+		IngressPointId: data.IngressPointID.ValueStringPointer(),
+	}
+	_, err := conn.DeleteIngressPoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Mail Manager Ingress Point (%s)", data.IngressPointID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitIngressPointDeleted(ctx, conn, data.IngressPointID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mail Manager Ingress Point (%s) delete", data.IngressPointID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *ingressPointResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findIngressPointByID(ctx context.Context, conn *mailmanager.Client, id string) (*mailmanager.GetIngressPointOutput, error) {
+	input := mailmanager.GetIngressPointInput{
+		IngressPointId: aws.String(id),
+	}
+
+	output, err := conn.GetIngressPoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusIngressPoint(ctx context.Context, conn *mailmanager.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIngressPointByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIngressPointCreated(ctx context.Context, conn *mailmanager.Client, id string, timeout time.Duration) (*mailmanager.GetIngressPointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IngressPointStatusProvisioning),
+		Target:  enum.Slice(awstypes.IngressPointStatusActive),
+		Refresh: statusIngressPoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mailmanager.GetIngressPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIngressPointUpdated(ctx context.Context, conn *mailmanager.Client, id string, timeout time.Duration) (*mailmanager.GetIngressPointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IngressPointStatusUpdating),
+		Target:  enum.Slice(awstypes.IngressPointStatusActive, awstypes.IngressPointStatusClosed),
+		Refresh: statusIngressPoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mailmanager.GetIngressPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIngressPointDeleted(ctx context.Context, conn *mailmanager.Client, id string, timeout time.Duration) (*mailmanager.GetIngressPointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IngressPointStatusDeprovisioning, awstypes.IngressPointStatusActive, awstypes.IngressPointStatusClosed),
+		Target:  []string{},
+		Refresh: statusIngressPoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*mailmanager.GetIngressPointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type ingressPointResourceModel struct {
+	ARecord                   types.String                                                    `tfsdk:"a_record"`
+	IngressPointARN           types.String                                                    `tfsdk:"arn"`
+	IngressPointConfiguration fwtypes.ListNestedObjectValueOf[ingressPointConfigurationModel] `tfsdk:"ingress_point_configuration"`
+	IngressPointID            types.String                                                    `tfsdk:"id"`
+	IngressPointName          types.String                                                    `tfsdk:"ingress_point_name"`
+	NetworkConfiguration      fwtypes.ListNestedObjectValueOf[networkConfigurationModel]      `tfsdk:"network_configuration"`
+	RuleSetID                 types.String                                                    `tfsdk:"rule_set_id"`
+	Status                    fwtypes.StringEnum[awstypes.IngressPointStatus]                 `tfsdk:"status"`
+	Tags                      tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                  `tfsdk:"timeouts"`
+	TrafficPolicyID           types.String                                                    `tfsdk:"traffic_policy_id"`
+	Type                      fwtypes.StringEnum[awstypes.IngressPointType]                   `tfsdk:"type"`
+}
+
+func (data *ingressPointResourceModel) flatten(ctx context.Context, output *mailmanager.GetIngressPointOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	networkConfiguration := data.NetworkConfiguration
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API returns a public IPv4 network configuration when none is configured.
+	if networkConfiguration.IsNull() {
+		if v, ok := output.NetworkConfiguration.(*awstypes.NetworkConfigurationMemberPublicNetworkConfiguration); ok && v.Value.IpType == awstypes.IpTypeIpv4 {
+			data.NetworkConfiguration = networkConfiguration
+		}
+	}
+
+	return diags
+}
+
+type ingressPointConfigurationModel struct {
+	SecretARN    fwtypes.ARN  `tfsdk:"secret_arn"`
+	SMTPPassword types.String `tfsdk:"smtp_password"`
+}
+
+var (
+	_ fwflex.Expander = ingressPointConfigurationModel{}
+)
+
+func (m ingressPointConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.SecretARN.IsNull():
+		return &awstypes.IngressPointConfigurationMemberSecretArn{Value: m.SecretARN.ValueString()}, diags
+
+	case !m.SMTPPassword.IsNull():
+		return &awstypes.IngressPointConfigurationMemberSmtpPassword{Value: m.SMTPPassword.ValueString()}, diags
+	}
+
+	return nil, diags
+}
+
+type networkConfigurationModel struct {
+	PrivateNetworkConfiguration fwtypes.ListNestedObjectValueOf[privateNetworkConfigurationModel] `tfsdk:"private_network_configuration"`
+	PublicNetworkConfiguration  fwtypes.ListNestedObjectValueOf[publicNetworkConfigurationModel]  `tfsdk:"public_network_configuration"`
+}
+
+var (
+	_ fwflex.Expander  = networkConfigurationModel{}
+	_ fwflex.Flattener = &networkConfigurationModel{}
+)
+
+func (m networkConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.PrivateNetworkConfiguration.IsNull():
+		var r awstypes.NetworkConfigurationMemberPrivateNetworkConfiguration
+		diags.Append(expandUnionMember(ctx, m.PrivateNetworkConfiguration, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.PublicNetworkConfiguration.IsNull():
+		var r awstypes.NetworkConfigurationMemberPublicNetworkConfiguration
+		diags.Append(expandUnionMember(ctx, m.PublicNetworkConfiguration, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *networkConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.NetworkConfigurationMemberPrivateNetworkConfiguration:
+		m.PrivateNetworkConfiguration, diags = flattenUnionMember[privateNetworkConfigurationModel](ctx, t.Value)
+	case awstypes.NetworkConfigurationMemberPublicNetworkConfiguration:
+		m.PublicNetworkConfiguration, diags = flattenUnionMember[publicNetworkConfigurationModel](ctx, t.Value)
+	}
+
+	return diags
+}
+
+type privateNetworkConfigurationModel struct {
+	VPCEndpointID types.String `tfsdk:"vpc_endpoint_id"`
+}
+
+type publicNetworkConfigurationModel struct {
+	IPType fwtypes.StringEnum[awstypes.IpType] `tfsdk:"ip_type"`
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMailManagerIngressPoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetIngressPointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_ingress_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngressPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngressPointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "a_record"),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ses", regexache.MustCompile(`mailmanager-ingress-point/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule_set_id", "aws_mailmanager_rule_set.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_id", "aws_mailmanager_traffic_policy.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "OPEN"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerIngressPoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetIngressPointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_ingress_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngressPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngressPointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmailmanager.ResourceIngressPoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerIngressPoint_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetIngressPointOutput
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_ingress_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngressPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngressPointConfig_auth(rName1, rName1, "Password123!"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "AUTH"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ingress_point_configuration"},
+			},
+			{
+				Config: testAccIngressPointConfig_auth(rName1, rName2, "Password456!"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_point_name", rName2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMailManagerIngressPoint_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetIngressPointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_ingress_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngressPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngressPointConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIngressPointConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccIngressPointConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngressPointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIngressPointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mailmanager_ingress_point" {
+				continue
+			}
+
+			_, err := tfmailmanager.FindIngressPointByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Mail Manager Ingress Point %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIngressPointExists(ctx context.Context, n string, v *mailmanager.GetIngressPointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		output, err := tfmailmanager.FindIngressPointByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIngressPointConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    action {
+      drop {}
+    }
+  }
+}
+
+resource "aws_mailmanager_traffic_policy" "test" {
+  traffic_policy_name = %[1]q
+  default_action      = "DENY"
+
+  policy_statement {
+    action = "ALLOW"
+
+    condition {
+      string_expression {
+        operator = "ENDS_WITH"
+        values   = ["example.com"]
+
+        evaluate {
+          attribute = "RECIPIENT"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccIngressPointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIngressPointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mailmanager_ingress_point" "test" {
+  ingress_point_name = %[1]q
+  rule_set_id        = aws_mailmanager_rule_set.test.id
+  traffic_policy_id  = aws_mailmanager_traffic_policy.test.id
+  type               = "OPEN"
+}
+`, rName))
+}
+
+func testAccIngressPointConfig_auth(rName, ingressPointName, password string) string {
+	return acctest.ConfigCompose(testAccIngressPointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mailmanager_ingress_point" "test" {
+  ingress_point_name = %[1]q
+  rule_set_id        = aws_mailmanager_rule_set.test.id
+  traffic_policy_id  = aws_mailmanager_traffic_policy.test.id
+  type               = "AUTH"
+
+  ingress_point_configuration {
+    smtp_password = %[2]q
+  }
+}
+`, ingressPointName, password))
+}
+
+func testAccIngressPointConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccIngressPointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mailmanager_ingress_point" "test" {
+  ingress_point_name = %[1]q
+  rule_set_id        = aws_mailmanager_rule_set.test.id
+  traffic_policy_id  = aws_mailmanager_traffic_policy.test.id
+  type               = "OPEN"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccIngressPointConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccIngressPointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mailmanager_ingress_point" "test" {
+  ingress_point_name = %[1]q
+  rule_set_id        = aws_mailmanager_rule_set.test.id
+  traffic_policy_id  = aws_mailmanager_traffic_policy.test.id
+  type               = "OPEN"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMailManagerRuleSet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetRuleSetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_rule_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleSetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ses", regexache.MustCompile(`mailmanager-rule-set/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.drop.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.condition.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "drop-all"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.unless.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerRuleSet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetRuleSetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_rule_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmailmanager.ResourceRuleSet, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerRuleSet_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetRuleSetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_rule_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleSetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccRuleSetConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.add_header.0.header_name", "X-Test-Header"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action.0.add_header.0.header_value", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "rule.0.action.1.archive.0.target_archive", "aws_mailmanager_archive.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "rule.0.action.1.archive.0.action_failure_policy"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.condition.0.string_expression.0.evaluate.0.attribute", "SUBJECT"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.condition.0.string_expression.0.operator", "CONTAINS"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.condition.0.string_expression.0.values.0", "test"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.unless.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.unless.0.number_expression.0.evaluate.0.attribute", "MESSAGE_SIZE"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.unless.0.number_expression.0.operator", "GREATER_THAN"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.unless.0.number_expression.0.value", "1048576"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action.0.drop.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerRuleSet_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetRuleSetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_rule_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleSetConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleSetConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccRuleSetConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRuleSetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mailmanager_rule_set" {
+				continue
+			}
+
+			_, err := tfmailmanager.FindRuleSetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Mail Manager Rule Set %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRuleSetExists(ctx context.Context, n string, v *mailmanager.GetRuleSetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		output, err := tfmailmanager.FindRuleSetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRuleSetConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    name = "drop-all"
+
+    action {
+      drop {}
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRuleSetConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_archive" "test" {
+  archive_name = %[1]q
+}
+
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    name = "archive-tests"
+
+    action {
+      add_header {
+        header_name  = "X-Test-Header"
+        header_value = "true"
+      }
+    }
+
+    action {
+      archive {
+        target_archive = aws_mailmanager_archive.test.id
+      }
+    }
+
+    condition {
+      string_expression {
+        operator = "CONTAINS"
+        values   = ["test"]
+
+        evaluate {
+          attribute = "SUBJECT"
+        }
+      }
+    }
+
+    unless {
+      number_expression {
+        operator = "GREATER_THAN"
+        value    = 1048576
+
+        evaluate {
+          attribute = "MESSAGE_SIZE"
+        }
+      }
+    }
+  }
+
+  rule {
+    name = "drop-all"
+
+    action {
+      drop {}
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRuleSetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    name = "drop-all"
+
+    action {
+      drop {}
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccRuleSetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_rule_set" "test" {
+  rule_set_name = %[1]q
+
+  rule {
+    name = "drop-all"
+
+    action {
+      drop {}
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
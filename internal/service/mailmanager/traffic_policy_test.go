@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mailmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mailmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmailmanager "github.com/hashicorp/terraform-provider-aws/internal/service/mailmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMailManagerTrafficPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetTrafficPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ses", regexache.MustCompile(`mailmanager-traffic-policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_action", "DENY"),
+					resource.TestCheckNoResourceAttr(resourceName, "max_message_size_bytes"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.action", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.string_expression.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.string_expression.0.evaluate.0.attribute", "RECIPIENT"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.string_expression.0.operator", "ENDS_WITH"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.string_expression.0.values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.string_expression.0.values.0", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerTrafficPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetTrafficPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmailmanager.ResourceTrafficPolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerTrafficPolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetTrafficPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_action", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.#", "1"),
+				),
+			},
+			{
+				Config: testAccTrafficPolicyConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_action", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "max_message_size_bytes", "1048576"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.action", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.ip_expression.0.evaluate.0.attribute", "SENDER_IP"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.ip_expression.0.operator", "CIDR_MATCHES"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.0.condition.0.ip_expression.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.1.action", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.1.condition.0.tls_expression.0.evaluate.0.attribute", "TLS_PROTOCOL"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.1.condition.0.tls_expression.0.operator", "MINIMUM_TLS_VERSION"),
+					resource.TestCheckResourceAttr(resourceName, "policy_statement.1.condition.0.tls_expression.0.value", "TLS1_2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMailManagerTrafficPolicy_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mailmanager.GetTrafficPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mailmanager_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.MailManagerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MailManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrafficPolicyConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccTrafficPolicyConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTrafficPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mailmanager_traffic_policy" {
+				continue
+			}
+
+			_, err := tfmailmanager.FindTrafficPolicyByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Mail Manager Traffic Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTrafficPolicyExists(ctx context.Context, n string, v *mailmanager.GetTrafficPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MailManagerClient(ctx)
+
+		output, err := tfmailmanager.FindTrafficPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTrafficPolicyConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_traffic_policy" "test" {
+  traffic_policy_name = %[1]q
+  default_action      = "DENY"
+
+  policy_statement {
+    action = "ALLOW"
+
+    condition {
+      string_expression {
+        operator = "ENDS_WITH"
+        values   = ["example.com"]
+
+        evaluate {
+          attribute = "RECIPIENT"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccTrafficPolicyConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_traffic_policy" "test" {
+  traffic_policy_name    = %[1]q
+  default_action         = "ALLOW"
+  max_message_size_bytes = 1048576
+
+  policy_statement {
+    action = "DENY"
+
+    condition {
+      ip_expression {
+        operator = "CIDR_MATCHES"
+        values   = ["192.0.2.0/24", "198.51.100.0/24"]
+
+        evaluate {
+          attribute = "SENDER_IP"
+        }
+      }
+    }
+  }
+
+  policy_statement {
+    action = "DENY"
+
+    condition {
+      tls_expression {
+        operator = "MINIMUM_TLS_VERSION"
+        value    = "TLS1_2"
+
+        evaluate {
+          attribute = "TLS_PROTOCOL"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccTrafficPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_traffic_policy" "test" {
+  traffic_policy_name = %[1]q
+  default_action      = "DENY"
+
+  policy_statement {
+    action = "ALLOW"
+
+    condition {
+      string_expression {
+        operator = "ENDS_WITH"
+        values   = ["example.com"]
+
+        evaluate {
+          attribute = "RECIPIENT"
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccTrafficPolicyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mailmanager_traffic_policy" "test" {
+  traffic_policy_name = %[1]q
+  default_action      = "DENY"
+
+  policy_statement {
+    action = "ALLOW"
+
+    condition {
+      string_expression {
+        operator = "ENDS_WITH"
+        values   = ["example.com"]
+
+        evaluate {
+          attribute = "RECIPIENT"
+        }
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -390,6 +390,13 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) create: %s", d.Id(), err)
 	}
 
+	// Wait for the cluster to be reported as an available member of the multi-region cluster.
+	if v, ok := d.GetOk("multi_region_cluster_name"); ok {
+		if _, err := waitMultiRegionClusterMemberAvailable(ctx, conn, v.(string), meta.(*conns.AWSClient).Region(ctx), d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) Multi-Region Cluster (%s) membership create: %s", d.Id(), v.(string), err)
+		}
+	}
+
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
@@ -603,6 +610,13 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) delete: %s", d.Id(), err)
 	}
 
+	// The multi-region cluster cannot be deleted until the cluster's membership has been removed.
+	if input.MultiRegionClusterName != nil {
+		if _, err := waitMultiRegionClusterMemberDeleted(ctx, conn, aws.ToString(input.MultiRegionClusterName), meta.(*conns.AWSClient).Region(ctx), d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MemoryDB Cluster (%s) Multi-Region Cluster (%s) membership delete: %s", d.Id(), aws.ToString(input.MultiRegionClusterName), err)
+		}
+	}
+
 	return diags
 }
 
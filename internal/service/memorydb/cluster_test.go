@@ -274,6 +274,7 @@ func TestAccMemoryDBCluster_multiRegionClusterName(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(ctx, resourceName),
 					resource.TestCheckResourceAttrPair(resourceName, "multi_region_cluster_name", multiRegionClusterResourceName, "multi_region_cluster_name"),
+					testAccCheckClusterMultiRegionClusterMemberExists(ctx, resourceName),
 				),
 			},
 			{
@@ -1164,6 +1165,21 @@ func testAccCheckClusterExists(ctx context.Context, n string) resource.TestCheck
 	}
 }
 
+func testAccCheckClusterMultiRegionClusterMemberExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBClient(ctx)
+
+		_, err := tfmemorydb.FindMultiRegionClusterMember(ctx, conn, rs.Primary.Attributes["multi_region_cluster_name"], acctest.Region(), rs.Primary.Attributes[names.AttrName])
+
+		return err
+	}
+}
+
 func testAccCheckSnapshotExistsByName(ctx context.Context, snapshotName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBClient(ctx)
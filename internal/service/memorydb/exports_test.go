@@ -16,6 +16,7 @@ var (
 	FindACLByName                = findACLByName
 	FindClusterByName            = findClusterByName
 	FindMultiRegionClusterByName = findMultiRegionClusterByName
+	FindMultiRegionClusterMember = findMultiRegionClusterMemberByThreePartKey
 	FindParameterGroupByName     = findParameterGroupByName
 	FindSnapshotByName           = findSnapshotByName
 	FindSubnetGroupByName        = findSubnetGroupByName
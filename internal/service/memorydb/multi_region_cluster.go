@@ -378,6 +378,7 @@ func (r *multiRegionClusterResource) Delete(ctx context.Context, req resource.De
 			create.ProblemStandardMessage(names.MemoryDB, create.ErrActionDeleting, ResNameMultiRegionCluster, state.MultiRegionClusterName.String(), err),
 			err.Error(),
 		)
+		return
 	}
 
 	if aws.ToString(output.Status) != clusterStatusAvailable {
@@ -488,6 +489,24 @@ func findMultiRegionClusters(ctx context.Context, conn *memorydb.Client, input *
 	return output, nil
 }
 
+// findMultiRegionClusterMemberByThreePartKey returns the Regional cluster membership of a
+// multi-Region cluster for the specified Region and cluster name.
+func findMultiRegionClusterMemberByThreePartKey(ctx context.Context, conn *memorydb.Client, multiRegionClusterName, region, clusterName string) (*awstypes.RegionalCluster, error) {
+	output, err := findMultiRegionClusterByName(ctx, conn, multiRegionClusterName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.Clusters {
+		if aws.ToString(v.Region) == region && aws.ToString(v.ClusterName) == clusterName {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
 func updateMultiRegionClusterAndWaitAvailable(ctx context.Context, conn *memorydb.Client, input *memorydb.UpdateMultiRegionClusterInput, timeout time.Duration) error {
 	if _, err := conn.UpdateMultiRegionCluster(ctx, input); err != nil {
 		return err
@@ -513,6 +532,22 @@ func statusMultiRegionCluster(ctx context.Context, conn *memorydb.Client, name s
 	}
 }
 
+func statusMultiRegionClusterMember(ctx context.Context, conn *memorydb.Client, multiRegionClusterName, region, clusterName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findMultiRegionClusterMemberByThreePartKey(ctx, conn, multiRegionClusterName, region, clusterName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
 func waitMultiRegionClusterAvailable(ctx context.Context, conn *memorydb.Client, name string, timeout time.Duration) (*awstypes.MultiRegionCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Delay:                     20 * time.Second,
@@ -551,6 +586,44 @@ func waitMultiRegionClusterDeleted(ctx context.Context, conn *memorydb.Client, n
 	return nil, err
 }
 
+func waitMultiRegionClusterMemberAvailable(ctx context.Context, conn *memorydb.Client, multiRegionClusterName, region, clusterName string, timeout time.Duration) (*awstypes.RegionalCluster, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Delay:                     20 * time.Second,
+		Pending:                   []string{clusterStatusCreating, clusterStatusUpdating, clusterStatusSnapshotting},
+		Target:                    []string{clusterStatusAvailable},
+		Refresh:                   statusMultiRegionClusterMember(ctx, conn, multiRegionClusterName, region, clusterName),
+		ContinuousTargetOccurence: 2,
+		Timeout:                   timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.RegionalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMultiRegionClusterMemberDeleted(ctx context.Context, conn *memorydb.Client, multiRegionClusterName, region, clusterName string, timeout time.Duration) (*awstypes.RegionalCluster, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Delay:                     20 * time.Second,
+		Pending:                   []string{clusterStatusAvailable, clusterStatusDeleting, clusterStatusUpdating, clusterStatusSnapshotting},
+		Target:                    []string{},
+		Refresh:                   statusMultiRegionClusterMember(ctx, conn, multiRegionClusterName, region, clusterName),
+		ContinuousTargetOccurence: 2,
+		Timeout:                   timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.RegionalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 // suffixAfterHyphen extracts the substring after the first hyphen ("-") in the input string.
 // If no hyphen is found, it returns an error.
 func suffixAfterHyphen(input string) (string, error) {
@@ -16,6 +16,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/neptune/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -315,7 +316,27 @@ func resourceCluster() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+				v, ok := diff.GetOk("serverless_v2_scaling_configuration")
+				if !ok {
+					return nil
+				}
+
+				tfList := v.([]interface{})
+				if len(tfList) == 0 || tfList[0] == nil {
+					return nil
+				}
+
+				tfMap := tfList[0].(map[string]interface{})
+				if minCapacity, maxCapacity := tfMap["min_capacity"].(float64), tfMap[names.AttrMaxCapacity].(float64); minCapacity > maxCapacity {
+					return fmt.Errorf("serverless_v2_scaling_configuration: min_capacity (%g) must be less than or equal to max_capacity (%g)", minCapacity, maxCapacity)
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
@@ -655,6 +676,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.PreferredMaintenanceWindow = aws.String(d.Get(names.AttrPreferredMaintenanceWindow).(string))
 		}
 
+		// Serverless v2 capacity changes are applied immediately, regardless of apply_immediately.
 		if d.HasChange("serverless_v2_scaling_configuration") {
 			input.ServerlessV2ScalingConfiguration = expandServerlessConfiguration(d.Get("serverless_v2_scaling_configuration").([]interface{}))
 		}
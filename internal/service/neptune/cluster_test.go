@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -274,6 +275,24 @@ func TestAccNeptuneCluster_serverlessConfiguration(t *testing.T) {
 				),
 			},
 			testAccClusterImportStep(resourceName),
+			{
+				Config: testAccClusterConfig_serverlessConfigurationCapacity(rName, 1.0, 64),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.min_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "serverless_v2_scaling_configuration.0.max_capacity", "64"),
+				),
+			},
+			{
+				Config:      testAccClusterConfig_serverlessConfigurationCapacity(rName, 16, 8),
+				ExpectError: regexache.MustCompile(`min_capacity \(16\) must be less than or equal to max_capacity \(8\)`),
+			},
 		},
 	})
 }
@@ -884,6 +903,21 @@ resource "aws_neptune_cluster" "test" {
 `, rName)
 }
 
+func testAccClusterConfig_serverlessConfigurationCapacity(rName string, minCapacity, maxCapacity float64) string {
+	return fmt.Sprintf(`
+resource "aws_neptune_cluster" "test" {
+  cluster_identifier_prefix = %[1]q
+  engine                    = "neptune"
+  skip_final_snapshot       = true
+
+  serverless_v2_scaling_configuration {
+    min_capacity = %[2]g
+    max_capacity = %[3]g
+  }
+}
+`, rName, minCapacity, maxCapacity)
+}
+
 func testAccClusterConfig_finalSnapshot(rName string) string {
 	return acctest.ConfigCompose(testAccClusterConfig_base(), fmt.Sprintf(`
 resource "aws_neptune_cluster" "test" {
@@ -86,14 +86,28 @@ const (
 )
 
 const (
-	globalClusterStatusAvailable = "available"
-	globalClusterStatusCreating  = "creating"
-	globalClusterStatusDeleted   = "deleted"
-	globalClusterStatusDeleting  = "deleting"
-	globalClusterStatusModifying = "modifying"
-	globalClusterStatusUpgrading = "upgrading"
+	globalClusterStatusAvailable     = "available"
+	globalClusterStatusCreating      = "creating"
+	globalClusterStatusDeleted       = "deleted"
+	globalClusterStatusDeleting      = "deleting"
+	globalClusterStatusFailingOver   = "failing-over"
+	globalClusterStatusModifying     = "modifying"
+	globalClusterStatusSwitchingOver = "switching-over"
+	globalClusterStatusUpgrading     = "upgrading"
 )
 
+const (
+	globalClusterFailoverTypeFailover   = "failover"
+	globalClusterFailoverTypeSwitchover = "switchover"
+)
+
+func globalClusterFailoverType_Values() []string {
+	return []string{
+		globalClusterFailoverTypeFailover,
+		globalClusterFailoverTypeSwitchover,
+	}
+}
+
 const (
 	clusterEndpointTypeAny    = "ANY"
 	clusterEndpointTypeReader = "READER"
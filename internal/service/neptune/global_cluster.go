@@ -5,8 +5,10 @@ package neptune
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -21,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -67,6 +70,11 @@ func resourceGlobalCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"failover_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(globalClusterFailoverType_Values(), false),
+			},
 			"global_cluster_identifier": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -93,6 +101,12 @@ func resourceGlobalCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"primary_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"source_db_cluster_identifier": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -185,6 +199,7 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting global_cluster_members: %s", err)
 	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
+	d.Set("primary_db_cluster_arn", findGlobalClusterWriterARN(globalCluster))
 	d.Set(names.AttrStorageEncrypted, globalCluster.StorageEncrypted)
 
 	return diags
@@ -255,6 +270,14 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("primary_db_cluster_arn") {
+		if v := d.Get("primary_db_cluster_arn").(string); v != "" {
+			if err := globalClusterChangeWriter(ctx, conn, d.Id(), v, d.Get("failover_type").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
 	return append(diags, resourceGlobalClusterRead(ctx, d, meta)...)
 }
 
@@ -375,6 +398,68 @@ func findGlobalClusters(ctx context.Context, conn *neptune.Client, input *neptun
 	return output, nil
 }
 
+// globalClusterChangeWriter makes the specified member cluster the primary (writer) cluster of the Neptune Global Cluster,
+// either via a managed switchover (the default) or via an unplanned failover that allows data loss.
+func globalClusterChangeWriter(ctx context.Context, conn *neptune.Client, globalClusterID, clusterARN, failoverType string, timeout time.Duration) error {
+	globalCluster, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading Neptune Global Cluster (%s): %w", globalClusterID, err)
+	}
+
+	if findGlobalClusterWriterARN(globalCluster) == clusterARN {
+		return nil
+	}
+
+	if !slices.ContainsFunc(globalCluster.GlobalClusterMembers, func(v awstypes.GlobalClusterMember) bool {
+		return aws.ToString(v.DBClusterArn) == clusterARN
+	}) {
+		return fmt.Errorf("changing Neptune Global Cluster (%s) primary cluster to %s: not a member of the Global Cluster", globalClusterID, clusterARN)
+	}
+
+	switch failoverType {
+	case globalClusterFailoverTypeFailover:
+		input := &neptune.FailoverGlobalClusterInput{
+			AllowDataLoss:             aws.Bool(true),
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(clusterARN),
+		}
+
+		_, err = conn.FailoverGlobalCluster(ctx, input)
+	default:
+		input := &neptune.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(clusterARN),
+		}
+
+		_, err = conn.SwitchoverGlobalCluster(ctx, input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("changing Neptune Global Cluster (%s) primary cluster to %s: %w", globalClusterID, clusterARN, err)
+	}
+
+	if _, err := waitGlobalClusterMemberPromoted(ctx, conn, globalClusterID, clusterARN, timeout); err != nil {
+		return fmt.Errorf("waiting for Neptune Global Cluster (%s) member (%s) promotion: %w", globalClusterID, clusterARN, err)
+	}
+
+	if _, err := waitGlobalClusterFailedOver(ctx, conn, globalClusterID, timeout); err != nil {
+		return fmt.Errorf("waiting for Neptune Global Cluster (%s) primary cluster change: %w", globalClusterID, err)
+	}
+
+	return nil
+}
+
+func findGlobalClusterWriterARN(globalCluster *awstypes.GlobalCluster) string {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.ToBool(v.IsWriter) {
+			return aws.ToString(v.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
 func statusGlobalCluster(ctx context.Context, conn *neptune.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findGlobalClusterByID(ctx, conn, id)
@@ -426,6 +511,57 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *neptune.Client, id stri
 	return nil, err
 }
 
+func statusGlobalClusterMemberIsWriter(ctx context.Context, conn *neptune.Client, globalClusterID, clusterARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strconv.FormatBool(findGlobalClusterWriterARN(output) == clusterARN), nil
+	}
+}
+
+func waitGlobalClusterMemberPromoted(ctx context.Context, conn *neptune.Client, globalClusterID, clusterARN string, timeout time.Duration) (*awstypes.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{strconv.FormatBool(false)},
+		Target:  []string{strconv.FormatBool(true)},
+		Refresh: statusGlobalClusterMemberIsWriter(ctx, conn, globalClusterID, clusterARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlobalClusterFailedOver(ctx context.Context, conn *neptune.Client, id string, timeout time.Duration) (*awstypes.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{globalClusterStatusFailingOver, globalClusterStatusModifying, globalClusterStatusSwitchingOver},
+		Target:  []string{globalClusterStatusAvailable},
+		Refresh: statusGlobalCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitGlobalClusterDeleted(ctx context.Context, conn *neptune.Client, id string, timeout time.Duration) (*awstypes.GlobalCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        []string{globalClusterStatusAvailable, globalClusterStatusDeleting},
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -315,6 +316,66 @@ func TestAccNeptuneGlobalCluster_StorageEncrypted(t *testing.T) {
 	})
 }
 
+func TestAccNeptuneGlobalCluster_primaryDBClusterARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalCluster1, globalCluster2, globalCluster3 awstypes.GlobalCluster
+	rNameGlobal := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePrimary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameSecondary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptune_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckGlobalCluster(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NeptuneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckGlobalClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_primaryDBClusterARN(rNameGlobal, rNamePrimary, rNameSecondary, "primary", "switchover"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster1),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_db_cluster_arn", "aws_neptune_cluster.primary", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_primaryDBClusterARN(rNameGlobal, rNamePrimary, rNameSecondary, "secondary", "switchover"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster2),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster1, &globalCluster2),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_db_cluster_arn", "aws_neptune_cluster.secondary", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_primaryDBClusterARN(rNameGlobal, rNamePrimary, rNameSecondary, "primary", "failover"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster3),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster2, &globalCluster3),
+					resource.TestCheckResourceAttr(resourceName, "failover_type", "failover"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_db_cluster_arn", "aws_neptune_cluster.primary", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGlobalClusterExists(ctx context.Context, n string, v *awstypes.GlobalCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -542,3 +603,73 @@ resource "aws_neptune_global_cluster" "test" {
 }
 `, rName, storageEncrypted)
 }
+
+func testAccGlobalClusterConfig_primaryDBClusterARN(rNameGlobal, rNamePrimary, rNameSecondary, primary, failoverType string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+locals {
+  # Cluster ARNs are constructed to avoid a dependency cycle between the global cluster and its members.
+  cluster_arns = {
+    primary   = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:cluster:%[2]s"
+    secondary = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.alternate.name}:${data.aws_caller_identity.current.account_id}:cluster:%[3]s"
+  }
+}
+
+resource "aws_neptune_global_cluster" "test" {
+  global_cluster_identifier = %[1]q
+  engine                    = "neptune"
+  engine_version            = "1.2.0.0"
+  primary_db_cluster_arn    = local.cluster_arns[%[4]q]
+  failover_type             = %[5]q
+}
+
+resource "aws_neptune_cluster" "primary" {
+  cluster_identifier                   = %[2]q
+  engine                               = aws_neptune_global_cluster.test.engine
+  engine_version                       = aws_neptune_global_cluster.test.engine_version
+  global_cluster_identifier            = aws_neptune_global_cluster.test.id
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+  skip_final_snapshot                  = true
+}
+
+resource "aws_neptune_cluster_instance" "primary" {
+  cluster_identifier           = aws_neptune_cluster.primary.id
+  engine                       = aws_neptune_cluster.primary.engine
+  engine_version               = aws_neptune_cluster.primary.engine_version
+  instance_class               = "db.r5.large"
+  neptune_parameter_group_name = "default.neptune1.2"
+}
+
+resource "aws_neptune_cluster" "secondary" {
+  provider = "awsalternate"
+
+  cluster_identifier                   = %[3]q
+  engine                               = aws_neptune_global_cluster.test.engine
+  engine_version                       = aws_neptune_global_cluster.test.engine_version
+  global_cluster_identifier            = aws_neptune_global_cluster.test.id
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+  skip_final_snapshot                  = true
+
+  depends_on = [aws_neptune_cluster_instance.primary]
+}
+
+resource "aws_neptune_cluster_instance" "secondary" {
+  provider = "awsalternate"
+
+  cluster_identifier           = aws_neptune_cluster.secondary.id
+  engine                       = aws_neptune_cluster.secondary.engine
+  engine_version               = aws_neptune_cluster.secondary.engine_version
+  instance_class               = "db.r5.large"
+  neptune_parameter_group_name = "default.neptune1.2"
+}
+`, rNameGlobal, rNamePrimary, rNameSecondary, primary, failoverType))
+}
@@ -226,6 +226,11 @@ func resourceFirewallPolicy() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("firewall_policy.0.stateful_engine_options.0.rule_order", d)
 			},
+			// A TLS inspection configuration can only be added to a new policy and cannot be removed,
+			// though an attached configuration can be swapped for another in place.
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				return forceNewIfTLSInspectionConfigurationAddedOrRemoved("firewall_policy.0.tls_inspection_configuration_arn", d)
+			},
 			verify.SetTagsDiff,
 		),
 	}
@@ -267,6 +267,18 @@ func forceNewIfNotRuleOrderDefault(key string, d *schema.ResourceDiff) error {
 	return nil
 }
 
+func forceNewIfTLSInspectionConfigurationAddedOrRemoved(key string, d *schema.ResourceDiff) error {
+	if d.Id() != "" && d.HasChange(key) {
+		old, new := d.GetChange(key)
+
+		if (old.(string) == "") != (new.(string) == "") {
+			return d.ForceNew(key)
+		}
+	}
+
+	return nil
+}
+
 func expandIPSets(tfList []interface{}) map[string]awstypes.IPSet {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
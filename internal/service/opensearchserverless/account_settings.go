@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Minimum capacity, in OCUs, that can be configured for indexing or search.
+	// See https://docs.aws.amazon.com/opensearch-service/latest/developerguide/serverless-scaling.html.
+	minCapacityInOCU = 2
+	// Default maximum capacity, in OCUs, for indexing and search.
+	defaultMaxCapacityInOCU = 10
+)
+
+// @FrameworkResource("aws_opensearchserverless_account_settings", name="Account Settings")
+func newAccountSettingsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &accountSettingsResource{}, nil
+}
+
+type accountSettingsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*accountSettingsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_opensearchserverless_account_settings"
+}
+
+func (r *accountSettingsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_limits": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[capacityLimitsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_indexing_capacity_in_ocu": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(minCapacityInOCU),
+							},
+						},
+						"max_search_capacity_in_ocu": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(minCapacityInOCU),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *accountSettingsResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("capacity_limits").AtAnyListIndex().AtName("max_indexing_capacity_in_ocu"),
+			path.MatchRoot("capacity_limits").AtAnyListIndex().AtName("max_search_capacity_in_ocu"),
+		),
+	}
+}
+
+func (r *accountSettingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data accountSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	input := &opensearchserverless.UpdateAccountSettingsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateAccountSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating OpenSearch Serverless Account Settings", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.AccountSettingsDetail, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ID = fwflex.StringValueToFramework(ctx, r.Meta().Region(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *accountSettingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data accountSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	output, err := findAccountSettings(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading OpenSearch Serverless Account Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *accountSettingsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new accountSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	input := &opensearchserverless.UpdateAccountSettingsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.UpdateAccountSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating OpenSearch Serverless Account Settings (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.AccountSettingsDetail, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// Delete resets the capacity limits to the account defaults.
+func (r *accountSettingsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data accountSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().OpenSearchServerlessClient(ctx)
+
+	input := &opensearchserverless.UpdateAccountSettingsInput{
+		CapacityLimits: &awstypes.CapacityLimits{
+			MaxIndexingCapacityInOCU: aws.Int32(defaultMaxCapacityInOCU),
+			MaxSearchCapacityInOCU:   aws.Int32(defaultMaxCapacityInOCU),
+		},
+	}
+
+	_, err := conn.UpdateAccountSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting OpenSearch Serverless Account Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type accountSettingsResourceModel struct {
+	CapacityLimits fwtypes.ListNestedObjectValueOf[capacityLimitsModel] `tfsdk:"capacity_limits"`
+	ID             types.String                                         `tfsdk:"id"`
+}
+
+type capacityLimitsModel struct {
+	MaxIndexingCapacityInOCU types.Int32 `tfsdk:"max_indexing_capacity_in_ocu"`
+	MaxSearchCapacityInOCU   types.Int32 `tfsdk:"max_search_capacity_in_ocu"`
+}
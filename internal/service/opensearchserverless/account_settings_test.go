@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package opensearchserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearchserverless "github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchServerlessAccountSettings_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic: testAccAccountSettings_basic,
+		"update":        testAccAccountSettings_update,
+		"validation":    testAccAccountSettings_validation,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAccountSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_opensearchserverless_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckCollection(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_basic(4, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("capacity_limits"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"max_indexing_capacity_in_ocu": knownvalue.Int32Exact(4),
+							"max_search_capacity_in_ocu":   knownvalue.Int32Exact(4),
+						}),
+					})),
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAccountSettings_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_opensearchserverless_account_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckCollection(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSettingsConfig_basic(4, 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.0.max_indexing_capacity_in_ocu", "4"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.0.max_search_capacity_in_ocu", "6"),
+				),
+			},
+			{
+				Config: testAccAccountSettingsConfig_basic(10, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountSettingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.0.max_indexing_capacity_in_ocu", "10"),
+					resource.TestCheckResourceAttr(resourceName, "capacity_limits.0.max_search_capacity_in_ocu", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSettings_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAccountSettingsConfig_basic(1, 4),
+				ExpectError: regexache.MustCompile(`Attribute capacity_limits\[0\].max_indexing_capacity_in_ocu value must\s+be at least 2`),
+			},
+			{
+				Config:      testAccAccountSettingsConfig_empty(),
+				ExpectError: regexache.MustCompile(`At least one attribute out of`),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountSettingsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessClient(ctx)
+
+		_, err := tfopensearchserverless.FindAccountSettings(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccCheckAccountSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchServerlessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_opensearchserverless_account_settings" {
+				continue
+			}
+
+			output, err := tfopensearchserverless.FindAccountSettings(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if v := output.CapacityLimits; v == nil || aws.ToInt32(v.MaxIndexingCapacityInOCU) != 10 || aws.ToInt32(v.MaxSearchCapacityInOCU) != 10 {
+				return fmt.Errorf("OpenSearch Serverless Account Settings %s capacity limits not reset to defaults", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountSettingsConfig_basic(maxIndexing, maxSearch int) string {
+	return fmt.Sprintf(`
+resource "aws_opensearchserverless_account_settings" "test" {
+  capacity_limits {
+    max_indexing_capacity_in_ocu = %[1]d
+    max_search_capacity_in_ocu   = %[2]d
+  }
+}
+`, maxIndexing, maxSearch)
+}
+
+func testAccAccountSettingsConfig_empty() string {
+	return `
+resource "aws_opensearchserverless_account_settings" "test" {
+  capacity_limits {}
+}
+`
+}
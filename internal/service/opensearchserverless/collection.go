@@ -273,6 +273,36 @@ func (r *resourceCollection) Update(ctx context.Context, req resource.UpdateRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+func (r *resourceCollection) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var collectionType, standbyReplicas types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(names.AttrType), &collectionType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("standby_replicas"), &standbyReplicas)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if collectionType.IsUnknown() || standbyReplicas.IsUnknown() {
+		return
+	}
+
+	if awstypes.StandbyReplicas(standbyReplicas.ValueString()) != awstypes.StandbyReplicasDisabled {
+		return
+	}
+
+	// The collection type defaults to TIMESERIES.
+	typ := awstypes.CollectionTypeTimeseries
+	if !collectionType.IsNull() {
+		typ = awstypes.CollectionType(collectionType.ValueString())
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("standby_replicas"),
+		"Standby replicas disabled",
+		fmt.Sprintf("A %s collection without standby replicas is not resilient to Availability Zone failures and is intended for development and test workloads only. "+
+			"Standby replicas cannot be enabled later without replacing the collection.", typ),
+	)
+}
+
 func (r *resourceCollection) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	conn := r.Meta().OpenSearchServerlessClient(ctx)
 
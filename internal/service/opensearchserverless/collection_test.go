@@ -94,6 +94,40 @@ func TestAccOpenSearchServerlessCollection_standbyReplicas(t *testing.T) {
 	})
 }
 
+func TestAccOpenSearchServerlessCollection_vectorSearch(t *testing.T) {
+	ctx := acctest.Context(t)
+	var collection types.CollectionDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_opensearchserverless_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.OpenSearchServerlessEndpointID)
+			testAccPreCheckCollection(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCollectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionConfig_typeAndStandbyReplicas(rName, string(types.CollectionTypeVectorsearch), string(types.StandbyReplicasDisabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(ctx, resourceName, &collection),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, string(types.CollectionTypeVectorsearch)),
+					resource.TestCheckResourceAttr(resourceName, "standby_replicas", string(types.StandbyReplicasDisabled)),
+					resource.TestCheckResourceAttrSet(resourceName, "collection_endpoint"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccOpenSearchServerlessCollection_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var collection types.CollectionDetail
@@ -316,6 +350,21 @@ resource "aws_opensearchserverless_collection" "test" {
 	)
 }
 
+func testAccCollectionConfig_typeAndStandbyReplicas(rName, collectionType, standbyReplicas string) string {
+	return acctest.ConfigCompose(
+		testAccCollectionBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_opensearchserverless_collection" "test" {
+  name             = %[1]q
+  type             = %[2]q
+  standby_replicas = %[3]q
+
+  depends_on = [aws_opensearchserverless_security_policy.test]
+}
+`, rName, collectionType, standbyReplicas),
+	)
+}
+
 func testAccCollectionConfig_update(rName, description string) string {
 	return acctest.ConfigCompose(
 		testAccCollectionBaseConfig(rName),
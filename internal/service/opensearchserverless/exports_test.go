@@ -13,6 +13,7 @@ var (
 	ResourceVPCEndpoint     = newVPCEndpointResource
 
 	FindAccessPolicyByNameAndType    = findAccessPolicyByNameAndType
+	FindAccountSettings              = findAccountSettings
 	FindCollectionByID               = findCollectionByID
 	FindLifecyclePolicyByNameAndType = findLifecyclePolicyByNameAndType
 	FindSecurityConfigByID           = findSecurityConfigByID
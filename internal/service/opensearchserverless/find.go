@@ -152,3 +152,19 @@ func findLifecyclePolicyByNameAndType(ctx context.Context, conn *opensearchserve
 
 	return tfresource.AssertSingleValueResult(out.LifecyclePolicyDetails)
 }
+
+func findAccountSettings(ctx context.Context, conn *opensearchserverless.Client) (*types.AccountSettingsDetail, error) {
+	input := &opensearchserverless.GetAccountSettingsInput{}
+
+	output, err := conn.GetAccountSettings(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccountSettingsDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccountSettingsDetail, nil
+}
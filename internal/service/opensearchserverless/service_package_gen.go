@@ -37,6 +37,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAccountSettingsResource,
+			Name:    "Account Settings",
+		},
 		{
 			Factory: newResourceAccessPolicy,
 			Name:    "Access Policy",
@@ -42,6 +42,10 @@ func resourceAccount() *schema.Resource {
 			StateContext: resourceAccountImportState,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -267,21 +271,38 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
 
-	close := d.Get("close_on_deletion").(bool)
-	var err error
-
-	if close {
+	if d.Get("close_on_deletion").(bool) {
 		log.Printf("[DEBUG] Closing AWS Organizations Account: %s", d.Id())
-		_, err = conn.CloseAccount(ctx, &organizations.CloseAccountInput{
-			AccountId: aws.String(d.Id()),
-		})
-	} else {
-		log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
-		_, err = conn.RemoveAccountFromOrganization(ctx, &organizations.RemoveAccountFromOrganizationInput{
-			AccountId: aws.String(d.Id()),
-		})
+		err := closeAccount(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+		if errs.IsA[*awstypes.AccountNotFoundException](err) {
+			return diags
+		}
+
+		if v, ok := errs.As[*awstypes.ConstraintViolationException](err); ok && v.Reason == awstypes.ConstraintViolationExceptionReasonCloseAccountQuotaExceeded {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("closing AWS Organizations Account (%s): account closure quota exceeded", d.Id()),
+				Detail:   "AWS Organizations limits the number of member accounts that can be closed within a rolling 30-day period. The account has not been closed and is still a member of the organization. Retry the destroy once the quota allows, close the account manually, or set `close_on_deletion` to `false` to only remove the account from the organization.",
+			})
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "closing AWS Organizations Account (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAccountDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) delete: %s", d.Id(), err)
+		}
+
+		return diags
 	}
 
+	log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
+	_, err := conn.RemoveAccountFromOrganization(ctx, &organizations.RemoveAccountFromOrganizationInput{
+		AccountId: aws.String(d.Id()),
+	})
+
 	if errs.IsA[*awstypes.AccountNotFoundException](err) {
 		return diags
 	}
@@ -290,13 +311,38 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "deleting AWS Organizations Account (%s): %s", d.Id(), err)
 	}
 
-	if close {
-		if _, err := waitAccountDeleted(ctx, conn, d.Id()); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for AWS Organizations Account (%s) delete: %s", d.Id(), err)
-		}
+	return diags
+}
+
+func closeAccount(ctx context.Context, conn *organizations.Client, id string, timeout time.Duration) error {
+	input := &organizations.CloseAccountInput{
+		AccountId: aws.String(id),
 	}
 
-	return diags
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.CloseAccount(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsA[*awstypes.ConcurrentModificationException](err) {
+				return true, err
+			}
+
+			// Only a limited number of accounts can be closing concurrently.
+			if v, ok := errs.As[*awstypes.ConstraintViolationException](err); ok && v.Reason == awstypes.ConstraintViolationExceptionReasonCloseAccountRequestsLimitExceeded {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	// The account may already be closed, e.g. by an earlier interrupted destroy; wait for it to be suspended.
+	if errs.IsA[*awstypes.AccountAlreadyClosedException](err) {
+		return nil
+	}
+
+	return err
 }
 
 func resourceAccountImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -469,13 +515,13 @@ func statusAccountStatus(ctx context.Context, conn *organizations.Client, id str
 	}
 }
 
-func waitAccountDeleted(ctx context.Context, conn *organizations.Client, id string) (*awstypes.Account, error) {
+func waitAccountDeleted(ctx context.Context, conn *organizations.Client, id string, timeout time.Duration) (*awstypes.Account, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      enum.Slice(awstypes.AccountStatusPendingClosure, awstypes.AccountStatusActive),
-		Target:       []string{},
+		Target:       []string{}, // SUSPENDED accounts are treated as not found.
 		Refresh:      statusAccountStatus(ctx, conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -73,7 +75,10 @@ func resourcePolicy() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -211,6 +216,61 @@ func resourcePolicyImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourcePolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrContent) || !d.NewValueKnown(names.AttrType) {
+		return nil
+	}
+
+	content := d.Get(names.AttrContent).(string)
+
+	if content == "" {
+		return nil
+	}
+
+	return validatePolicyContent(awstypes.PolicyType(d.Get(names.AttrType).(string)), content)
+}
+
+// managementPolicyTypeRootKeys maps each management policy type to the top-level key its content must contain.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_management_policies-syntax.html.
+var managementPolicyTypeRootKeys = map[awstypes.PolicyType]string{
+	awstypes.PolicyTypeAiservicesOptOutPolicy: "services",
+	awstypes.PolicyTypeBackupPolicy:           "plans",
+	awstypes.PolicyTypeChatbotPolicy:          "chatbot",
+	awstypes.PolicyTypeDeclarativePolicyEc2:   "ec2_attributes",
+	awstypes.PolicyTypeTagPolicy:              "tags",
+}
+
+func validatePolicyContent(policyType awstypes.PolicyType, content string) error {
+	var document map[string]any
+
+	if err := tfjson.DecodeFromString(content, &document); err != nil {
+		return fmt.Errorf("%s content must be a JSON object: %w", policyType, err)
+	}
+
+	switch policyType {
+	case awstypes.PolicyTypeResourceControlPolicy, awstypes.PolicyTypeServiceControlPolicy:
+		if _, ok := document["Statement"]; !ok {
+			return fmt.Errorf("%s content must contain a \"Statement\" element", policyType)
+		}
+	default:
+		key, ok := managementPolicyTypeRootKeys[policyType]
+
+		if !ok {
+			return nil
+		}
+
+		if _, ok := document["Statement"]; ok {
+			return fmt.Errorf("%s content must not contain a \"Statement\" element; use the management policy syntax", policyType)
+		}
+
+		if _, ok := document[key]; !ok {
+			return fmt.Errorf("%s content must contain a top-level %q element", policyType, key)
+		}
+	}
+
+	return nil
+}
+
 func findPolicyByID(ctx context.Context, conn *organizations.Client, id string) (*awstypes.Policy, error) {
 	input := &organizations.DescribePolicyInput{
 		PolicyId: aws.String(id),
@@ -66,9 +66,23 @@ func resourcePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 		TargetId: aws.String(targetID),
 	}
 
-	_, err := tfresource.RetryWhenIsA[*awstypes.FinalizingOrganizationException](ctx, organizationFinalizationTimeout, func() (interface{}, error) {
-		return conn.AttachPolicy(ctx, input)
-	})
+	_, err := tfresource.RetryWhen(ctx, organizationFinalizationTimeout,
+		func() (interface{}, error) {
+			return conn.AttachPolicy(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsA[*awstypes.FinalizingOrganizationException](err) {
+				return true, err
+			}
+
+			// Enabling a policy type in the organization root is eventually consistent.
+			if errs.IsA[*awstypes.PolicyTypeNotEnabledException](err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Organizations Policy Attachment (%s): %s", id, err)
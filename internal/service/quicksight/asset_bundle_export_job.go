@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_quicksight_asset_bundle_export_job", name="Asset Bundle Export Job")
+func newAssetBundleExportJobResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &assetBundleExportJobResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	resNameAssetBundleExportJob = "Asset Bundle Export Job"
+)
+
+type assetBundleExportJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (r *assetBundleExportJobResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_quicksight_asset_bundle_export_job"
+}
+
+func (r *assetBundleExportJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"asset_bundle_export_job_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w\-]+$`), ""),
+				},
+			},
+			names.AttrAWSAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"download_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"export_format": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.AssetBundleExportFormat](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"include_all_dependencies": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_permissions": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_tags": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_status": schema.StringAttribute{
+				Computed: true,
+			},
+			"resource_arns": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 100),
+					setvalidator.ValueStringsAre(fwvalidators.ARN()),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *assetBundleExportJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var plan resourceAssetBundleExportJobData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AWSAccountID.IsUnknown() || plan.AWSAccountID.IsNull() {
+		plan.AWSAccountID = types.StringValue(r.Meta().AccountID(ctx))
+	}
+	awsAccountID, jobID := flex.StringValueFromFramework(ctx, plan.AWSAccountID), flex.StringValueFromFramework(ctx, plan.AssetBundleExportJobID)
+	in := &quicksight.StartAssetBundleExportJobInput{
+		AssetBundleExportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+		ExportFormat:           awstypes.AssetBundleExportFormat(plan.ExportFormat.ValueString()),
+		IncludeAllDependencies: plan.IncludeAllDependencies.ValueBool(),
+		IncludePermissions:     plan.IncludePermissions.ValueBool(),
+		IncludeTags:            plan.IncludeTags.ValueBool(),
+		ResourceArns:           flex.ExpandFrameworkStringValueSet(ctx, plan.ResourceARNs),
+	}
+
+	out, err := conn.StartAssetBundleExportJob(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, resNameAssetBundleExportJob, jobID, err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, resNameAssetBundleExportJob, jobID, nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringValueToFramework(ctx, assetBundleExportJobCreateResourceID(awsAccountID, jobID))
+
+	waitOut, err := waitAssetBundleExportJobSuccessful(ctx, conn, awsAccountID, jobID, r.CreateTimeout(ctx, plan.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionWaitingForCreation, resNameAssetBundleExportJob, jobID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ARN = flex.StringToFramework(ctx, waitOut.Arn)
+	plan.DownloadURL = flex.StringToFramework(ctx, waitOut.DownloadUrl)
+	plan.JobStatus = flex.StringValueToFramework(ctx, waitOut.JobStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *assetBundleExportJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var state resourceAssetBundleExportJobData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsAccountID, jobID, err := assetBundleExportJobParseResourceID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameAssetBundleExportJob, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findAssetBundleExportJobByTwoPartKey(ctx, conn, awsAccountID, jobID)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameAssetBundleExportJob, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ARN = flex.StringToFramework(ctx, out.Arn)
+	state.AssetBundleExportJobID = flex.StringToFramework(ctx, out.AssetBundleExportJobId)
+	state.AWSAccountID = flex.StringValueToFramework(ctx, awsAccountID)
+	// The download URL is a presigned URL that is refreshed on every call.
+	state.DownloadURL = flex.StringToFramework(ctx, out.DownloadUrl)
+	state.ExportFormat = flex.StringValueToFramework(ctx, out.ExportFormat)
+	state.IncludeAllDependencies = types.BoolValue(out.IncludeAllDependencies)
+	state.IncludePermissions = types.BoolValue(out.IncludePermissions)
+	state.IncludeTags = types.BoolValue(out.IncludeTags)
+	state.JobStatus = flex.StringValueToFramework(ctx, out.JobStatus)
+	state.ResourceARNs = flex.FlattenFrameworkStringValueSet(ctx, out.ResourceArns)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func findAssetBundleExportJobByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string) (*quicksight.DescribeAssetBundleExportJobOutput, error) {
+	input := &quicksight.DescribeAssetBundleExportJobInput{
+		AssetBundleExportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	return findAssetBundleExportJob(ctx, conn, input)
+}
+
+func findAssetBundleExportJob(ctx context.Context, conn *quicksight.Client, input *quicksight.DescribeAssetBundleExportJobInput) (*quicksight.DescribeAssetBundleExportJobOutput, error) {
+	output, err := conn.DescribeAssetBundleExportJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAssetBundleExportJob(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAssetBundleExportJobByTwoPartKey(ctx, conn, awsAccountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
+
+func waitAssetBundleExportJobSuccessful(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string, timeout time.Duration) (*quicksight.DescribeAssetBundleExportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.AssetBundleExportJobStatusQueuedForImmediateExecution, awstypes.AssetBundleExportJobStatusInProgress),
+		Target:     enum.Slice(awstypes.AssetBundleExportJobStatusSuccessful),
+		Refresh:    statusAssetBundleExportJob(ctx, conn, awsAccountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.DescribeAssetBundleExportJobOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Errors, assetBundleExportJobError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func assetBundleExportJobError(v awstypes.AssetBundleExportJobError) error {
+	return fmt.Errorf("%s: %s: %s", aws.ToString(v.Arn), aws.ToString(v.Type), aws.ToString(v.Message))
+}
+
+const assetBundleExportJobResourceIDSeparator = ","
+
+func assetBundleExportJobCreateResourceID(awsAccountID, jobID string) string {
+	parts := []string{awsAccountID, jobID}
+	id := strings.Join(parts, assetBundleExportJobResourceIDSeparator)
+
+	return id
+}
+
+func assetBundleExportJobParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, assetBundleExportJobResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sASSET_BUNDLE_EXPORT_JOB_ID", id, assetBundleExportJobResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+type resourceAssetBundleExportJobData struct {
+	ARN                    types.String   `tfsdk:"arn"`
+	AssetBundleExportJobID types.String   `tfsdk:"asset_bundle_export_job_id"`
+	AWSAccountID           types.String   `tfsdk:"aws_account_id"`
+	DownloadURL            types.String   `tfsdk:"download_url"`
+	ExportFormat           types.String   `tfsdk:"export_format"`
+	ID                     types.String   `tfsdk:"id"`
+	IncludeAllDependencies types.Bool     `tfsdk:"include_all_dependencies"`
+	IncludePermissions     types.Bool     `tfsdk:"include_permissions"`
+	IncludeTags            types.Bool     `tfsdk:"include_tags"`
+	JobStatus              types.String   `tfsdk:"job_status"`
+	ResourceARNs           types.Set      `tfsdk:"resource_arns"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQuickSightAssetBundleExportJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job quicksight.DescribeAssetBundleExportJobOutput
+	resourceName := "aws_quicksight_asset_bundle_export_job.test"
+	themeResourceName := "aws_quicksight_theme.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetBundleExportJobConfig_basic(rId, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetBundleExportJobExists(ctx, resourceName, &job),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "quicksight", fmt.Sprintf("asset-bundle-export-job/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "asset_bundle_export_job_id", rId),
+					resource.TestCheckResourceAttrSet(resourceName, "download_url"),
+					resource.TestCheckResourceAttr(resourceName, "export_format", string(awstypes.AssetBundleExportFormatQuicksightJson)),
+					resource.TestCheckResourceAttr(resourceName, "include_all_dependencies", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "include_permissions", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "include_tags", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "job_status", string(awstypes.AssetBundleExportJobStatusSuccessful)),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", themeResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"download_url"},
+			},
+		},
+	})
+}
+
+func TestAccQuickSightAssetBundleExportJob_includeOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job quicksight.DescribeAssetBundleExportJobOutput
+	resourceName := "aws_quicksight_asset_bundle_export_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetBundleExportJobConfig_includeOptions(rId, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetBundleExportJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "export_format", string(awstypes.AssetBundleExportFormatCloudformationJson)),
+					resource.TestCheckResourceAttr(resourceName, "include_all_dependencies", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "include_permissions", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "include_tags", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "job_status", string(awstypes.AssetBundleExportJobStatusSuccessful)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssetBundleExportJobExists(ctx context.Context, n string, v *quicksight.DescribeAssetBundleExportJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		output, err := tfquicksight.FindAssetBundleExportJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["asset_bundle_export_job_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAssetBundleExportJobConfig_base(rId, rName string) string {
+	return testAccThemeConfig_basic(rId, rName, "MIDNIGHT")
+}
+
+func testAccAssetBundleExportJobConfig_basic(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssetBundleExportJobConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_asset_bundle_export_job" "test" {
+  asset_bundle_export_job_id = %[1]q
+  export_format              = "QUICKSIGHT_JSON"
+  resource_arns              = [aws_quicksight_theme.test.arn]
+}
+`, rId))
+}
+
+func testAccAssetBundleExportJobConfig_includeOptions(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssetBundleExportJobConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_asset_bundle_export_job" "test" {
+  asset_bundle_export_job_id = %[1]q
+  export_format              = "CLOUDFORMATION_JSON"
+  resource_arns              = [aws_quicksight_theme.test.arn]
+
+  include_all_dependencies = true
+  include_permissions      = true
+  include_tags             = true
+}
+`, rId))
+}
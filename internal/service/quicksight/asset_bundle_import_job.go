@@ -0,0 +1,551 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_quicksight_asset_bundle_import_job", name="Asset Bundle Import Job")
+func newAssetBundleImportJobResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &assetBundleImportJobResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	resNameAssetBundleImportJob = "Asset Bundle Import Job"
+)
+
+type assetBundleImportJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (r *assetBundleImportJobResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_quicksight_asset_bundle_import_job"
+}
+
+func (r *assetBundleImportJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	overrideNameAttribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 2048),
+		},
+	}
+	overrideIDAttribute := schema.StringAttribute{
+		Required: true,
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"asset_bundle_import_job_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w\-]+$`), ""),
+				},
+			},
+			names.AttrAWSAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"failure_action": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.AssetBundleImportFailureAction](),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"asset_bundle_import_source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportSourceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"body": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("body"),
+									path.MatchRelative().AtParent().AtName("s3_uri"),
+								),
+							},
+						},
+						"s3_uri": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.S3URI(),
+							},
+						},
+					},
+				},
+			},
+			"override_parameters": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportJobOverrideParametersModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"analyses": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportJobAnalysisOverrideParametersModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"analysis_id":  overrideIDAttribute,
+									names.AttrName: overrideNameAttribute,
+								},
+							},
+						},
+						"dashboards": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportJobDashboardOverrideParametersModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"dashboard_id": overrideIDAttribute,
+									names.AttrName: overrideNameAttribute,
+								},
+							},
+						},
+						"data_sets": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportJobDataSetOverrideParametersModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"data_set_id":  overrideIDAttribute,
+									names.AttrName: overrideNameAttribute,
+								},
+							},
+						},
+						"data_sources": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportJobDataSourceOverrideParametersModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"data_source_id": overrideIDAttribute,
+									names.AttrName:   overrideNameAttribute,
+								},
+								Blocks: map[string]schema.Block{
+									"credentials": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportJobDataSourceCredentialsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"secret_arn": schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														fwvalidators.ARN(),
+													},
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"credential_pair": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportJobDataSourceCredentialPairModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("secret_arn"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrPassword: schema.StringAttribute{
+																Required:  true,
+																Sensitive: true,
+															},
+															names.AttrUsername: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"resource_id_override_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportJobResourceIDOverrideConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"prefix_for_all_resources": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"themes": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[assetBundleImportJobThemeOverrideParametersModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: overrideNameAttribute,
+									"theme_id":     overrideIDAttribute,
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *assetBundleImportJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var plan resourceAssetBundleImportJobData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.AWSAccountID.IsUnknown() || plan.AWSAccountID.IsNull() {
+		plan.AWSAccountID = types.StringValue(r.Meta().AccountID(ctx))
+	}
+	awsAccountID, jobID := flex.StringValueFromFramework(ctx, plan.AWSAccountID), flex.StringValueFromFramework(ctx, plan.AssetBundleImportJobID)
+	in := &quicksight.StartAssetBundleImportJobInput{
+		AssetBundleImportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	if !plan.FailureAction.IsUnknown() && !plan.FailureAction.IsNull() {
+		in.FailureAction = awstypes.AssetBundleImportFailureAction(plan.FailureAction.ValueString())
+	}
+
+	source, diags := plan.AssetBundleImportSource.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.AssetBundleImportSource = &awstypes.AssetBundleImportSource{
+		S3Uri: source.S3URI.ValueStringPointer(),
+	}
+
+	// The bundle body is configured as a base64-encoded string.
+	if v := source.Body.ValueString(); v != "" {
+		body, err := itypes.Base64Decode(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("asset_bundle_import_source").AtListIndex(0).AtName("body"),
+				create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, resNameAssetBundleImportJob, jobID, err),
+				err.Error(),
+			)
+			return
+		}
+
+		in.AssetBundleImportSource.Body = body
+	}
+
+	if !plan.OverrideParameters.IsNull() {
+		in.OverrideParameters = &awstypes.AssetBundleImportJobOverrideParameters{}
+		resp.Diagnostics.Append(flex.Expand(ctx, plan.OverrideParameters, in.OverrideParameters)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	out, err := conn.StartAssetBundleImportJob(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, resNameAssetBundleImportJob, jobID, err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionCreating, resNameAssetBundleImportJob, jobID, nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringValueToFramework(ctx, assetBundleImportJobCreateResourceID(awsAccountID, jobID))
+
+	waitOut, err := waitAssetBundleImportJobSuccessful(ctx, conn, awsAccountID, jobID, r.CreateTimeout(ctx, plan.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionWaitingForCreation, resNameAssetBundleImportJob, jobID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ARN = flex.StringToFramework(ctx, waitOut.Arn)
+	plan.FailureAction = flex.StringValueToFramework(ctx, waitOut.FailureAction)
+	plan.JobStatus = flex.StringValueToFramework(ctx, waitOut.JobStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *assetBundleImportJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().QuickSightClient(ctx)
+
+	var state resourceAssetBundleImportJobData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	awsAccountID, jobID, err := assetBundleImportJobParseResourceID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameAssetBundleImportJob, state.ID.String(), nil),
+			err.Error(),
+		)
+		return
+	}
+
+	out, err := findAssetBundleImportJobByTwoPartKey(ctx, conn, awsAccountID, jobID)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QuickSight, create.ErrActionReading, resNameAssetBundleImportJob, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The import source and override parameters are not returned in a form that can be compared with the configuration.
+	state.ARN = flex.StringToFramework(ctx, out.Arn)
+	state.AssetBundleImportJobID = flex.StringToFramework(ctx, out.AssetBundleImportJobId)
+	state.AWSAccountID = flex.StringValueToFramework(ctx, awsAccountID)
+	state.FailureAction = flex.StringValueToFramework(ctx, out.FailureAction)
+	state.JobStatus = flex.StringValueToFramework(ctx, out.JobStatus)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func findAssetBundleImportJobByTwoPartKey(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string) (*quicksight.DescribeAssetBundleImportJobOutput, error) {
+	input := &quicksight.DescribeAssetBundleImportJobInput{
+		AssetBundleImportJobId: aws.String(jobID),
+		AwsAccountId:           aws.String(awsAccountID),
+	}
+
+	return findAssetBundleImportJob(ctx, conn, input)
+}
+
+func findAssetBundleImportJob(ctx context.Context, conn *quicksight.Client, input *quicksight.DescribeAssetBundleImportJobInput) (*quicksight.DescribeAssetBundleImportJobOutput, error) {
+	output, err := conn.DescribeAssetBundleImportJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAssetBundleImportJob(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAssetBundleImportJobByTwoPartKey(ctx, conn, awsAccountID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
+
+func waitAssetBundleImportJobSuccessful(ctx context.Context, conn *quicksight.Client, awsAccountID, jobID string, timeout time.Duration) (*quicksight.DescribeAssetBundleImportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.AssetBundleImportJobStatusQueuedForImmediateExecution,
+			awstypes.AssetBundleImportJobStatusInProgress,
+			awstypes.AssetBundleImportJobStatusFailedRollbackInProgress,
+		),
+		Target:     enum.Slice(awstypes.AssetBundleImportJobStatusSuccessful),
+		Refresh:    statusAssetBundleImportJob(ctx, conn, awsAccountID, jobID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.DescribeAssetBundleImportJobOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Errors, assetBundleImportJobError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func assetBundleImportJobError(v awstypes.AssetBundleImportJobError) error {
+	return fmt.Errorf("%s: %s: %s", aws.ToString(v.Arn), aws.ToString(v.Type), aws.ToString(v.Message))
+}
+
+const assetBundleImportJobResourceIDSeparator = ","
+
+func assetBundleImportJobCreateResourceID(awsAccountID, jobID string) string {
+	parts := []string{awsAccountID, jobID}
+	id := strings.Join(parts, assetBundleImportJobResourceIDSeparator)
+
+	return id
+}
+
+func assetBundleImportJobParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, assetBundleImportJobResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected AWS_ACCOUNT_ID%[2]sASSET_BUNDLE_IMPORT_JOB_ID", id, assetBundleImportJobResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+type resourceAssetBundleImportJobData struct {
+	ARN                     types.String                                                                 `tfsdk:"arn"`
+	AssetBundleImportJobID  types.String                                                                 `tfsdk:"asset_bundle_import_job_id"`
+	AssetBundleImportSource fwtypes.ListNestedObjectValueOf[assetBundleImportSourceModel]                `tfsdk:"asset_bundle_import_source"`
+	AWSAccountID            types.String                                                                 `tfsdk:"aws_account_id"`
+	FailureAction           types.String                                                                 `tfsdk:"failure_action"`
+	ID                      types.String                                                                 `tfsdk:"id"`
+	JobStatus               types.String                                                                 `tfsdk:"job_status"`
+	OverrideParameters      fwtypes.ListNestedObjectValueOf[assetBundleImportJobOverrideParametersModel] `tfsdk:"override_parameters"`
+	Timeouts                timeouts.Value                                                               `tfsdk:"timeouts"`
+}
+
+type assetBundleImportSourceModel struct {
+	Body  types.String `tfsdk:"body"`
+	S3URI types.String `tfsdk:"s3_uri"`
+}
+
+type assetBundleImportJobOverrideParametersModel struct {
+	Analyses                        fwtypes.ListNestedObjectValueOf[assetBundleImportJobAnalysisOverrideParametersModel]      `tfsdk:"analyses"`
+	Dashboards                      fwtypes.ListNestedObjectValueOf[assetBundleImportJobDashboardOverrideParametersModel]     `tfsdk:"dashboards"`
+	DataSets                        fwtypes.ListNestedObjectValueOf[assetBundleImportJobDataSetOverrideParametersModel]       `tfsdk:"data_sets"`
+	DataSources                     fwtypes.ListNestedObjectValueOf[assetBundleImportJobDataSourceOverrideParametersModel]    `tfsdk:"data_sources"`
+	ResourceIDOverrideConfiguration fwtypes.ListNestedObjectValueOf[assetBundleImportJobResourceIDOverrideConfigurationModel] `tfsdk:"resource_id_override_configuration"`
+	Themes                          fwtypes.ListNestedObjectValueOf[assetBundleImportJobThemeOverrideParametersModel]         `tfsdk:"themes"`
+}
+
+type assetBundleImportJobAnalysisOverrideParametersModel struct {
+	AnalysisID types.String `tfsdk:"analysis_id"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type assetBundleImportJobDashboardOverrideParametersModel struct {
+	DashboardID types.String `tfsdk:"dashboard_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+type assetBundleImportJobDataSetOverrideParametersModel struct {
+	DataSetID types.String `tfsdk:"data_set_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+type assetBundleImportJobDataSourceOverrideParametersModel struct {
+	Credentials  fwtypes.ListNestedObjectValueOf[assetBundleImportJobDataSourceCredentialsModel] `tfsdk:"credentials"`
+	DataSourceID types.String                                                                    `tfsdk:"data_source_id"`
+	Name         types.String                                                                    `tfsdk:"name"`
+}
+
+type assetBundleImportJobDataSourceCredentialsModel struct {
+	CredentialPair fwtypes.ListNestedObjectValueOf[assetBundleImportJobDataSourceCredentialPairModel] `tfsdk:"credential_pair"`
+	SecretARN      types.String                                                                       `tfsdk:"secret_arn"`
+}
+
+type assetBundleImportJobDataSourceCredentialPairModel struct {
+	Password types.String `tfsdk:"password"`
+	Username types.String `tfsdk:"username"`
+}
+
+type assetBundleImportJobResourceIDOverrideConfigurationModel struct {
+	PrefixForAllResources types.String `tfsdk:"prefix_for_all_resources"`
+}
+
+type assetBundleImportJobThemeOverrideParametersModel struct {
+	Name    types.String `tfsdk:"name"`
+	ThemeID types.String `tfsdk:"theme_id"`
+}
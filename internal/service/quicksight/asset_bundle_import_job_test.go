@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/quicksight"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQuickSightAssetBundleImportJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job quicksight.DescribeAssetBundleImportJobOutput
+	resourceName := "aws_quicksight_asset_bundle_import_job.test"
+	exportResourceName := "aws_quicksight_asset_bundle_export_job.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetBundleImportJobConfig_base(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetBundleExportJobCopyToS3(ctx, exportResourceName, bucketResourceName, "bundle.qs"),
+				),
+			},
+			{
+				Config: testAccAssetBundleImportJobConfig_basic(rId, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetBundleImportJobExists(ctx, resourceName, &job),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "quicksight", fmt.Sprintf("asset-bundle-import-job/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "asset_bundle_import_job_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "failure_action", string(awstypes.AssetBundleImportFailureActionRollback)),
+					resource.TestCheckResourceAttr(resourceName, "job_status", string(awstypes.AssetBundleImportJobStatusSuccessful)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"asset_bundle_import_source", "override_parameters"},
+			},
+		},
+	})
+}
+
+func testAccCheckAssetBundleImportJobExists(ctx context.Context, n string, v *quicksight.DescribeAssetBundleImportJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		output, err := tfquicksight.FindAssetBundleImportJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["asset_bundle_import_job_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCheckAssetBundleExportJobCopyToS3 downloads the exported asset bundle and uploads it to the specified S3 bucket.
+func testAccCheckAssetBundleExportJobCopyToS3(ctx context.Context, exportJobName, bucketName, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[exportJobName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", exportJobName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightClient(ctx)
+
+		output, err := tfquicksight.FindAssetBundleExportJobByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrAWSAccountID], rs.Primary.Attributes["asset_bundle_export_job_id"])
+
+		if err != nil {
+			return err
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, aws.ToString(output.DownloadUrl), nil)
+		if err != nil {
+			return err
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("downloading QuickSight Asset Bundle Export Job (%s): %s", rs.Primary.ID, response.Status)
+		}
+
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return err
+		}
+
+		rs, ok = s.RootModule().Resources[bucketName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", bucketName)
+		}
+
+		_, err = acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx).PutObject(ctx, &s3.PutObjectInput{
+			Body:   bytes.NewReader(body),
+			Bucket: aws.String(rs.Primary.ID),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccAssetBundleImportJobConfig_base(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssetBundleExportJobConfig_basic(rId, rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName))
+}
+
+func testAccAssetBundleImportJobConfig_basic(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssetBundleImportJobConfig_base(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_asset_bundle_import_job" "test" {
+  asset_bundle_import_job_id = %[1]q
+  failure_action             = "ROLLBACK"
+
+  asset_bundle_import_source {
+    s3_uri = "s3://${aws_s3_bucket.test.bucket}/bundle.qs"
+  }
+
+  # Importing into the source account updates the exported theme in place.
+  override_parameters {
+    themes {
+      theme_id = aws_quicksight_theme.test.theme_id
+      name     = aws_quicksight_theme.test.name
+    }
+  }
+}
+`, rId))
+}
@@ -30,6 +30,8 @@ var (
 	DefaultUserNamespace                  = defaultUserNamespace
 	FindAccountSubscriptionByID           = findAccountSubscriptionByID
 	FindAnalysisByTwoPartKey              = findAnalysisByTwoPartKey
+	FindAssetBundleExportJobByTwoPartKey  = findAssetBundleExportJobByTwoPartKey
+	FindAssetBundleImportJobByTwoPartKey  = findAssetBundleImportJobByTwoPartKey
 	FindDashboardByThreePartKey           = findDashboardByThreePartKey
 	FindDataSetByTwoPartKey               = findDataSetByTwoPartKey
 	FindDataSourceByTwoPartKey            = findDataSourceByTwoPartKey
@@ -20,6 +20,14 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAssetBundleExportJobResource,
+			Name:    "Asset Bundle Export Job",
+		},
+		{
+			Factory: newAssetBundleImportJobResource,
+			Name:    "Asset Bundle Import Job",
+		},
 		{
 			Factory: newFolderMembershipResource,
 			Name:    "Folder Membership",
@@ -5,12 +5,14 @@ package ram
 
 // Exports for use in tests only.
 var (
+	ResourcePermission              = resourcePermission
 	ResourcePrincipalAssociation    = resourcePrincipalAssociation
 	ResourceResourceAssociation     = resourceResourceAssociation
 	ResourceResourceShare           = resourceResourceShare
 	ResourceResourceShareAccepter   = resourceResourceShareAccepter
 	ResourceSharingWithOrganization = resourceSharingWithOrganization
 
+	FindPermissionByARN                      = findPermissionByARN
 	FindPrincipalAssociationByTwoPartKey     = findPrincipalAssociationByTwoPartKey
 	FindResourceAssociationByTwoPartKey      = findResourceAssociationByTwoPartKey
 	FindResourceShareOwnerOtherAccountsByARN = findResourceShareOwnerOtherAccountsByARN
@@ -0,0 +1,433 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ram"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ram/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// A customer managed permission can have at most 5 versions.
+	permissionVersionsMax = 5
+)
+
+// @SDKResource("aws_ram_permission", name="Permission")
+// @Tags
+func resourcePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionCreate,
+		ReadWithoutTimeout:   resourcePermissionRead,
+		UpdateWithoutTimeout: resourcePermissionUpdate,
+		DeleteWithoutTimeout: resourcePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 36),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"permission_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_template": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			names.AttrResourceType: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	policyTemplate, err := structure.NormalizeJsonString(d.Get("policy_template").(string))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	name := d.Get(names.AttrName).(string)
+	input := &ram.CreatePermissionInput{
+		ClientToken:    aws.String(sdkid.UniqueId()),
+		Name:           aws.String(name),
+		PolicyTemplate: aws.String(policyTemplate),
+		ResourceType:   aws.String(d.Get(names.AttrResourceType).(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	output, err := conn.CreatePermission(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Permission (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Permission.Arn))
+
+	_, err = tfresource.RetryWhenNotFound(ctx, resourceSharePropagationTimeout, func() (interface{}, error) {
+		return findPermissionByARN(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePermissionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	permission, err := findPermissionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, permission.Arn)
+	d.Set(names.AttrCreationTime, aws.ToTime(permission.CreationTime).Format(time.RFC3339))
+	d.Set("default_version", permission.DefaultVersion)
+	d.Set("last_updated_time", aws.ToTime(permission.LastUpdatedTime).Format(time.RFC3339))
+	d.Set(names.AttrName, permission.Name)
+	d.Set("permission_type", permission.PermissionType)
+
+	d.Set("policy_template", permission.Permission)
+	d.Set(names.AttrResourceType, permission.ResourceType)
+	d.Set(names.AttrStatus, permission.Status)
+	d.Set(names.AttrVersion, permission.Version)
+
+	setTagsOut(ctx, permission.Tags)
+
+	return diags
+}
+
+func resourcePermissionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	if d.HasChange("policy_template") {
+		// Make room for the new version by removing the oldest non-default version.
+		versions, err := findPermissionVersionsByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RAM Permission (%s) versions: %s", d.Id(), err)
+		}
+
+		if len(versions) >= permissionVersionsMax {
+			if err := deleteOldestPermissionVersion(ctx, conn, d.Id(), versions); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		policyTemplate, err := structure.NormalizeJsonString(d.Get("policy_template").(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &ram.CreatePermissionVersionInput{
+			ClientToken:    aws.String(sdkid.UniqueId()),
+			PermissionArn:  aws.String(d.Id()),
+			PolicyTemplate: aws.String(policyTemplate),
+		}
+
+		output, err := conn.CreatePermissionVersion(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RAM Permission (%s) version: %s", d.Id(), err)
+		}
+
+		version, err := strconv.ParseInt(aws.ToString(output.Permission.Version), 10, 32)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		_, err = conn.SetDefaultPermissionVersion(ctx, &ram.SetDefaultPermissionVersionInput{
+			ClientToken:       aws.String(sdkid.UniqueId()),
+			PermissionArn:     aws.String(d.Id()),
+			PermissionVersion: aws.Int32(int32(version)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting RAM Permission (%s) default version (%d): %s", d.Id(), version, err)
+		}
+	}
+
+	if d.HasChange(names.AttrTagsAll) {
+		o, n := d.GetChange(names.AttrTagsAll)
+
+		if err := updatePermissionTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RAM Permission (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePermissionRead(ctx, d, meta)...)
+}
+
+func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMClient(ctx)
+
+	log.Printf("[DEBUG] Deleting RAM Permission: %s", d.Id())
+	_, err := conn.DeletePermission(ctx, &ram.DeletePermissionInput{
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		PermissionArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.UnknownResourceException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPermissionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// deleteOldestPermissionVersion deletes the lowest numbered version that is not the default version.
+func deleteOldestPermissionVersion(ctx context.Context, conn *ram.Client, arn string, versions []awstypes.ResourceSharePermissionSummary) error {
+	var oldest int64
+	for _, v := range versions {
+		if aws.ToBool(v.DefaultVersion) {
+			continue
+		}
+
+		version, err := strconv.ParseInt(aws.ToString(v.Version), 10, 32)
+		if err != nil {
+			return err
+		}
+
+		if oldest == 0 || version < oldest {
+			oldest = version
+		}
+	}
+
+	if oldest == 0 {
+		return nil
+	}
+
+	_, err := conn.DeletePermissionVersion(ctx, &ram.DeletePermissionVersionInput{
+		ClientToken:       aws.String(sdkid.UniqueId()),
+		PermissionArn:     aws.String(arn),
+		PermissionVersion: aws.Int32(int32(oldest)),
+	})
+
+	if err != nil {
+		return fmt.Errorf("deleting RAM Permission (%s) version (%d): %w", arn, oldest, err)
+	}
+
+	return nil
+}
+
+// updatePermissionTags updates RAM permission tags.
+// The generated updateTags function only supports resource shares.
+func updatePermissionTags(ctx context.Context, conn *ram.Client, arn string, oldTagsMap, newTagsMap any) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreSystem(names.RAM); len(removedTags) > 0 {
+		input := &ram.UntagResourceInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     removedTags.Keys(),
+		}
+
+		if _, err := conn.UntagResource(ctx, input); err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", arn, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreSystem(names.RAM); len(updatedTags) > 0 {
+		input := &ram.TagResourceInput{
+			ResourceArn: aws.String(arn),
+			Tags:        Tags(updatedTags),
+		}
+
+		if _, err := conn.TagResource(ctx, input); err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", arn, err)
+		}
+	}
+
+	return nil
+}
+
+func findPermissionByARN(ctx context.Context, conn *ram.Client, arn string) (*awstypes.ResourceSharePermissionDetail, error) {
+	input := &ram.GetPermissionInput{
+		PermissionArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPermission(ctx, input)
+
+	if errs.IsA[*awstypes.UnknownResourceException](err) || errs.IsA[*awstypes.MalformedArnException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Permission == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Permission.Status; status == awstypes.PermissionStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Permission, nil
+}
+
+func findPermissionVersionsByARN(ctx context.Context, conn *ram.Client, arn string) ([]awstypes.ResourceSharePermissionSummary, error) {
+	input := &ram.ListPermissionVersionsInput{
+		PermissionArn: aws.String(arn),
+	}
+	var output []awstypes.ResourceSharePermissionSummary
+
+	pages := ram.NewListPermissionVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.UnknownResourceException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Permissions...)
+	}
+
+	return slices.DeleteFunc(output, func(v awstypes.ResourceSharePermissionSummary) bool {
+		return aws.ToString(v.Status) == string(awstypes.PermissionStatusDeleted)
+	}), nil
+}
+
+func statusPermission(ctx context.Context, conn *ram.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPermissionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPermissionDeleted(ctx context.Context, conn *ram.Client, arn string, timeout time.Duration) (*awstypes.ResourceSharePermissionDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PermissionStatusAttachable, awstypes.PermissionStatusUnattachable, awstypes.PermissionStatusDeleting),
+		Target:  []string{},
+		Refresh: statusPermission(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ResourceSharePermissionDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
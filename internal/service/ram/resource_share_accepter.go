@@ -95,39 +95,41 @@ func resourceResourceShareAccepterCreate(ctx context.Context, d *schema.Resource
 	conn := meta.(*conns.AWSClient).RAMClient(ctx)
 
 	shareARN := d.Get("share_arn").(string)
-	maybeInvitation, err := findMaybeResourceShareInvitationByResourceShareARNAndStatus(ctx, conn, shareARN, string(awstypes.ResourceShareInvitationStatusPending))
+	// The invitation is only sent once the sharing account has associated this account with the resource share,
+	// which typically happens concurrently via another provider configuration.
+	// The wait is kept short as no invitation is ever sent for a share within the same organization.
+	invitation, err := waitResourceShareInvitationCreated(ctx, conn, shareARN, resourceSharePropagationTimeout)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading pending RAM Resource Share (%s) invitation: %s", shareARN, err)
-	}
-
-	var invitationExists bool
-	var invitationARN string
-	if maybeInvitation.IsSome() {
-		invitationExists = true
-		invitationARN = aws.ToString(maybeInvitation.MustUnwrap().ResourceShareInvitationArn)
-	}
-
-	if !invitationExists || invitationARN == "" {
+	if tfresource.NotFound(err) {
 		return sdkdiag.AppendErrorf(diags, "No pending RAM Resource Share (%s) invitation found\n\n"+
 			"NOTE: If both AWS accounts are in the same AWS Organization and RAM Sharing with AWS Organizations is enabled, this resource is not necessary",
 			shareARN)
 	}
 
-	input := &ram.AcceptResourceShareInvitationInput{
-		ClientToken:                aws.String(id.UniqueId()),
-		ResourceShareInvitationArn: aws.String(invitationARN),
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading pending RAM Resource Share (%s) invitation: %s", shareARN, err)
 	}
 
-	output, err := conn.AcceptResourceShareInvitation(ctx, input)
+	invitationARN := aws.ToString(invitation.ResourceShareInvitationArn)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "accepting RAM Resource Share (%s) invitation (%s): %s", shareARN, invitationARN, err)
+	// An invitation accepted outside of Terraform (or by a previous, interrupted apply) is adopted as-is.
+	if invitation.Status == awstypes.ResourceShareInvitationStatusPending {
+		input := &ram.AcceptResourceShareInvitationInput{
+			ClientToken:                aws.String(id.UniqueId()),
+			ResourceShareInvitationArn: aws.String(invitationARN),
+		}
+
+		output, err := conn.AcceptResourceShareInvitation(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "accepting RAM Resource Share (%s) invitation (%s): %s", shareARN, invitationARN, err)
+		}
+
+		invitationARN = aws.ToString(output.ResourceShareInvitation.ResourceShareInvitationArn)
 	}
 
 	d.SetId(shareARN)
 
-	invitationARN = aws.ToString(output.ResourceShareInvitation.ResourceShareInvitationArn)
 	if _, err := waitResourceShareInvitationAccepted(ctx, conn, invitationARN, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) invitation (%s) accept: %s", shareARN, invitationARN, err)
 	}
@@ -376,6 +378,43 @@ func statusResourceShareInvitation(ctx context.Context, conn *ram.Client, arn st
 	}
 }
 
+// waitResourceShareInvitationCreated waits for a pending or accepted invitation to the specified resource share to be visible to the receiving account.
+func waitResourceShareInvitationCreated(ctx context.Context, conn *ram.Client, resourceShareARN string, timeout time.Duration) (*awstypes.ResourceShareInvitation, error) {
+	input := &ram.GetResourceShareInvitationsInput{
+		ResourceShareArns: []string{resourceShareARN},
+	}
+	filter := func(v *awstypes.ResourceShareInvitation) bool {
+		switch v.Status {
+		case awstypes.ResourceShareInvitationStatusPending, awstypes.ResourceShareInvitationStatusAccepted:
+			return true
+		default:
+			return false
+		}
+	}
+
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, timeout, func() (interface{}, error) {
+		maybeInvitation, err := findMaybeResourceShareInvitation(ctx, conn, input, filter)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if maybeInvitation.IsNone() {
+			return nil, &retry.NotFoundError{LastRequest: input}
+		}
+
+		return maybeInvitation.MustUnwrap(), nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	v := outputRaw.(awstypes.ResourceShareInvitation)
+
+	return &v, nil
+}
+
 func waitResourceShareInvitationAccepted(ctx context.Context, conn *ram.Client, arn string, timeout time.Duration) (*awstypes.ResourceShareInvitation, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceShareInvitationStatusPending),
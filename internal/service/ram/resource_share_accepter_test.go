@@ -96,6 +96,34 @@ func TestAccRAMResourceShareAccepter_disappears(t *testing.T) {
 	})
 }
 
+func TestAccRAMResourceShareAccepter_waitForInvitation(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ram_resource_share_accepter.test"
+	resourceShareResourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckResourceShareAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareAccepterConfig_waitForInvitation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareAccepterExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "share_arn", resourceShareResourceName, names.AttrARN),
+					acctest.MatchResourceAttrRegionalARNAccountID(resourceName, "invitation_arn", "ram", `\d{12}`, regexache.MustCompile(fmt.Sprintf("resource-share-invitation/%s$", verify.UUIDRegexPattern))),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ResourceShareStatusActive)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRAMResourceShareAccepter_resourceAssociation(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ram_resource_share_accepter.test"
@@ -198,6 +226,32 @@ data "aws_caller_identity" "receiver" {}
 `, rName))
 }
 
+// testAccResourceShareAccepterConfig_waitForInvitation creates the accepter concurrently with the principal association
+// so that the accepter has to wait for the invitation to be sent.
+func testAccResourceShareAccepterConfig_waitForInvitation(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_ram_resource_share_accepter" "test" {
+  share_arn = aws_ram_resource_share.test.arn
+}
+
+resource "aws_ram_resource_share" "test" {
+  provider = "awsalternate"
+
+  name                      = %[1]q
+  allow_external_principals = true
+}
+
+resource "aws_ram_principal_association" "test" {
+  provider = "awsalternate"
+
+  principal          = data.aws_caller_identity.receiver.account_id
+  resource_share_arn = aws_ram_resource_share.test.arn
+}
+
+data "aws_caller_identity" "receiver" {}
+`, rName))
+}
+
 func testAccResourceShareAccepterConfig_association(rName string) string {
 	return acctest.ConfigCompose(testAccResourceShareAccepterConfig_basic(rName), fmt.Sprintf(`
 resource "aws_ram_resource_association" "test" {
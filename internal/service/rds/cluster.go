@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -142,6 +143,13 @@ func resourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cluster_scalability_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ClusterScalabilityType](),
+			},
 			"copy_tags_to_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -1091,6 +1099,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.CACertificateIdentifier = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("cluster_scalability_type"); ok {
+			input.ClusterScalabilityType = types.ClusterScalabilityType(v.(string))
+		}
+
 		if v := d.Get(names.AttrDatabaseName); v.(string) != "" {
 			input.DatabaseName = aws.String(v.(string))
 		}
@@ -1309,6 +1321,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return aws.ToString(v.DBInstanceIdentifier)
 	}))
 	d.Set("cluster_resource_id", dbc.DbClusterResourceId)
+	d.Set("cluster_scalability_type", dbc.ClusterScalabilityType)
 	d.Set("copy_tags_to_snapshot", dbc.CopyTagsToSnapshot)
 	// Only set the DatabaseName if it is not nil. There is a known API bug where
 	// RDS accepts a DatabaseName but does not return it, causing a perpetual
@@ -39,6 +39,12 @@ func resourceClusterEndpoint() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -116,10 +122,7 @@ func resourceClusterEndpointCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(endpointID)
 
-	const (
-		timeout = 30 * time.Minute
-	)
-	if _, err := waitClusterEndpointCreated(ctx, conn, d.Id(), timeout); err != nil {
+	if _, err := waitClusterEndpointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster Endpoint (%s) create: %s", d.Id(), err)
 	}
 
@@ -183,6 +186,10 @@ func resourceClusterEndpointUpdate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "modifying RDS Cluster Endpoint (%s): %s", d.Id(), err)
 		}
+
+		if _, err := waitClusterEndpointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster Endpoint (%s) update: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceClusterEndpointRead(ctx, d, meta)...)
@@ -294,9 +301,28 @@ func waitClusterEndpointCreated(ctx context.Context, conn *rds.Client, id string
 	return nil, err
 }
 
+func waitClusterEndpointUpdated(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBClusterEndpoint, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{clusterEndpointStatusModifying},
+		Target:     []string{clusterEndpointStatusAvailable},
+		Refresh:    statusClusterEndpoint(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBClusterEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitClusterEndpointDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBClusterEndpoint, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{clusterEndpointStatusAvailable, clusterEndpointStatusDeleting},
+		Pending:    []string{clusterEndpointStatusAvailable, clusterEndpointStatusDeleting, clusterEndpointStatusModifying},
 		Target:     []string{},
 		Refresh:    statusClusterEndpoint(ctx, conn, id),
 		Timeout:    timeout,
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -116,6 +117,60 @@ func TestAccRDSClusterEndpoint_tags(t *testing.T) {
 	})
 }
 
+func TestAccRDSClusterEndpoint_members(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var v1, v2, v3 types.DBClusterEndpoint
+	resourceName := "aws_rds_cluster_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterEndpointConfig_staticMembers(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterEndpointExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "excluded_members.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "static_members.*", "aws_rds_cluster_instance.test1", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterEndpointConfig_staticMembers(rName, "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterEndpointExists(ctx, resourceName, &v2),
+					testAccCheckClusterEndpointNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "excluded_members.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "static_members.*", "aws_rds_cluster_instance.test2", names.AttrID),
+				),
+			},
+			{
+				Config: testAccClusterEndpointConfig_excludedMembers(rName, "test1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterEndpointExists(ctx, resourceName, &v3),
+					testAccCheckClusterEndpointNotRecreated(&v2, &v3),
+					resource.TestCheckResourceAttr(resourceName, "excluded_members.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "excluded_members.*", "aws_rds_cluster_instance.test1", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "static_members.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckClusterEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
@@ -163,6 +218,16 @@ func testAccCheckClusterEndpointExists(ctx context.Context, n string, v *types.D
 	}
 }
 
+func testAccCheckClusterEndpointNotRecreated(before, after *types.DBClusterEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.DBClusterEndpointResourceIdentifier), aws.ToString(after.DBClusterEndpointResourceIdentifier); before != after {
+			return fmt.Errorf("RDS Cluster Endpoint (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccClusterEndpointConfig_base(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
 data "aws_rds_orderable_db_instance" "test" {
@@ -224,6 +289,30 @@ resource "aws_rds_cluster_endpoint" "default" {
 `, rName))
 }
 
+func testAccClusterEndpointConfig_staticMembers(rName, member string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_endpoint" "test" {
+  cluster_identifier          = aws_rds_cluster.default.id
+  cluster_endpoint_identifier = %[1]q
+  custom_endpoint_type        = "READER"
+
+  static_members = [aws_rds_cluster_instance.%[2]s.id]
+}
+`, rName, member))
+}
+
+func testAccClusterEndpointConfig_excludedMembers(rName, member string) string {
+	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_endpoint" "test" {
+  cluster_identifier          = aws_rds_cluster.default.id
+  cluster_endpoint_identifier = %[1]q
+  custom_endpoint_type        = "READER"
+
+  excluded_members = [aws_rds_cluster_instance.%[2]s.id]
+}
+`, rName, member))
+}
+
 func testAccClusterEndpointConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccClusterEndpointConfig_base(rName), fmt.Sprintf(`
 resource "aws_rds_cluster_endpoint" "reader" {
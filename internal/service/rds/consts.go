@@ -51,6 +51,7 @@ const (
 	clusterEndpointStatusAvailable = "available"
 	clusterEndpointStatusCreating  = "creating"
 	clusterEndpointStatusDeleting  = "deleting"
+	clusterEndpointStatusModifying = "modifying"
 )
 
 const (
@@ -117,11 +118,16 @@ const (
 )
 
 const (
-	globalClusterStatusAvailable = "available"
-	globalClusterStatusCreating  = "creating"
-	globalClusterStatusDeleting  = "deleting"
-	globalClusterStatusModifying = "modifying"
-	globalClusterStatusUpgrading = "upgrading"
+	globalClusterStatusAvailable     = "available"
+	globalClusterStatusCreating      = "creating"
+	globalClusterStatusDeleting      = "deleting"
+	globalClusterStatusFailingOver   = "failing-over"
+	globalClusterStatusModifying     = "modifying"
+	globalClusterStatusSwitchingOver = "switching-over"
+	globalClusterStatusUpgrading     = "upgrading"
+
+	// Non-standard status for internal use.
+	globalClusterStatusAvailableWithUnsynchronizedMembers = "tf-available-with-unsynchronized-members"
 )
 
 const (
@@ -172,6 +178,30 @@ const (
 	globalClusterEngineAuroraPostgreSQL = "aurora-postgresql"
 )
 
+const (
+	replicaPromotionPolicyNone    = "none"
+	replicaPromotionPolicyPromote = "promote"
+)
+
+const (
+	globalClusterFailoverTypeFailover   = "failover"
+	globalClusterFailoverTypeSwitchover = "switchover"
+)
+
+func globalClusterFailoverType_Values() []string {
+	return []string{
+		globalClusterFailoverTypeFailover,
+		globalClusterFailoverTypeSwitchover,
+	}
+}
+
+func replicaPromotionPolicy_Values() []string {
+	return []string{
+		replicaPromotionPolicyNone,
+		replicaPromotionPolicyPromote,
+	}
+}
+
 func globalClusterEngine_Values() []string {
 	return []string{
 		globalClusterEngineAurora,
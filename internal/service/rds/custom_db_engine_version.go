@@ -214,24 +214,6 @@ func resourceCustomDBEngineVersionCreate(ctx context.Context, d *schema.Resource
 		input.ImageId = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("filename"); ok {
-		filename := v.(string)
-		// Grab an exclusive lock so that we're only reading one contact flow into
-		// memory at a time.
-		// See https://github.com/hashicorp/terraform/issues/9364
-		conns.GlobalMutexKV.Lock(mutexKey)
-		defer conns.GlobalMutexKV.Unlock(mutexKey)
-
-		file, err := tfio.ReadFileContents(filename)
-		if err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
-		}
-
-		input.Manifest = aws.String(string(file))
-	} else if v, ok := d.GetOk("manifest"); ok {
-		input.Manifest = aws.String(v.(string))
-	}
-
 	output, err := conn.CreateCustomDBEngineVersion(ctx, input)
 
 	if err != nil {
@@ -430,6 +412,8 @@ const (
 	statusDeleting          = "deleting"
 	statusDeprecated        = "deprecated"
 	statusFailed            = "failed"
+	statusInactive          = "inactive"
+	statusInactiveRestore   = "inactive-except-restore"
 	statusPendingValidation = "pending-validation" // Custom for SQL Server, ready for validation by an instance
 )
 
@@ -471,7 +455,7 @@ func waitCustomDBEngineVersionCreated(ctx context.Context, conn *rds.Client, eng
 func waitCustomDBEngineVersionUpdated(ctx context.Context, conn *rds.Client, engine, engineVersion string, timeout time.Duration) (*types.DBEngineVersion, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{statusAvailable},
-		Target:  []string{statusAvailable, statusPendingValidation},
+		Target:  []string{statusAvailable, statusPendingValidation, statusInactive, statusInactiveRestore},
 		Refresh: statusDBEngineVersion(ctx, conn, engine, engineVersion),
 		Timeout: timeout,
 	}
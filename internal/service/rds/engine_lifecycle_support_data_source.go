@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_rds_engine_lifecycle_support", name="Engine Lifecycle Support")
+func newEngineLifecycleSupportDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &engineLifecycleSupportDataSource{}, nil
+}
+
+type engineLifecycleSupportDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*engineLifecycleSupportDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_rds_engine_lifecycle_support"
+}
+
+func (d *engineLifecycleSupportDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrEngine: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"major_engine_version": schema.StringAttribute{
+				Optional: true,
+			},
+			"major_engine_versions": framework.DataSourceComputedListOfObjectAttribute[majorEngineVersionModel](ctx),
+		},
+	}
+}
+
+func (d *engineLifecycleSupportDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data engineLifecycleSupportDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().RDSClient(ctx)
+
+	input := &rds.DescribeDBMajorEngineVersionsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	majorEngineVersions, err := findDBMajorEngineVersions(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Engine Lifecycle Support (%s)", data.Engine.ValueString()), err.Error())
+
+		return
+	}
+
+	output := &rds.DescribeDBMajorEngineVersionsOutput{
+		DBMajorEngineVersions: majorEngineVersions,
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("DB"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Engine
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findDBMajorEngineVersions(ctx context.Context, conn *rds.Client, input *rds.DescribeDBMajorEngineVersionsInput) ([]awstypes.DBMajorEngineVersion, error) {
+	var output []awstypes.DBMajorEngineVersion
+
+	pages := rds.NewDescribeDBMajorEngineVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DBMajorEngineVersions...)
+	}
+
+	return output, nil
+}
+
+type engineLifecycleSupportDataSourceModel struct {
+	Engine              types.String                                             `tfsdk:"engine"`
+	ID                  types.String                                             `tfsdk:"id"`
+	MajorEngineVersion  types.String                                             `tfsdk:"major_engine_version"`
+	MajorEngineVersions fwtypes.ListNestedObjectValueOf[majorEngineVersionModel] `tfsdk:"major_engine_versions"`
+}
+
+type majorEngineVersionModel struct {
+	Engine                    types.String                                                   `tfsdk:"engine"`
+	MajorEngineVersion        types.String                                                   `tfsdk:"major_engine_version"`
+	SupportedEngineLifecycles fwtypes.ListNestedObjectValueOf[supportedEngineLifecycleModel] `tfsdk:"supported_engine_lifecycles"`
+}
+
+type supportedEngineLifecycleModel struct {
+	LifecycleSupportEndDate   timetypes.RFC3339                                 `tfsdk:"lifecycle_support_end_date"`
+	LifecycleSupportName      fwtypes.StringEnum[awstypes.LifecycleSupportName] `tfsdk:"lifecycle_support_name"`
+	LifecycleSupportStartDate timetypes.RFC3339                                 `tfsdk:"lifecycle_support_start_date"`
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSEngineLifecycleSupportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_engine_lifecycle_support.test"
+	engine := tfrds.InstanceEnginePostgres
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineLifecycleSupportDataSourceConfig_basic(engine, "13"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrEngine, engine),
+					resource.TestCheckResourceAttr(dataSourceName, "major_engine_versions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "major_engine_versions.0.engine", engine),
+					resource.TestCheckResourceAttr(dataSourceName, "major_engine_versions.0.major_engine_version", "13"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "major_engine_versions.0.supported_engine_lifecycles.*", map[string]string{
+						"lifecycle_support_name": "open-source-rds-standard-support",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "major_engine_versions.0.supported_engine_lifecycles.*", map[string]string{
+						"lifecycle_support_name": "open-source-rds-extended-support",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSEngineLifecycleSupportDataSource_engine(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_engine_lifecycle_support.test"
+	engine := tfrds.InstanceEngineMySQL
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineLifecycleSupportDataSourceConfig_engine(engine),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrEngine, engine),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "major_engine_versions.#", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccEngineLifecycleSupportDataSourceConfig_basic(engine, majorEngineVersion string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_lifecycle_support" "test" {
+  engine               = %[1]q
+  major_engine_version = %[2]q
+}
+`, engine, majorEngineVersion)
+}
+
+func testAccEngineLifecycleSupportDataSourceConfig_engine(engine string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_lifecycle_support" "test" {
+  engine = %[1]q
+}
+`, engine)
+}
@@ -253,6 +253,7 @@ func (r *resourceExportTask) Delete(ctx context.Context, req resource.DeleteRequ
 			create.ProblemStandardMessage(names.RDS, create.ErrActionDeleting, ResNameExportTask, state.ID.String(), nil),
 			err.Error(),
 		)
+		return
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
@@ -318,6 +319,10 @@ func waitExportTaskCreated(ctx context.Context, conn *rds.Client, id string, tim
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 	if out, ok := outputRaw.(*awstypes.ExportTask); ok {
+		if err == nil && aws.ToString(out.Status) == StatusFailed {
+			err = fmt.Errorf("export task failed: %s", aws.ToString(out.FailureCause))
+		}
+
 		return out, err
 	}
 
@@ -380,7 +385,7 @@ func (rd *resourceExportTaskData) refreshFromOutput(ctx context.Context, out *aw
 	rd.SourceType = flex.StringValueToFramework(ctx, out.SourceType)
 	rd.Status = flex.StringToFramework(ctx, out.Status)
 	rd.TaskEndTime = timeToFramework(ctx, out.TaskEndTime)
-	rd.TaskStartTime = timeToFramework(ctx, out.TaskEndTime)
+	rd.TaskStartTime = timeToFramework(ctx, out.TaskStartTime)
 	rd.WarningMessage = flex.StringToFramework(ctx, out.WarningMessage)
 }
 
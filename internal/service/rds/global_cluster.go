@@ -97,6 +97,11 @@ func resourceGlobalCluster() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"failover_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(globalClusterFailoverType_Values(), false),
+			},
 			"global_cluster_identifier": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -139,6 +144,11 @@ func resourceGlobalCluster() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -264,7 +274,15 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChange("target_db_cluster_arn") {
+		if v := d.Get("target_db_cluster_arn").(string); v != "" {
+			if err := globalClusterChangeWriter(ctx, conn, d.Id(), v, d.Get("failover_type").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "failover_type", "target_db_cluster_arn") {
 		input := &rds.ModifyGlobalClusterInput{
 			DeletionProtection:      aws.Bool(d.Get(names.AttrDeletionProtection).(bool)),
 			GlobalClusterIdentifier: aws.String(d.Id()),
@@ -512,6 +530,50 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *rds.Client, id string,
 	return nil, err
 }
 
+func statusGlobalClusterWriter(ctx context.Context, conn *rds.Client, id, writerARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGlobalClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.ToString(output.Status)
+		if status == globalClusterStatusAvailable && !globalClusterMembersSynchronized(output.GlobalClusterMembers, writerARN) {
+			status = globalClusterStatusAvailableWithUnsynchronizedMembers
+		}
+
+		return output, status, nil
+	}
+}
+
+func waitGlobalClusterWriterChanged(ctx context.Context, conn *rds.Client, id, writerARN string, timeout time.Duration) (*types.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			globalClusterStatusAvailableWithUnsynchronizedMembers,
+			globalClusterStatusFailingOver,
+			globalClusterStatusModifying,
+			globalClusterStatusSwitchingOver,
+		},
+		Target:  []string{globalClusterStatusAvailable},
+		Refresh: statusGlobalClusterWriter(ctx, conn, id, writerARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitGlobalClusterDeleted(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.GlobalCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        []string{globalClusterStatusAvailable, globalClusterStatusDeleting},
@@ -786,6 +848,78 @@ func waitGlobalClusterMemberUpdated(ctx context.Context, conn *rds.Client, id st
 	return nil, err
 }
 
+// globalClusterChangeWriter makes the specified member DB cluster the writer (primary) of the RDS Global Cluster,
+// either via a planned switchover (the default) or via an unplanned failover that allows data loss.
+func globalClusterChangeWriter(ctx context.Context, conn *rds.Client, globalClusterID, targetARN, failoverType string, timeout time.Duration) error {
+	globalCluster, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Global Cluster (%s): %w", globalClusterID, err)
+	}
+
+	if globalClusterWriterARN(globalCluster.GlobalClusterMembers) == targetARN {
+		return nil
+	}
+
+	switch failoverType {
+	case globalClusterFailoverTypeFailover:
+		input := &rds.FailoverGlobalClusterInput{
+			AllowDataLoss:             aws.Bool(true),
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetARN),
+		}
+
+		_, err = conn.FailoverGlobalCluster(ctx, input)
+	default:
+		input := &rds.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetARN),
+		}
+
+		_, err = conn.SwitchoverGlobalCluster(ctx, input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("changing RDS Global Cluster (%s) writer to %s: %w", globalClusterID, targetARN, err)
+	}
+
+	if _, err := waitGlobalClusterWriterChanged(ctx, conn, globalClusterID, targetARN, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS Global Cluster (%s) writer change to %s: %w", globalClusterID, targetARN, err)
+	}
+
+	return nil
+}
+
+func globalClusterWriterARN(apiObjects []types.GlobalClusterMember) string {
+	for _, apiObject := range apiObjects {
+		if aws.ToBool(apiObject.IsWriter) {
+			return aws.ToString(apiObject.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
+// globalClusterMembersSynchronized returns whether the specified DB cluster is the writer and all readers are
+// replicating from it.
+func globalClusterMembersSynchronized(apiObjects []types.GlobalClusterMember, writerARN string) bool {
+	if globalClusterWriterARN(apiObjects) != writerARN {
+		return false
+	}
+
+	for _, apiObject := range apiObjects {
+		if aws.ToBool(apiObject.IsWriter) {
+			continue
+		}
+
+		if v := apiObject.SynchronizationStatus; v != "" && v != types.GlobalClusterMemberSynchronizationStatusConnected {
+			return false
+		}
+	}
+
+	return true
+}
+
 func flattenGlobalClusterMembers(apiObjects []types.GlobalClusterMember) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
@@ -479,6 +479,52 @@ func TestAccRDSGlobalCluster_EngineVersion_auroraPostgreSQL(t *testing.T) {
 	})
 }
 
+func TestAccRDSGlobalCluster_switchover(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var globalCluster1, globalCluster2, globalCluster3 types.GlobalCluster
+	rNameGlobal := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePrimary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameSecondary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_global_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckGlobalCluster(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckGlobalClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_switchover(rNameGlobal, rNamePrimary, rNameSecondary, "local.primary_arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster1),
+					resource.TestCheckResourceAttr(resourceName, "global_cluster_members.#", "2"),
+					testAccCheckGlobalClusterWriter(&globalCluster1, "aws_rds_cluster.primary"),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_switchover(rNameGlobal, rNamePrimary, rNameSecondary, "local.secondary_arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster2),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster1, &globalCluster2),
+					testAccCheckGlobalClusterWriter(&globalCluster2, "aws_rds_cluster.secondary"),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_switchover(rNameGlobal, rNamePrimary, rNameSecondary, "local.primary_arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &globalCluster3),
+					testAccCheckGlobalClusterNotRecreated(&globalCluster2, &globalCluster3),
+					testAccCheckGlobalClusterWriter(&globalCluster3, "aws_rds_cluster.primary"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSGlobalCluster_forceDestroy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var globalCluster1 types.GlobalCluster
@@ -685,6 +731,28 @@ func testAccCheckGlobalClusterNotRecreated(i, j *types.GlobalCluster) resource.T
 	}
 }
 
+func testAccCheckGlobalClusterWriter(v *types.GlobalCluster, clusterResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[clusterResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", clusterResourceName)
+		}
+
+		want := rs.Primary.Attributes[names.AttrARN]
+		for _, member := range v.GlobalClusterMembers {
+			if aws.ToBool(member.IsWriter) {
+				if got := aws.ToString(member.DBClusterArn); got != want {
+					return fmt.Errorf("RDS Global Cluster writer is %s, expected %s", got, want)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS Global Cluster (%s) has no writer", aws.ToString(v.GlobalClusterIdentifier))
+	}
+}
+
 func testAccCheckGlobalClusterRecreated(i, j *types.GlobalCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.ToString(i.GlobalClusterResourceId) == aws.ToString(j.GlobalClusterResourceId) {
@@ -1191,6 +1259,133 @@ resource "aws_rds_cluster_instance" "secondary" {
 `, engine, mainInstanceClasses, upgrade, rNameGlobal, rNamePrimary, rNameSecondary))
 }
 
+func testAccGlobalClusterConfig_switchover(rNameGlobal, rNamePrimary, rNameSecondary, target string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+  state    = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+data "aws_rds_engine_version" "test" {
+  engine = "aurora-postgresql"
+  latest = true
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.test.engine
+  engine_version             = data.aws_rds_engine_version.test.version_actual
+  preferred_instance_classes = [%[1]s]
+  supports_clusters          = true
+  supports_global_databases  = true
+}
+
+locals {
+  primary_arn   = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:cluster:%[3]s"
+  secondary_arn = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.alternate.name}:${data.aws_caller_identity.current.account_id}:cluster:%[4]s"
+}
+
+resource "aws_rds_global_cluster" "test" {
+  global_cluster_identifier = %[2]q
+  engine                    = data.aws_rds_engine_version.test.engine
+  engine_version            = data.aws_rds_engine_version.test.version_actual
+  target_db_cluster_arn     = %[5]s
+}
+
+resource "aws_rds_cluster" "primary" {
+  apply_immediately         = true
+  cluster_identifier        = %[3]q
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  master_password           = "avoid-plaintext-passwords"
+  master_username           = "tfacctest"
+  skip_final_snapshot       = true
+
+  lifecycle {
+    ignore_changes = [replication_source_identifier]
+  }
+}
+
+resource "aws_rds_cluster_instance" "primary" {
+  apply_immediately  = true
+  cluster_identifier = aws_rds_cluster.primary.id
+  engine             = aws_rds_cluster.primary.engine
+  engine_version     = aws_rds_cluster.primary.engine_version
+  identifier         = %[3]q
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+resource "aws_vpc" "alternate" {
+  provider   = "awsalternate"
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[4]q
+  }
+}
+
+resource "aws_subnet" "alternate" {
+  provider          = "awsalternate"
+  count             = 3
+  vpc_id            = aws_vpc.alternate.id
+  availability_zone = data.aws_availability_zones.alternate.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+
+  tags = {
+    Name = %[4]q
+  }
+}
+
+resource "aws_db_subnet_group" "alternate" {
+  provider   = "awsalternate"
+  name       = %[4]q
+  subnet_ids = aws_subnet.alternate[*].id
+}
+
+resource "aws_rds_cluster" "secondary" {
+  provider                  = "awsalternate"
+  apply_immediately         = true
+  cluster_identifier        = %[4]q
+  db_subnet_group_name      = aws_db_subnet_group.alternate.name
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  skip_final_snapshot       = true
+
+  lifecycle {
+    ignore_changes = [replication_source_identifier]
+  }
+
+  depends_on = [aws_rds_cluster_instance.primary]
+}
+
+resource "aws_rds_cluster_instance" "secondary" {
+  provider           = "awsalternate"
+  apply_immediately  = true
+  cluster_identifier = aws_rds_cluster.secondary.id
+  engine             = aws_rds_cluster.secondary.engine
+  engine_version     = aws_rds_cluster.secondary.engine_version
+  identifier         = %[4]q
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+`, mainInstanceClasses, rNameGlobal, rNamePrimary, rNameSecondary, target))
+}
+
 func testAccGlobalClusterConfig_sourceClusterID(rName string) string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "default" {
@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -416,7 +417,7 @@ func resourceInstance() *schema.Resource {
 			"manage_master_user_password": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{names.AttrPassword},
+				ConflictsWith: []string{names.AttrPassword, "password_wo"},
 			},
 			"master_user_secret": {
 				Type:     schema.TypeList,
@@ -497,7 +498,19 @@ func resourceInstance() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"manage_master_user_password"},
+				ConflictsWith: []string{"manage_master_user_password", "password_wo"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"manage_master_user_password", names.AttrPassword},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
@@ -535,6 +548,12 @@ func resourceInstance() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: enum.Validate[types.ReplicaMode](),
 			},
+			"replica_promotion_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      replicaPromotionPolicyNone,
+				ValidateFunc: validation.StringInSlice(replicaPromotionPolicy_Values(), false),
+			},
 			"replicas": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -686,6 +705,10 @@ func resourceInstance() *schema.Resource {
 			},
 		},
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath(names.AttrPassword), cty.GetAttrPath("password_wo")),
+		},
+
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
@@ -732,6 +755,12 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
+	passwordWO, d2 := sdkv2.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, d2...)
+	if diags.HasError() {
+		return diags
+	}
+
 	// Some API calls (e.g. CreateDBInstanceReadReplica, RestoreDBInstanceFromDBSnapshot
 	// RestoreDBInstanceToPointInTime do not support all parameters to
 	// correctly apply all settings in one pass. For missing parameters or
@@ -1001,6 +1030,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			modifyDbInstanceInput.MasterUserPassword = aws.String(v.(string))
 			requiresModifyDbInstance = true
 		}
+
+		if passwordWO != "" {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(passwordWO)
+			requiresModifyDbInstance = true
+		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
 		if _, ok := d.GetOk(names.AttrAllocatedStorage); !ok {
 			diags = sdkdiag.AppendErrorf(diags, `"allocated_storage": required field is not set`)
@@ -1114,6 +1148,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.MasterUserPassword = aws.String(v.(string))
 		}
 
+		if passwordWO != "" {
+			input.MasterUserPassword = aws.String(passwordWO)
+		}
+
 		if v, ok := d.GetOk("performance_insights_enabled"); ok {
 			input.EnablePerformanceInsights = aws.Bool(v.(bool))
 		}
@@ -1357,6 +1395,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			requiresModifyDbInstance = true
 		}
 
+		if passwordWO != "" {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(passwordWO)
+			requiresModifyDbInstance = true
+		}
+
 		if v, ok := d.GetOk("performance_insights_enabled"); ok {
 			modifyDbInstanceInput.EnablePerformanceInsights = aws.Bool(v.(bool))
 			requiresModifyDbInstance = true
@@ -1583,6 +1626,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			requiresModifyDbInstance = true
 		}
 
+		if passwordWO != "" {
+			modifyDbInstanceInput.MasterUserPassword = aws.String(passwordWO)
+			requiresModifyDbInstance = true
+		}
+
 		if v, ok := d.GetOk(names.AttrPort); ok {
 			input.Port = aws.Int32(int32(v.(int)))
 		}
@@ -1779,6 +1827,10 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			input.MasterUserPassword = aws.String(v.(string))
 		}
 
+		if passwordWO != "" {
+			input.MasterUserPassword = aws.String(passwordWO)
+		}
+
 		if v, ok := d.GetOk(names.AttrParameterGroupName); ok {
 			input.DBParameterGroupName = aws.String(v.(string))
 		}
@@ -1889,6 +1941,12 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if _, ok := d.GetOk("replicate_source_db"); ok && d.Get("replica_promotion_policy").(string) == replicaPromotionPolicyPromote {
+		if err := promoteInstanceReadReplica(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
@@ -2069,27 +2127,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
 
 	// Separate request to promote a database.
-	if d.HasChange("replicate_source_db") {
-		if d.Get("replicate_source_db").(string) == "" {
-			input := &rds.PromoteReadReplicaInput{
-				BackupRetentionPeriod: aws.Int32(int32(d.Get("backup_retention_period").(int))),
-				DBInstanceIdentifier:  aws.String(d.Get(names.AttrIdentifier).(string)),
-			}
-
-			if attr, ok := d.GetOk("backup_window"); ok {
-				input.PreferredBackupWindow = aws.String(attr.(string))
-			}
-
-			_, err := conn.PromoteReadReplica(ctx, input)
-
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "promoting RDS DB Instance (%s): %s", d.Get(names.AttrIdentifier).(string), err)
-			}
-
-			if _, err := waitDBInstanceAvailable(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
-				return sdkdiag.AppendErrorf(diags, "promoting RDS DB Instance (%s): waiting for completion: %s", d.Get(names.AttrIdentifier).(string), err)
+	if d.HasChanges("replica_promotion_policy", "replicate_source_db") {
+		o, n := d.GetChange("replicate_source_db")
+		if promote := d.Get("replica_promotion_policy").(string) == replicaPromotionPolicyPromote; o.(string) != "" && (n.(string) == "" || promote) {
+			if err := promoteInstanceReadReplica(ctx, conn, d, deadline.Remaining()); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
 			}
-		} else {
+		} else if d.HasChange("replicate_source_db") {
 			return sdkdiag.AppendErrorf(diags, "cannot elect new source database for replication")
 		}
 	}
@@ -2101,6 +2145,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		"blue_green_update",
 		"delete_automated_backups",
 		names.AttrFinalSnapshotIdentifier,
+		"replica_promotion_policy",
 		"replicate_source_db",
 		"skip_final_snapshot",
 		names.AttrTags, names.AttrTagsAll,
@@ -2110,11 +2155,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			"blue_green_update",
 			"delete_automated_backups",
 			names.AttrFinalSnapshotIdentifier,
+			"replica_promotion_policy",
 			"replicate_source_db",
 			"skip_final_snapshot",
 			names.AttrTags, names.AttrTagsAll,
 			names.AttrDeletionProtection,
 			names.AttrPassword,
+			"password_wo_version",
 		) {
 			orchestrator := newBlueGreenOrchestrator(conn)
 			defer orchestrator.CleanUp(ctx)
@@ -2384,6 +2431,7 @@ func resourceInstanceImport(_ context.Context, d *schema.ResourceData, meta inte
 	// that final_snapshot_identifier is not required.
 	d.Set("skip_final_snapshot", true)
 	d.Set("delete_automated_backups", true)
+	d.Set("replica_promotion_policy", replicaPromotionPolicyNone)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -2461,18 +2509,24 @@ func dbInstancePopulateModify(input *rds.ModifyDBInstanceInput, d *schema.Resour
 	input.DeletionProtection = aws.Bool(d.Get(names.AttrDeletionProtection).(bool))
 
 	// "InvalidParameterCombination: Specify the parameters for either AWS Managed Active Directory or self-managed Active Directory".
-	if d.HasChanges(names.AttrDomain, "domain_iam_role_name") {
+	if d.HasChanges(names.AttrDomain, "domain_auth_secret_arn", "domain_dns_ips", "domain_fqdn", "domain_iam_role_name", "domain_ou") {
 		needsModify = true
-		input.Domain = aws.String(d.Get(names.AttrDomain).(string))
-		input.DomainIAMRoleName = aws.String(d.Get("domain_iam_role_name").(string))
-	} else if d.HasChanges("domain_auth_secret_arn", "domain_dns_ips", "domain_fqdn", "domain_ou") {
-		needsModify = true
-		input.DomainAuthSecretArn = aws.String(d.Get("domain_auth_secret_arn").(string))
-		if v, ok := d.GetOk("domain_dns_ips"); ok && len(v.([]interface{})) > 0 {
-			input.DomainDnsIps = flex.ExpandStringValueList(v.([]interface{}))
+
+		switch {
+		case d.Get(names.AttrDomain).(string) != "":
+			input.Domain = aws.String(d.Get(names.AttrDomain).(string))
+			input.DomainIAMRoleName = aws.String(d.Get("domain_iam_role_name").(string))
+		// domain_fqdn is Computed, so use the required domain_auth_secret_arn to detect a self-managed Active Directory.
+		case d.Get("domain_auth_secret_arn").(string) != "":
+			input.DomainAuthSecretArn = aws.String(d.Get("domain_auth_secret_arn").(string))
+			if v, ok := d.GetOk("domain_dns_ips"); ok && len(v.([]interface{})) > 0 {
+				input.DomainDnsIps = flex.ExpandStringValueList(v.([]interface{}))
+			}
+			input.DomainFqdn = aws.String(d.Get("domain_fqdn").(string))
+			input.DomainOu = aws.String(d.Get("domain_ou").(string))
+		default:
+			input.DisableDomain = aws.Bool(true)
 		}
-		input.DomainFqdn = aws.String(d.Get("domain_fqdn").(string))
-		input.DomainOu = aws.String(d.Get("domain_ou").(string))
 	}
 
 	if d.HasChange("enabled_cloudwatch_logs_exports") {
@@ -2576,6 +2630,15 @@ func dbInstancePopulateModify(input *rds.ModifyDBInstanceInput, d *schema.Resour
 		}
 	}
 
+	// Write-only password changes are signalled by a change to the version attribute.
+	// The attribute's type is known so the only possible diagnostics are for a missing value.
+	if d.HasChange("password_wo_version") {
+		if v, diags := sdkv2.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo")); !diags.HasError() && v != "" {
+			needsModify = true
+			input.MasterUserPassword = aws.String(v)
+		}
+	}
+
 	if d.HasChanges("performance_insights_enabled", "performance_insights_kms_key_id", "performance_insights_retention_period") {
 		needsModify = true
 		input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
@@ -3171,7 +3234,37 @@ func stopInstance(ctx context.Context, conn *rds.Client, id string, timeout time
 	return nil
 }
 
-func instanceReplicateSourceDBSuppressDiff(_, old, new string, _ *schema.ResourceData) bool {
+// promoteInstanceReadReplica promotes the read replica to a standalone DB instance.
+func promoteInstanceReadReplica(ctx context.Context, conn *rds.Client, d *schema.ResourceData, timeout time.Duration) error {
+	identifier := d.Get(names.AttrIdentifier).(string)
+	input := &rds.PromoteReadReplicaInput{
+		BackupRetentionPeriod: aws.Int32(int32(d.Get("backup_retention_period").(int))),
+		DBInstanceIdentifier:  aws.String(identifier),
+	}
+
+	if attr, ok := d.GetOk("backup_window"); ok {
+		input.PreferredBackupWindow = aws.String(attr.(string))
+	}
+
+	_, err := conn.PromoteReadReplica(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("promoting RDS DB Instance (%s): %w", identifier, err)
+	}
+
+	if _, err := waitDBInstanceAvailable(ctx, conn, d.Id(), timeout); err != nil {
+		return fmt.Errorf("promoting RDS DB Instance (%s): waiting for completion: %w", identifier, err)
+	}
+
+	return nil
+}
+
+func instanceReplicateSourceDBSuppressDiff(_, old, new string, d *schema.ResourceData) bool {
+	// A read replica promoted to a standalone instance no longer reports its source.
+	if old == "" && new != "" && d.Id() != "" && d.Get("replica_promotion_policy").(string) == replicaPromotionPolicyPromote {
+		return true
+	}
+
 	// Ideally, we'd be able to check the partition, region, and accountID, but that's not available in SDK
 	if arn.IsARN(old) {
 		if new != "" && !arn.IsARN(new) {
@@ -39,6 +39,7 @@ func newIntegrationResource(_ context.Context) (resource.ResourceWithConfigure,
 	r := &integrationResource{}
 
 	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
 	r.SetDefaultDeleteTimeout(30 * time.Minute)
 
 	return r, nil
@@ -56,7 +57,6 @@ const (
 
 type integrationResource struct {
 	framework.ResourceWithConfigure
-	framework.WithNoOpUpdate[integrationResourceModel]
 	framework.WithImportByID
 	framework.WithTimeouts
 }
@@ -77,7 +77,17 @@ func (r *integrationResource) Schema(ctx context.Context, request resource.Schem
 				},
 			},
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
-			names.AttrID:  framework.IDAttribute(),
+			"data_filter": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
 			"integration_name": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -112,6 +122,7 @@ func (r *integrationResource) Schema(ctx context.Context, request resource.Schem
 		Blocks: map[string]schema.Block{
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 		},
@@ -159,6 +170,7 @@ func (r *integrationResource) Create(ctx context.Context, request resource.Creat
 	}
 
 	// Set values for unknowns.
+	data.DataFilter = fwflex.StringToFramework(ctx, integration.DataFilter)
 	data.KMSKeyID = fwflex.StringToFramework(ctx, integration.KMSKeyId)
 
 	response.Diagnostics.Append(response.State.Set(ctx, data)...)
@@ -212,6 +224,54 @@ func (r *integrationResource) Read(ctx context.Context, request resource.ReadReq
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
+func (r *integrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new integrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	if !new.DataFilter.Equal(old.DataFilter) || !new.Description.Equal(old.Description) {
+		input := &rds.ModifyIntegrationInput{
+			IntegrationIdentifier: new.ID.ValueStringPointer(),
+		}
+
+		if !new.DataFilter.Equal(old.DataFilter) {
+			input.DataFilter = fwflex.StringFromFramework(ctx, new.DataFilter)
+		}
+
+		if !new.Description.Equal(old.Description) {
+			input.Description = aws.String(new.Description.ValueString())
+		}
+
+		_, err := conn.ModifyIntegration(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating RDS Integration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		integration, err := waitIntegrationUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Integration (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.DataFilter = fwflex.StringToFramework(ctx, integration.DataFilter)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
 func (r *integrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	var data integrationResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
@@ -327,6 +387,25 @@ func waitIntegrationCreated(ctx context.Context, conn *rds.Client, arn string, t
 	return nil, err
 }
 
+func waitIntegrationUpdated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{integrationStatusModifying},
+		Target:  []string{integrationStatusActive},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Errors, integrationError)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitIntegrationDeleted(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{integrationStatusDeleting, integrationStatusActive},
@@ -352,6 +431,8 @@ func integrationError(v awstypes.IntegrationError) error {
 
 type integrationResourceModel struct {
 	AdditionalEncryptionContext fwtypes.MapValueOf[types.String] `tfsdk:"additional_encryption_context"`
+	DataFilter                  types.String                     `tfsdk:"data_filter"`
+	Description                 types.String                     `tfsdk:"description"`
 	ID                          types.String                     `tfsdk:"id"`
 	IntegrationARN              types.String                     `tfsdk:"arn"`
 	IntegrationName             types.String                     `tfsdk:"integration_name"`
@@ -127,6 +127,8 @@ func TestAccRDSIntegration_optional(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_rds_cluster.test", names.AttrARN),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_redshiftserverless_namespace.test", names.AttrARN),
 					resource.TestCheckResourceAttr(resourceName, "additional_encryption_context.department", "test"),
+					resource.TestCheckResourceAttr(resourceName, "data_filter", "include: test.mytable"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "A test integration"),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
 				),
@@ -448,6 +450,8 @@ resource "aws_rds_integration" "test" {
   source_arn       = aws_rds_cluster.test.arn
   target_arn       = aws_redshiftserverless_namespace.test.arn
   kms_key_id       = aws_kms_key.test.arn
+  data_filter      = "include: test.mytable"
+  description      = "A test integration"
 
   additional_encryption_context = {
     "department" : "test",
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_rds_master_user_secret, name="Master User Secret")
+func newMasterUserSecretEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &masterUserSecretEphemeralResource{}, nil
+}
+
+type masterUserSecretEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (*masterUserSecretEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_rds_master_user_secret"
+}
+
+func (e *masterUserSecretEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Optional: true,
+			},
+			"db_instance_identifier": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("db_cluster_identifier")),
+				},
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"secret_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"secret_status": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *masterUserSecretEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data masterUserSecretEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().RDSClient(ctx)
+
+	var masterUserSecret *awstypes.MasterUserSecret
+	if id := data.DBInstanceIdentifier.ValueString(); id != "" {
+		output, err := findDBInstanceByID(ctx, conn, id)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading RDS DB Instance (%s)", id), err.Error())
+
+			return
+		}
+
+		if output.MasterUserSecret == nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading RDS DB Instance (%s)", id), "master user password is not managed by AWS Secrets Manager")
+
+			return
+		}
+
+		masterUserSecret = output.MasterUserSecret
+	} else {
+		id := data.DBClusterIdentifier.ValueString()
+		output, err := findDBClusterByID(ctx, conn, id)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading RDS Cluster (%s)", id), err.Error())
+
+			return
+		}
+
+		if output.MasterUserSecret == nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading RDS Cluster (%s)", id), "master user password is not managed by AWS Secrets Manager")
+
+			return
+		}
+
+		masterUserSecret = output.MasterUserSecret
+	}
+
+	secretARN := aws.ToString(masterUserSecret.SecretArn)
+	output, err := e.Meta().SecretsManagerClient(ctx).GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretARN),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Secrets Manager Secret (%s)", secretARN), err.Error())
+
+		return
+	}
+
+	var credentials struct {
+		Password string `json:"password"`
+		Username string `json:"username"`
+	}
+	if err := json.Unmarshal([]byte(aws.ToString(output.SecretString)), &credentials); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("parsing Secrets Manager Secret (%s)", secretARN), err.Error())
+
+		return
+	}
+
+	data.KMSKeyID = fwflex.StringToFramework(ctx, masterUserSecret.KmsKeyId)
+	data.Password = types.StringValue(credentials.Password)
+	data.SecretARN = fwflex.StringToFramework(ctx, masterUserSecret.SecretArn)
+	data.SecretStatus = fwflex.StringToFramework(ctx, masterUserSecret.SecretStatus)
+	data.Username = types.StringValue(credentials.Username)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type masterUserSecretEphemeralResourceModel struct {
+	DBClusterIdentifier  types.String `tfsdk:"db_cluster_identifier"`
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	KMSKeyID             types.String `tfsdk:"kms_key_id"`
+	Password             types.String `tfsdk:"password"`
+	SecretARN            types.String `tfsdk:"secret_arn"`
+	SecretStatus         types.String `tfsdk:"secret_status"`
+	Username             types.String `tfsdk:"username"`
+}
@@ -260,12 +260,14 @@ func expandConnectionPoolConfiguration(tfMap map[string]interface{}) *types.Conn
 		MaxIdleConnectionsPercent: aws.Int32(int32(tfMap["max_idle_connections_percent"].(int))),
 	}
 
-	if v, ok := tfMap["init_query"].(string); ok && v != "" {
+	// Omitted values leave the existing setting unchanged, so empty values are sent explicitly
+	// to clear a previously configured initialization query or set of session pinning filters.
+	if v, ok := tfMap["init_query"].(string); ok {
 		apiObject.InitQuery = aws.String(v)
 	}
 
-	if v, ok := tfMap["session_pinning_filters"].(*schema.Set); ok && v.Len() > 0 {
-		apiObject.SessionPinningFilters = flex.ExpandStringValueSet(v)
+	if v, ok := tfMap["session_pinning_filters"].(*schema.Set); ok {
+		apiObject.SessionPinningFilters = flex.ExpandStringValueEmptySet(v)
 	}
 
 	return apiObject
@@ -174,6 +174,13 @@ func TestAccRDSProxyDefaultTargetGroup_initQuery(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.init_query", "SET a=2, b=1"),
 				),
 			},
+			{
+				Config: testAccProxyDefaultTargetGroupConfig_emptyConnectionPoolConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyTargetGroupExists(ctx, resourceName, &dbProxyTargetGroup),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.init_query", ""),
+				),
+			},
 		},
 	})
 }
@@ -293,6 +300,13 @@ func TestAccRDSProxyDefaultTargetGroup_sessionPinningFilters(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.session_pinning_filters.0", sessionPinningFilters),
 				),
 			},
+			{
+				Config: testAccProxyDefaultTargetGroupConfig_emptyConnectionPoolConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProxyTargetGroupExists(ctx, resourceName, &dbProxyTargetGroup),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.session_pinning_filters.#", "0"),
+				),
+			},
 		},
 	})
 }
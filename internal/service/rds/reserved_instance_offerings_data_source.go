@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_rds_reserved_instance_offerings", name="Reserved Instance Offerings")
+func dataSourceReservedOfferings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReservedOfferingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"db_instance_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDuration: {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"offering_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Partial Upfront",
+					"All Upfront",
+					"No Upfront",
+				}, false),
+			},
+			"offerings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"currency_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDuration: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"fixed_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"multi_az": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"offering_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"offering_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"recurring_charges": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"recurring_charge_amount": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"recurring_charge_frequency": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"usage_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceReservedOfferingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := &rds.DescribeReservedDBInstancesOfferingsInput{}
+
+	if v, ok := d.GetOk("db_instance_class"); ok {
+		input.DBInstanceClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDuration); ok {
+		input.Duration = aws.String(strconv.Itoa(v.(int)))
+	}
+
+	if v := d.GetRawConfig().GetAttr("multi_az"); v.IsKnown() && !v.IsNull() {
+		input.MultiAZ = aws.Bool(v.True())
+	}
+
+	if v, ok := d.GetOk("offering_type"); ok {
+		input.OfferingType = aws.String(v.(string))
+	}
+
+	productDescription := d.Get("product_description").(string)
+	if productDescription != "" {
+		input.ProductDescription = aws.String(productDescription)
+	}
+
+	// The API returns all products where the product description contains the input product description,
+	// e.g. "mysql" also matches "aurora-mysql", so only keep exact matches.
+	offerings, err := findReservedDBInstancesOfferings(ctx, conn, input, func(v *types.ReservedDBInstancesOffering) bool {
+		return productDescription == "" || aws.ToString(v.ProductDescription) == productDescription
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Reserved Instance Offerings: %s", err)
+	}
+
+	var offeringIDs []string
+	for _, v := range offerings {
+		offeringIDs = append(offeringIDs, aws.ToString(v.ReservedDBInstancesOfferingId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	d.Set("offering_ids", offeringIDs)
+	if err := d.Set("offerings", flattenReservedDBInstancesOfferings(offerings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting offerings: %s", err)
+	}
+
+	return diags
+}
+
+func flattenReservedDBInstancesOfferings(apiObjects []types.ReservedDBInstancesOffering) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"currency_code":       aws.ToString(apiObject.CurrencyCode),
+			"db_instance_class":   aws.ToString(apiObject.DBInstanceClass),
+			names.AttrDuration:    aws.ToInt32(apiObject.Duration),
+			"fixed_price":         aws.ToFloat64(apiObject.FixedPrice),
+			"multi_az":            aws.ToBool(apiObject.MultiAZ),
+			"offering_id":         aws.ToString(apiObject.ReservedDBInstancesOfferingId),
+			"offering_type":       aws.ToString(apiObject.OfferingType),
+			"product_description": aws.ToString(apiObject.ProductDescription),
+			"recurring_charges":   flattenRecurringCharges(apiObject.RecurringCharges),
+			"usage_price":         aws.ToFloat64(apiObject.UsagePrice),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
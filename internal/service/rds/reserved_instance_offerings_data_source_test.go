@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSReservedInstanceOfferingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_reserved_instance_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedInstanceOfferingsDataSourceConfig_basic(testInstanceClass, "postgresql"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "offering_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offerings.0.offering_id", dataSourceName, "offering_ids.0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offerings.0.currency_code"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.db_instance_class", testInstanceClass),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.duration", "31536000"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offerings.0.fixed_price"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.multi_az", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.offering_type", "All Upfront"),
+					resource.TestCheckResourceAttr(dataSourceName, "offerings.0.product_description", "postgresql"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSReservedInstanceOfferingsDataSource_instanceClass(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_reserved_instance_offerings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedInstanceOfferingsDataSourceConfig_instanceClass(testInstanceClass, "mysql"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "offerings.#", 1),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "offerings.*", map[string]string{
+						"db_instance_class":   testInstanceClass,
+						"offering_type":       "No Upfront",
+						"product_description": "mysql",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccReservedInstanceOfferingsDataSourceConfig_basic(class, desc string) string {
+	return fmt.Sprintf(`
+data "aws_rds_reserved_instance_offerings" "test" {
+  db_instance_class   = %[1]q
+  duration            = 31536000
+  multi_az            = false
+  offering_type       = "All Upfront"
+  product_description = %[2]q
+}
+`, class, desc)
+}
+
+func testAccReservedInstanceOfferingsDataSourceConfig_instanceClass(class, desc string) string {
+	return fmt.Sprintf(`
+data "aws_rds_reserved_instance_offerings" "test" {
+  db_instance_class   = %[1]q
+  product_description = %[2]q
+}
+`, class, desc)
+}
@@ -14,12 +14,25 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
+	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory: newMasterUserSecretEphemeralResource,
+			Name:    "Master User Secret",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
 		{
 			Factory: newClusterParameterGroupDataSource,
 			Name:    "Cluster Parameter Group",
 		},
+		{
+			Factory: newEngineLifecycleSupportDataSource,
+			Name:    "Engine Lifecycle Support",
+		},
 	}
 }
 
@@ -46,6 +59,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceInstanceState,
 			Name:    "Instance State",
 		},
+		{
+			Factory: newShardGroupResource,
+			Name:    "Shard Group",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
@@ -125,6 +148,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_rds_reserved_instance_offering",
 			Name:     "Reserved Instance Offering",
 		},
+		{
+			Factory:  dataSourceReservedOfferings,
+			TypeName: "aws_rds_reserved_instance_offerings",
+			Name:     "Reserved Instance Offerings",
+		},
 	}
 }
 
@@ -5,14 +5,17 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -158,9 +161,18 @@ func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
+	sourceDBSnapshotID := d.Get("source_db_snapshot_identifier").(string)
 	targetDBSnapshotID := d.Get("target_db_snapshot_identifier").(string)
+
+	// A snapshot shared from another account must be referenced by ARN.
+	if sourceARN, err := arn.Parse(sourceDBSnapshotID); err == nil && sourceARN.AccountID != meta.(*conns.AWSClient).AccountID(ctx) {
+		if err := validateSharedDBSnapshotSource(ctx, conn, sourceARN, d.Get(names.AttrKMSKeyID).(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RDS DB Snapshot Copy (%s): %s", targetDBSnapshotID, err)
+		}
+	}
+
 	input := &rds.CopyDBSnapshotInput{
-		SourceDBSnapshotIdentifier: aws.String(d.Get("source_db_snapshot_identifier").(string)),
+		SourceDBSnapshotIdentifier: aws.String(sourceDBSnapshotID),
 		Tags:                       getTagsIn(ctx),
 		TargetDBSnapshotIdentifier: aws.String(targetDBSnapshotID),
 	}
@@ -313,3 +325,52 @@ func resourceSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, met
 
 	return diags
 }
+
+// validateSharedDBSnapshotSource verifies that a DB snapshot owned by another account has been shared with
+// the caller and, if the snapshot is encrypted, that a KMS key in the caller's account has been specified.
+func validateSharedDBSnapshotSource(ctx context.Context, conn *rds.Client, sourceARN arn.ARN, kmsKeyID string) error {
+	snapshot, err := findSharedDBSnapshotByARN(ctx, conn, sourceARN.String(), func(o *rds.Options) {
+		o.Region = sourceARN.Region
+	})
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("source DB snapshot (%s) has not been shared with this account", sourceARN)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading source DB snapshot (%s): %w", sourceARN, err)
+	}
+
+	if aws.ToBool(snapshot.Encrypted) && kmsKeyID == "" {
+		return fmt.Errorf("%s must be set when copying encrypted DB snapshot (%s) shared from account %s", names.AttrKMSKeyID, sourceARN, sourceARN.AccountID)
+	}
+
+	return nil
+}
+
+func findSharedDBSnapshotByARN(ctx context.Context, conn *rds.Client, arn string, optFns ...func(*rds.Options)) (*types.DBSnapshot, error) {
+	input := &rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(arn),
+		IncludeShared:        aws.Bool(true),
+		SnapshotType:         aws.String("shared"),
+	}
+
+	output, err := conn.DescribeDBSnapshots(ctx, input, optFns...)
+
+	if errs.IsA[*types.DBSnapshotNotFoundFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.DBSnapshots)
+}
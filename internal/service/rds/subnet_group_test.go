@@ -284,15 +284,51 @@ func TestAccRDSSubnetGroup_updateSubnets(t *testing.T) {
 	})
 }
 
+func TestAccRDSSubnetGroup_regionOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.DBSubnetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_subnet_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubnetGroupConfig_regionOverride(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubnetGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccSubnetGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSubnetGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSubnetGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
-
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_db_subnet_group" {
 				continue
 			}
 
+			ctx := acctest.RegionalContext(ctx, rs.Primary.Attributes[names.AttrRegion])
+			conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
 			_, err := tfrds.FindDBSubnetGroupByName(ctx, conn, rs.Primary.ID)
 
 			if tfresource.NotFound(err) {
@@ -317,6 +353,7 @@ func testAccCheckSubnetGroupExists(ctx context.Context, n string, v *types.DBSub
 			return fmt.Errorf("Not found: %s", n)
 		}
 
+		ctx := acctest.RegionalContext(ctx, rs.Primary.Attributes[names.AttrRegion])
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
 
 		output, err := tfrds.FindDBSubnetGroupByName(ctx, conn, rs.Primary.ID)
@@ -339,6 +376,48 @@ resource "aws_db_subnet_group" "test" {
 `, rName))
 }
 
+func testAccSubnetGroupConfig_regionOverride(rName, region string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  region = %[2]q
+  state  = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  region     = %[2]q
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  region            = %[2]q
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_db_subnet_group" "test" {
+  region     = %[2]q
+  name       = %[1]q
+  subnet_ids = aws_subnet.test[*].id
+}
+`, rName, region)
+}
+
 func testAccSubnetGroupConfig_nameGenerated(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), `
 resource "aws_db_subnet_group" "test" {
@@ -50,6 +50,7 @@ const (
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
 // @Tags(identifierAttribute="bucket", resourceType="Bucket")
+// @IdentityAttribute("bucket")
 // @Testing(importIgnore="force_destroy")
 func resourceBucket() *schema.Resource {
 	return &schema.Resource{
@@ -66,7 +67,7 @@ func resourceBucket() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceBucketImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1598,6 +1599,11 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func resourceBucketImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set(names.AttrForceDestroy, false)
+	return []*schema.ResourceData{d}, nil
+}
+
 func findBucket(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) error {
 	input := &s3.HeadBucketInput{
 		Bucket: aws.String(bucket),
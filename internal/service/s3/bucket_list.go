@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_s3_bucket", name="Bucket")
+func newBucketListResource() list.ListResourceWithConfigure {
+	return &bucketListResource{}
+}
+
+var _ list.ListResourceWithRawV5Schemas = &bucketListResource{}
+
+type bucketListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *bucketListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_bucket"
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrPrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Only list buckets whose names begin with this prefix.",
+			},
+		},
+	}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var config bucketListResourceModel
+	if diags := request.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().S3Client(ctx)
+
+	// Only general purpose buckets in the provider's Region are listed.
+	input := s3.ListBucketsInput{
+		BucketRegion: aws.String(l.Meta().Region(ctx)),
+	}
+	if diags := fwflex.Expand(ctx, config, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		pages := s3.NewListBucketsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("listing S3 Buckets", err.Error())
+				yield(list.ListResult{Diagnostics: diags})
+				return
+			}
+
+			for _, bucket := range page.Buckets {
+				name := aws.ToString(bucket.Name)
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				if !l.SetResult(ctx, request, name, &result) {
+					continue
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type bucketListResourceModel struct {
+	Prefix types.String `tfsdk:"prefix"`
+}
@@ -18,7 +18,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	ERNameSecretVersion = "Ephemeral Resource Secret Version"
+)
+
 // @EphemeralResource(aws_secretsmanager_secret_version, name="Secret Version")
+// @Region(overrideEnabled=true)
 func newEphemeralSecretVersion(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
 	return &ephemeralSecrets{}, nil
 }
@@ -68,13 +73,13 @@ func (e *ephemeralSecrets) Schema(ctx context.Context, _ ephemeral.SchemaRequest
 
 func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
 	var data epSecretVersionData
-	conn := e.Meta().SecretsManagerClient(ctx)
-
 	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	conn := e.Meta().SecretsManagerClient(ctx)
+
 	input := secretsmanager.GetSecretValueInput{}
 	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
 	if response.Diagnostics.HasError() {
@@ -84,7 +89,7 @@ func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenReque
 	output, err := findSecretVersion(ctx, conn, &input)
 	if err != nil {
 		response.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.SecretsManager, create.ErrActionReading, DSNameSecretVersions, data.ARN.String(), err),
+			create.ProblemStandardMessage(names.SecretsManager, create.ErrActionReading, ERNameSecretVersion, data.SecretID.ValueString(), err),
 			err.Error(),
 		)
 		return
@@ -101,6 +106,7 @@ func (e *ephemeralSecrets) Open(ctx context.Context, request ephemeral.OpenReque
 }
 
 type epSecretVersionData struct {
+	framework.WithRegionModel
 	ARN           types.String                      `tfsdk:"arn"`
 	CreatedDate   timetypes.RFC3339                 `tfsdk:"created_date"`
 	SecretID      types.String                      `tfsdk:"secret_id"`
@@ -46,6 +46,38 @@ func TestAccSecretsManagerSecretVersionEphemeral_basic(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersionEphemeral_regionOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	secretString := "super-secret"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionEphemeralResourceConfig_regionOverride(rName, secretString, acctest.AlternateRegion()),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrRegion), knownvalue.StringExact(acctest.AlternateRegion())),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_string"), knownvalue.StringExact(secretString)),
+				},
+			},
+		},
+	})
+}
+
 func testAccSecretVersionEphemeralResourceConfig_basic(rName, secretString string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_version.test"),
@@ -65,3 +97,26 @@ ephemeral "aws_secretsmanager_secret_version" "test" {
 }
 `, rName, secretString))
 }
+
+func testAccSecretVersionEphemeralResourceConfig_regionOverride(rName, secretString, region string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_secret_version.test"),
+		fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  region = %[3]q
+  name   = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  region        = %[3]q
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = %[2]q
+}
+
+ephemeral "aws_secretsmanager_secret_version" "test" {
+  region     = %[3]q
+  secret_id  = aws_secretsmanager_secret.test.id
+  version_id = aws_secretsmanager_secret_version.test.version_id
+}
+`, rName, secretString, region))
+}
@@ -19,6 +19,9 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newEphemeralSecretVersion,
 			Name:    "Secret Version",
+			Region: &types.ServicePackageResourceRegion{
+				IsOverrideEnabled: true,
+			},
 		},
 	}
 }
@@ -12,8 +12,10 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -48,9 +50,6 @@ func (r *automationRuleResource) Metadata(_ context.Context, request resource.Me
 }
 
 func (r *automationRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
-	const (
-		defaultFilterSchemaMaxSize = 20
-	)
 	response.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
@@ -68,6 +67,9 @@ func (r *automationRuleResource) Schema(ctx context.Context, request resource.Sc
 			},
 			"rule_order": schema.Int64Attribute{
 				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
 			},
 			"rule_status": schema.StringAttribute{
 				CustomType: fwtypes.StringEnumType[awstypes.RuleStatus](),
@@ -81,109 +83,126 @@ func (r *automationRuleResource) Schema(ctx context.Context, request resource.Sc
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
 		Blocks: map[string]schema.Block{
-			names.AttrActions: schema.SetNestedBlock{
-				CustomType: fwtypes.NewSetNestedObjectTypeOf[automationRulesActionModel](ctx),
-				Validators: []validator.Set{
-					setvalidator.IsRequired(),
-					setvalidator.SizeAtLeast(1),
+			names.AttrActions: automationRuleActionsBlock(ctx),
+			"criteria":        automationRuleCriteriaBlock(ctx),
+		},
+	}
+}
+
+func automationRuleActionsBlock(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[automationRulesActionModel](ctx),
+		Validators: []validator.Set{
+			setvalidator.IsRequired(),
+			setvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrType: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.AutomationRulesActionType](),
+					Optional:   true,
 				},
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						names.AttrType: schema.StringAttribute{
-							CustomType: fwtypes.StringEnumType[awstypes.AutomationRulesActionType](),
-							Optional:   true,
-						},
+			},
+			Blocks: map[string]schema.Block{
+				"finding_fields_update": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesFindingFieldsUpdateModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
 					},
-					Blocks: map[string]schema.Block{
-						"finding_fields_update": schema.ListNestedBlock{
-							CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesFindingFieldsUpdateModel](ctx),
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(1),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"confidence": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.Between(0, 100),
+								},
 							},
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"confidence": schema.Int64Attribute{
-										Optional: true,
-									},
-									"criticality": schema.Int64Attribute{
-										Optional: true,
-									},
-									"types": schema.ListAttribute{
-										CustomType:  fwtypes.ListOfStringType,
-										Optional:    true,
-										ElementType: types.StringType,
-									},
-									"user_defined_fields": schema.MapAttribute{
-										CustomType:  fwtypes.MapOfStringType,
-										Optional:    true,
-										ElementType: types.StringType,
-									},
-									"verification_state": schema.StringAttribute{
-										CustomType: fwtypes.StringEnumType[awstypes.VerificationState](),
-										Optional:   true,
-									},
+							"criticality": schema.Int64Attribute{
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.Between(0, 100),
 								},
-								Blocks: map[string]schema.Block{
-									"note": schema.ListNestedBlock{
-										CustomType: fwtypes.NewListNestedObjectTypeOf[noteUpdateModel](ctx),
-										Validators: []validator.List{
-											listvalidator.SizeAtMost(1),
+							},
+							"types": schema.ListAttribute{
+								CustomType:  fwtypes.ListOfStringType,
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"user_defined_fields": schema.MapAttribute{
+								CustomType:  fwtypes.MapOfStringType,
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"verification_state": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.VerificationState](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"note": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[noteUpdateModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"text": schema.StringAttribute{
+											Required: true,
 										},
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												"text": schema.StringAttribute{
-													Required: true,
-												},
-												"updated_by": schema.StringAttribute{
-													Required: true,
-												},
-											},
+										"updated_by": schema.StringAttribute{
+											Required: true,
 										},
 									},
-									"related_findings": schema.SetNestedBlock{
-										CustomType: fwtypes.NewSetNestedObjectTypeOf[relatedFindingModel](ctx),
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												names.AttrID: schema.StringAttribute{
-													Required: true,
-												},
-												"product_arn": schema.StringAttribute{
-													CustomType: fwtypes.ARNType,
-													Required:   true,
-												},
-											},
+								},
+							},
+							"related_findings": schema.SetNestedBlock{
+								CustomType: fwtypes.NewSetNestedObjectTypeOf[relatedFindingModel](ctx),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrID: schema.StringAttribute{
+											Required: true,
+										},
+										"product_arn": schema.StringAttribute{
+											CustomType: fwtypes.ARNType,
+											Required:   true,
 										},
 									},
-									"severity": schema.ListNestedBlock{
-										CustomType: fwtypes.NewListNestedObjectTypeOf[severityUpdateModel](ctx),
-										Validators: []validator.List{
-											listvalidator.SizeAtMost(1),
+								},
+							},
+							"severity": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[severityUpdateModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"label": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.SeverityLabel](),
+											Optional:   true,
+											Computed:   true,
 										},
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												"label": schema.StringAttribute{
-													CustomType: fwtypes.StringEnumType[awstypes.SeverityLabel](),
-													Optional:   true,
-													Computed:   true,
-												},
-												"product": schema.Float64Attribute{
-													Optional: true,
-												},
+										"normalized": schema.Int64Attribute{
+											Optional: true,
+											Validators: []validator.Int64{
+												int64validator.Between(0, 100),
 											},
 										},
-									},
-									"workflow": schema.ListNestedBlock{
-										CustomType: fwtypes.NewListNestedObjectTypeOf[workflowUpdateModel](ctx),
-										Validators: []validator.List{
-											listvalidator.SizeAtMost(1),
+										"product": schema.Float64Attribute{
+											Optional: true,
 										},
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												names.AttrStatus: schema.StringAttribute{
-													CustomType: fwtypes.StringEnumType[awstypes.WorkflowStatus](),
-													Optional:   true,
-												},
-											},
+									},
+								},
+							},
+							"workflow": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[workflowUpdateModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrStatus: schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.WorkflowStatus](),
+											Optional:   true,
 										},
 									},
 								},
@@ -192,55 +211,62 @@ func (r *automationRuleResource) Schema(ctx context.Context, request resource.Sc
 					},
 				},
 			},
-			"criteria": schema.ListNestedBlock{
-				CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesFindingFiltersModel](ctx),
-				Validators: []validator.List{
-					listvalidator.IsRequired(),
-					listvalidator.SizeAtLeast(1),
-					listvalidator.SizeAtMost(1),
-				},
-				NestedObject: schema.NestedBlockObject{
-					Blocks: map[string]schema.Block{
-						names.AttrAWSAccountID:               stringFilterSchemaFramework(ctx, 100), //nolint:mnd // 100 is the maximum number of items
-						"aws_account_name":                   stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"company_name":                       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"compliance_associated_standards_id": stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"compliance_security_control_id":     stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"compliance_status":                  stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"confidence":                         numberFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						names.AttrCreatedAt:                  dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"criticality":                        numberFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						names.AttrDescription:                stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"first_observed_at":                  dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"generator_id":                       stringFilterSchemaFramework(ctx, 100), //nolint:mnd // 100 is the maximum number of items
-						names.AttrID:                         stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"last_observed_at":                   dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"note_text":                          stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"note_updated_at":                    dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"note_updated_by":                    stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"product_arn":                        stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"product_name":                       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"record_state":                       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"related_findings_id":                stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"related_findings_product_arn":       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"resource_application_arn":           stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"resource_application_name":          stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"resource_details_other":             mapFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						names.AttrResourceID:                 stringFilterSchemaFramework(ctx, 100), //nolint:mnd // 100 is the maximum number of items
-						"resource_partition":                 stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"resource_region":                    stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						names.AttrResourceTags:               mapFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						names.AttrResourceType:               stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"severity_label":                     stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"source_url":                         stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"title":                              stringFilterSchemaFramework(ctx, 100), //nolint:mnd // 100 is the maximum number of items
-						names.AttrType:                       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"updated_at":                         dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"user_defined_fields":                mapFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"verification_state":                 stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-						"workflow_status":                    stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
-					},
-				},
+		},
+	}
+}
+
+func automationRuleCriteriaBlock(ctx context.Context) schema.ListNestedBlock {
+	const (
+		defaultFilterSchemaMaxSize = 20
+	)
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesFindingFiltersModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				names.AttrAWSAccountID:               stringFilterSchemaFramework(ctx, 100), //nolint:mnd // 100 is the maximum number of items
+				"aws_account_name":                   stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"company_name":                       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"compliance_associated_standards_id": stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"compliance_security_control_id":     stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"compliance_status":                  stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"confidence":                         numberFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				names.AttrCreatedAt:                  dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"criticality":                        numberFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				names.AttrDescription:                stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"first_observed_at":                  dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"generator_id":                       stringFilterSchemaFramework(ctx, 100), //nolint:mnd // 100 is the maximum number of items
+				names.AttrID:                         stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"last_observed_at":                   dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"note_text":                          stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"note_updated_at":                    dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"note_updated_by":                    stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"product_arn":                        stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"product_name":                       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"record_state":                       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"related_findings_id":                stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"related_findings_product_arn":       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"resource_application_arn":           stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"resource_application_name":          stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"resource_details_other":             mapFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				names.AttrResourceID:                 stringFilterSchemaFramework(ctx, 100), //nolint:mnd // 100 is the maximum number of items
+				"resource_partition":                 stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"resource_region":                    stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				names.AttrResourceTags:               mapFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				names.AttrResourceType:               stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"severity_label":                     stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"source_url":                         stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"title":                              stringFilterSchemaFramework(ctx, 100), //nolint:mnd // 100 is the maximum number of items
+				names.AttrType:                       stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"updated_at":                         dateFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"user_defined_fields":                mapFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"verification_state":                 stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
+				"workflow_status":                    stringFilterSchemaFramework(ctx, defaultFilterSchemaMaxSize),
 			},
 		},
 	}
@@ -508,6 +534,56 @@ func (r *automationRuleResource) Delete(ctx context.Context, request resource.De
 
 func (r *automationRuleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
+
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan automationRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RuleOrder.IsUnknown() || plan.RuleOrder.IsNull() {
+		return
+	}
+
+	var ruleARN string
+	if !request.State.Raw.IsNull() {
+		var state automationRuleResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.RuleOrder.Equal(state.RuleOrder) {
+			return
+		}
+
+		ruleARN = state.RuleARN.ValueString()
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	ruleOrder := plan.RuleOrder.ValueInt64()
+	conflict, err := findAutomationRuleMetadataByRuleOrder(ctx, conn, int32(ruleOrder), ruleARN)
+
+	switch {
+	case tfresource.NotFound(err):
+		return
+	case err != nil:
+		response.Diagnostics.AddError("listing Security Hub Automation Rules", err.Error())
+
+		return
+	}
+
+	// Not an error, as the other rule's order may be changed in the same apply.
+	response.Diagnostics.AddAttributeWarning(
+		path.Root("rule_order"),
+		"Conflicting rule_order",
+		fmt.Sprintf("rule_order %d is currently used by Security Hub Automation Rule %q (%s). Each automation rule should have a unique rule_order unless that rule's order is changed in the same apply.", ruleOrder, aws.ToString(conflict.RuleName), aws.ToString(conflict.RuleArn)),
+	)
 }
 
 func findAutomationRuleByARN(ctx context.Context, conn *securityhub.Client, arn string) (*awstypes.AutomationRulesConfig, error) {
@@ -549,6 +625,52 @@ func findAutomationRules(ctx context.Context, conn *securityhub.Client, input *s
 	return output.Rules, nil
 }
 
+// findAutomationRuleMetadataByRuleOrder returns the automation rule, other than the one identified by excludeARN, that uses the specified rule order.
+func findAutomationRuleMetadataByRuleOrder(ctx context.Context, conn *securityhub.Client, ruleOrder int32, excludeARN string) (*awstypes.AutomationRulesMetadata, error) {
+	output, err := findAutomationRulesMetadata(ctx, conn, &securityhub.ListAutomationRulesInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output {
+		if aws.ToInt32(v.RuleOrder) == ruleOrder && aws.ToString(v.RuleArn) != excludeARN {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+func findAutomationRulesMetadata(ctx context.Context, conn *securityhub.Client, input *securityhub.ListAutomationRulesInput) ([]awstypes.AutomationRulesMetadata, error) {
+	var output []awstypes.AutomationRulesMetadata
+
+	for {
+		page, err := conn.ListAutomationRules(ctx, input)
+
+		if tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AutomationRulesMetadata...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
 type automationRuleResourceModel struct {
 	Actions     fwtypes.SetNestedObjectValueOf[automationRulesActionModel]          `tfsdk:"actions"`
 	Criteria    fwtypes.ListNestedObjectValueOf[automationRulesFindingFiltersModel] `tfsdk:"criteria"`
@@ -601,8 +723,9 @@ type relatedFindingModel struct {
 }
 
 type severityUpdateModel struct {
-	Label   fwtypes.StringEnum[awstypes.SeverityLabel] `tfsdk:"label"`
-	Product types.Float64                              `tfsdk:"product"`
+	Label      fwtypes.StringEnum[awstypes.SeverityLabel] `tfsdk:"label"`
+	Normalized types.Int64                                `tfsdk:"normalized"`
+	Product    types.Float64                              `tfsdk:"product"`
 }
 
 type workflowUpdateModel struct {
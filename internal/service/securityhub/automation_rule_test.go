@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -340,6 +341,47 @@ func testAccAutomationRule_tags(t *testing.T) {
 	})
 }
 
+func testAccAutomationRule_ruleOrderSwap(t *testing.T) {
+	ctx := acctest.Context(t)
+	var automationRule1, automationRule2 types.AutomationRulesConfig
+	resourceName1 := "aws_securityhub_automation_rule.test1"
+	resourceName2 := "aws_securityhub_automation_rule.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_ruleOrders(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName1, &automationRule1),
+					testAccCheckAutomationRuleExists(ctx, resourceName2, &automationRule2),
+					resource.TestCheckResourceAttr(resourceName1, "rule_order", "1"),
+					resource.TestCheckResourceAttr(resourceName2, "rule_order", "2"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_ruleOrders(rName, 2, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName1, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(resourceName2, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRuleExists(ctx, resourceName1, &automationRule1),
+					testAccCheckAutomationRuleExists(ctx, resourceName2, &automationRule2),
+					resource.TestCheckResourceAttr(resourceName1, "rule_order", "2"),
+					resource.TestCheckResourceAttr(resourceName2, "rule_order", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAutomationRuleExists(ctx context.Context, n string, v *types.AutomationRulesConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -760,3 +802,57 @@ resource "aws_securityhub_automation_rule" "test" {
 }
 `, rName, key, value, key2, value2)
 }
+
+func testAccAutomationRuleConfig_ruleOrders(rName string, ruleOrder1, ruleOrder2 int) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rule" "test1" {
+  description = "test description"
+  rule_name   = "%[1]s-1"
+  rule_order  = %[2]d
+
+  actions {
+    finding_fields_update {
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+    type = "FINDING_FIELDS_UPDATE"
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+
+resource "aws_securityhub_automation_rule" "test2" {
+  description = "test description"
+  rule_name   = "%[1]s-2"
+  rule_order  = %[3]d
+
+  actions {
+    finding_fields_update {
+      workflow {
+        status = "SUPPRESSED"
+      }
+    }
+    type = "FINDING_FIELDS_UPDATE"
+  }
+
+  criteria {
+    aws_account_id {
+      comparison = "EQUALS"
+      value      = "1234567890"
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, ruleOrder1, ruleOrder2)
+}
@@ -0,0 +1,574 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// BatchGetAutomationRules, BatchUpdateAutomationRules and BatchDeleteAutomationRules accept at most 100 rules per call.
+	automationRulesBatchSize = 100
+)
+
+// @FrameworkResource(name="Automation Rules")
+func newAutomationRulesResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &automationRulesResource{}, nil
+}
+
+type automationRulesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *automationRulesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_securityhub_automation_rules"
+}
+
+func (r *automationRulesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[automationRulesRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						// Computed values are carried over by rule name in ModifyPlan.
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Required: true,
+						},
+						"is_terminal": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"rule_name": schema.StringAttribute{
+							Required: true,
+						},
+						"rule_order": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 1000),
+							},
+						},
+						"rule_status": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.RuleStatus](),
+							Computed:   true,
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrActions: automationRuleActionsBlock(ctx),
+						"criteria":        automationRuleCriteriaBlock(ctx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *automationRulesResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data automationRulesResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return
+	}
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	seenRuleOrders := make(map[int64]int)
+	seenRuleNames := make(map[string]int)
+	for i, rule := range rules {
+		if !rule.RuleOrder.IsNull() && !rule.RuleOrder.IsUnknown() {
+			ruleOrder := rule.RuleOrder.ValueInt64()
+			if j, ok := seenRuleOrders[ruleOrder]; ok {
+				response.Diagnostics.AddAttributeError(
+					path.Root(names.AttrRule).AtListIndex(i).AtName("rule_order"),
+					"Conflicting rule_order",
+					fmt.Sprintf("rule_order %d is also used by rule %d. Each automation rule must have a unique rule_order.", ruleOrder, j),
+				)
+			} else {
+				seenRuleOrders[ruleOrder] = i
+			}
+		}
+
+		// Rules are identified by name.
+		if !rule.RuleName.IsNull() && !rule.RuleName.IsUnknown() {
+			ruleName := rule.RuleName.ValueString()
+			if j, ok := seenRuleNames[ruleName]; ok {
+				response.Diagnostics.AddAttributeError(
+					path.Root(names.AttrRule).AtListIndex(i).AtName("rule_name"),
+					"Conflicting rule_name",
+					fmt.Sprintf("rule_name %q is also used by rule %d. Each automation rule must have a unique rule_name.", ruleName, j),
+				)
+			} else {
+				seenRuleNames[ruleName] = i
+			}
+		}
+	}
+}
+
+func (r *automationRulesResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() || request.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state automationRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Rules.IsUnknown() {
+		return
+	}
+
+	planRules, diags := plan.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	stateRules, diags := state.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Rules are matched by name, so computed values are carried over from the existing rule with the same name.
+	stateRulesByName := automationRulesByName(stateRules)
+	for _, rule := range planRules {
+		if rule.RuleName.IsUnknown() {
+			continue
+		}
+
+		stateRule := stateRulesByName.take(rule.RuleName.ValueString())
+		if stateRule == nil {
+			continue
+		}
+
+		rule.RuleARN = stateRule.RuleARN
+		if rule.RuleStatus.IsUnknown() {
+			rule.RuleStatus = stateRule.RuleStatus
+		}
+	}
+
+	plan.Rules = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, planRules)
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+func (r *automationRulesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data automationRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, rule := range rules {
+		response.Diagnostics.Append(createAutomationRulesRule(ctx, conn, rule)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID(ctx))
+	data.Rules = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, rules)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *automationRulesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data automationRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	var managed []*automationRulesRuleModel
+	if !data.Rules.IsNull() {
+		response.Diagnostics.Append(data.Rules.ElementsAs(ctx, &managed, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	metadata, err := findAutomationRulesMetadata(ctx, conn, &securityhub.ListAutomationRulesInput{})
+
+	if err != nil && !tfresource.NotFound(err) {
+		response.Diagnostics.AddError("listing Security Hub Automation Rules", err.Error())
+
+		return
+	}
+
+	// Managed rules keep their configured position; any other rules in the Region are appended in rule order so that they are removed on the next apply.
+	var ruleARNs []string
+	for _, rule := range managed {
+		ruleARNs = append(ruleARNs, rule.RuleARN.ValueString())
+	}
+	slices.SortStableFunc(metadata, func(a, b awstypes.AutomationRulesMetadata) int {
+		return cmp.Compare(aws.ToInt32(a.RuleOrder), aws.ToInt32(b.RuleOrder))
+	})
+	for _, v := range metadata {
+		if arn := aws.ToString(v.RuleArn); !slices.Contains(ruleARNs, arn) {
+			ruleARNs = append(ruleARNs, arn)
+		}
+	}
+
+	automationRules, err := findAutomationRulesByARNs(ctx, conn, ruleARNs)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Hub Automation Rules (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var rules []*automationRulesRuleModel
+	for _, ruleARN := range ruleARNs {
+		idx := slices.IndexFunc(automationRules, func(v awstypes.AutomationRulesConfig) bool {
+			return aws.ToString(v.RuleArn) == ruleARN
+		})
+		if idx == -1 {
+			continue
+		}
+
+		var rule automationRulesRuleModel
+		response.Diagnostics.Append(fwflex.Flatten(ctx, automationRules[idx], &rule)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		rules = append(rules, &rule)
+	}
+
+	data.Rules = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, rules)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *automationRulesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new automationRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	oldRules, diags := old.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	newRules, diags := new.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Rules are matched by name so that adding, removing or reordering rules does not affect other rules.
+	oldRulesByName := automationRulesByName(oldRules)
+	var items []awstypes.UpdateAutomationRulesRequestItem
+	var createRules []*automationRulesRuleModel
+	for _, rule := range newRules {
+		oldRule := oldRulesByName.take(rule.RuleName.ValueString())
+		if oldRule == nil {
+			createRules = append(createRules, rule)
+
+			continue
+		}
+
+		rule.RuleARN = oldRule.RuleARN
+		if rule.RuleStatus.IsUnknown() {
+			rule.RuleStatus = oldRule.RuleStatus
+		}
+
+		if rule.equal(oldRule) {
+			continue
+		}
+
+		item := awstypes.UpdateAutomationRulesRequestItem{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, rule, &item)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		items = append(items, item)
+	}
+
+	// Delete removed rules first, and create new rules last, so that their rule orders can be reused.
+	var ruleARNs []string
+	for _, rules := range oldRulesByName {
+		for _, rule := range rules {
+			ruleARNs = append(ruleARNs, rule.RuleARN.ValueString())
+		}
+	}
+
+	if err := deleteAutomationRules(ctx, conn, ruleARNs); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Hub Automation Rules (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	for chunk := range slices.Chunk(items, automationRulesBatchSize) {
+		input := &securityhub.BatchUpdateAutomationRulesInput{
+			UpdateAutomationRulesRequestItems: chunk,
+		}
+
+		output, err := conn.BatchUpdateAutomationRules(ctx, input)
+
+		if err == nil {
+			err = unprocessedAutomationRulesError(output.UnprocessedAutomationRules)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Security Hub Automation Rules (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	for _, rule := range createRules {
+		response.Diagnostics.Append(createAutomationRulesRule(ctx, conn, rule)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	new.Rules = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, newRules)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *automationRulesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data automationRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SecurityHubClient(ctx)
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var ruleARNs []string
+	for _, rule := range rules {
+		ruleARNs = append(ruleARNs, rule.RuleARN.ValueString())
+	}
+
+	if err := deleteAutomationRules(ctx, conn, ruleARNs); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Security Hub Automation Rules (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func createAutomationRulesRule(ctx context.Context, conn *securityhub.Client, rule *automationRulesRuleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &securityhub.CreateAutomationRuleInput{}
+	diags.Append(fwflex.Expand(ctx, rule, input)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	output, err := conn.CreateAutomationRule(ctx, input)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("creating Security Hub Automation Rule (%s)", aws.ToString(input.RuleName)), err.Error())
+
+		return diags
+	}
+
+	ruleARN := aws.ToString(output.RuleArn)
+	rule.RuleARN = types.StringValue(ruleARN)
+
+	automationRule, err := findAutomationRuleByARN(ctx, conn, ruleARN)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Security Hub Automation Rule (%s)", ruleARN), err.Error())
+
+		return diags
+	}
+
+	rule.RuleStatus = fwtypes.StringEnumValue(automationRule.RuleStatus)
+
+	return diags
+}
+
+func deleteAutomationRules(ctx context.Context, conn *securityhub.Client, ruleARNs []string) error {
+	for chunk := range slices.Chunk(ruleARNs, automationRulesBatchSize) {
+		input := &securityhub.BatchDeleteAutomationRulesInput{
+			AutomationRulesArns: chunk,
+		}
+
+		output, err := conn.BatchDeleteAutomationRules(ctx, input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := unprocessedAutomationRulesError(output.UnprocessedAutomationRules); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findAutomationRulesByARNs(ctx context.Context, conn *securityhub.Client, arns []string) ([]awstypes.AutomationRulesConfig, error) {
+	var output []awstypes.AutomationRulesConfig
+
+	for chunk := range slices.Chunk(arns, automationRulesBatchSize) {
+		input := &securityhub.BatchGetAutomationRulesInput{
+			AutomationRulesArns: chunk,
+		}
+
+		rules, err := findAutomationRules(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, rules...)
+	}
+
+	return output, nil
+}
+
+func unprocessedAutomationRulesError(apiObjects []awstypes.UnprocessedAutomationRule) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("%s: %d: %s", aws.ToString(apiObject.RuleArn), aws.ToInt32(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+type automationRulesResourceModel struct {
+	ID    types.String                                              `tfsdk:"id"`
+	Rules fwtypes.ListNestedObjectValueOf[automationRulesRuleModel] `tfsdk:"rule"`
+}
+
+type automationRulesRuleModel struct {
+	Actions     fwtypes.SetNestedObjectValueOf[automationRulesActionModel]          `tfsdk:"actions"`
+	Criteria    fwtypes.ListNestedObjectValueOf[automationRulesFindingFiltersModel] `tfsdk:"criteria"`
+	Description types.String                                                        `tfsdk:"description"`
+	IsTerminal  types.Bool                                                          `tfsdk:"is_terminal"`
+	RuleARN     types.String                                                        `tfsdk:"arn"`
+	RuleName    types.String                                                        `tfsdk:"rule_name"`
+	RuleOrder   types.Int64                                                         `tfsdk:"rule_order"`
+	RuleStatus  fwtypes.StringEnum[awstypes.RuleStatus]                             `tfsdk:"rule_status"`
+}
+
+// automationRulesByNameMap groups rules by rule name, preserving their order.
+type automationRulesByNameMap map[string][]*automationRulesRuleModel
+
+func automationRulesByName(rules []*automationRulesRuleModel) automationRulesByNameMap {
+	m := make(automationRulesByNameMap)
+
+	for _, rule := range rules {
+		name := rule.RuleName.ValueString()
+		m[name] = append(m[name], rule)
+	}
+
+	return m
+}
+
+// take removes and returns the first rule with the specified name, or nil if there is none.
+func (m automationRulesByNameMap) take(name string) *automationRulesRuleModel {
+	rules := m[name]
+	if len(rules) == 0 {
+		return nil
+	}
+
+	if len(rules) == 1 {
+		delete(m, name)
+	} else {
+		m[name] = rules[1:]
+	}
+
+	return rules[0]
+}
+
+func (m *automationRulesRuleModel) equal(other *automationRulesRuleModel) bool {
+	return m.Actions.Equal(other.Actions) &&
+		m.Criteria.Equal(other.Criteria) &&
+		m.Description.Equal(other.Description) &&
+		m.IsTerminal.Equal(other.IsTerminal) &&
+		m.RuleName.Equal(other.RuleName) &&
+		m.RuleOrder.Equal(other.RuleOrder) &&
+		m.RuleStatus.Equal(other.RuleStatus)
+}
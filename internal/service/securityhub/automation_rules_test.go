@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecurityhub "github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAutomationRules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securityhub_automation_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRulesConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule.0.arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.is_terminal", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_name", rName+"-0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_status", "ENABLED"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule.1.arn"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule_name", rName+"-1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule_order", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securityhub_automation_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRulesConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfsecurityhub.ResourceAutomationRules, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAutomationRules_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_securityhub_automation_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRulesConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAutomationRulesConfig_basic(rName, 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.rule_name", rName+"-2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.rule_order", "3"),
+				),
+			},
+			{
+				Config: testAccAutomationRulesConfig_basic(rName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomationRules_duplicateRuleOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutomationRulesConfig_duplicateRuleOrder(rName),
+				ExpectError: regexache.MustCompile(`rule_order 1 is also used by rule 0`),
+			},
+		},
+	})
+}
+
+func testAccAutomationRules_insert(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleARN0, ruleARN1 string
+	resourceName := "aws_securityhub_automation_rules.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRulesConfig_ruleNames(rName, "b", "c"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName),
+					testAccCheckAutomationRulesRuleARN(resourceName, 0, &ruleARN0),
+					testAccCheckAutomationRulesRuleARN(resourceName, 1, &ruleARN1),
+				),
+			},
+			{
+				Config: testAccAutomationRulesConfig_ruleNames(rName, "a", "b", "c"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_name", rName+"-a"),
+					resource.TestCheckResourceAttrPtr(resourceName, "rule.1.arn", &ruleARN0),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule_name", rName+"-b"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule_order", "2"),
+					resource.TestCheckResourceAttrPtr(resourceName, "rule.2.arn", &ruleARN1),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.rule_name", rName+"-c"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.rule_order", "3"),
+				),
+			},
+			{
+				Config: testAccAutomationRulesConfig_ruleNames(rName, "a", "c"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationRulesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttrPtr(resourceName, "rule.1.arn", &ruleARN1),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule_name", rName+"-c"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule_order", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomationRules_duplicateRuleName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityHubServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAutomationRulesConfig_ruleNames(rName, "a", "a"),
+				ExpectError: regexache.MustCompile(`rule_name "[^"]+-a" is also used by rule 0`),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationRulesRuleARN(n string, index int, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*v = rs.Primary.Attributes[fmt.Sprintf("rule.%d.arn", index)]
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRulesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		for _, ruleARN := range testAccAutomationRulesARNs(rs) {
+			if _, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, ruleARN); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutomationRulesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_securityhub_automation_rules" {
+				continue
+			}
+
+			for _, ruleARN := range testAccAutomationRulesARNs(rs) {
+				_, err := tfsecurityhub.FindAutomationRuleByARN(ctx, conn, ruleARN)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Security Hub Automation Rule %s still exists", ruleARN)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAutomationRulesARNs(rs *terraform.ResourceState) []string {
+	var ruleARNs []string
+
+	n, _ := strconv.Atoi(rs.Primary.Attributes["rule.#"])
+	for i := range n {
+		ruleARNs = append(ruleARNs, rs.Primary.Attributes[fmt.Sprintf("rule.%d.arn", i)])
+	}
+
+	return ruleARNs
+}
+
+func testAccAutomationRulesConfig_basic(rName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rules" "test" {
+  dynamic "rule" {
+    for_each = range(%[2]d)
+
+    content {
+      description = "test description ${rule.value}"
+      rule_name   = "%[1]s-${rule.value}"
+      rule_order  = rule.value + 1
+
+      actions {
+        finding_fields_update {
+          workflow {
+            status = "SUPPRESSED"
+          }
+        }
+        type = "FINDING_FIELDS_UPDATE"
+      }
+
+      criteria {
+        aws_account_id {
+          comparison = "EQUALS"
+          value      = "1234567890"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, n)
+}
+
+func testAccAutomationRulesConfig_ruleNames(rName string, ruleNames ...string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "test" {}
+
+resource "aws_securityhub_automation_rules" "test" {
+  dynamic "rule" {
+    for_each = ["%[2]s"]
+
+    content {
+      description = "test description ${rule.value}"
+      rule_name   = "%[1]s-${rule.value}"
+      rule_order  = rule.key + 1
+
+      actions {
+        finding_fields_update {
+          workflow {
+            status = "SUPPRESSED"
+          }
+        }
+        type = "FINDING_FIELDS_UPDATE"
+      }
+
+      criteria {
+        aws_account_id {
+          comparison = "EQUALS"
+          value      = "1234567890"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_securityhub_account.test]
+}
+`, rName, strings.Join(ruleNames, `", "`))
+}
+
+func testAccAutomationRulesConfig_duplicateRuleOrder(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_automation_rules" "test" {
+  rule {
+    description = "test description"
+    rule_name   = "%[1]s-0"
+    rule_order  = 1
+
+    actions {
+      finding_fields_update {
+        workflow {
+          status = "SUPPRESSED"
+        }
+      }
+      type = "FINDING_FIELDS_UPDATE"
+    }
+
+    criteria {
+      aws_account_id {
+        comparison = "EQUALS"
+        value      = "1234567890"
+      }
+    }
+  }
+
+  rule {
+    description = "test description"
+    rule_name   = "%[1]s-1"
+    rule_order  = 1
+
+    actions {
+      finding_fields_update {
+        workflow {
+          status = "NOTIFIED"
+        }
+      }
+      type = "FINDING_FIELDS_UPDATE"
+    }
+
+    criteria {
+      aws_account_id {
+        comparison = "EQUALS"
+        value      = "1234567890"
+      }
+    }
+  }
+}
+`, rName)
+}
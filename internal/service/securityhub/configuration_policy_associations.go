@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securityhub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_securityhub_configuration_policy_associations", name="Configuration Policy Associations")
+func resourceConfigurationPolicyAssociations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyAssociationsCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyAssociationsRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyAssociationsUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyAssociationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The universally unique identifier (UUID) of the configuration policy.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"target_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The identifiers of the target accounts, organizational units, or the root to associate with the specified configuration.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validConfigurationPolicyAssociationTargetID,
+				},
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyAssociationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	policyID := d.Get("policy_id").(string)
+	targetIDs := flex.ExpandStringValueSet(d.Get("target_ids").(*schema.Set))
+
+	if err := startConfigurationPolicyAssociations(ctx, conn, policyID, targetIDs); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(policyID)
+
+	if _, err := waitConfigurationPolicyAssociationsSucceeded(ctx, conn, targetIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Associations (%s) success: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	output, err := findConfigurationPolicyAssociationsByPolicyID(ctx, conn, d.Id())
+
+	if err == nil && len(output) == 0 {
+		err = tfresource.NewEmptyResultError(d.Id())
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy Associations (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy Associations (%s): %s", d.Id(), err)
+	}
+
+	var targetIDs []string
+	for _, v := range output {
+		targetIDs = append(targetIDs, aws.ToString(v.TargetId))
+	}
+
+	d.Set("policy_id", d.Id())
+	d.Set("target_ids", targetIDs)
+
+	return diags
+}
+
+func resourceConfigurationPolicyAssociationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	if d.HasChange("target_ids") {
+		o, n := d.GetChange("target_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if err := startConfigurationPolicyDisassociations(ctx, conn, d.Id(), del); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := startConfigurationPolicyAssociations(ctx, conn, d.Id(), add); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if _, err := waitConfigurationPolicyAssociationsSucceeded(ctx, conn, add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Configuration Policy Associations (%s) success: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConfigurationPolicyAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyAssociationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy Associations: %s", d.Id())
+	if err := startConfigurationPolicyDisassociations(ctx, conn, d.Id(), flex.ExpandStringValueSet(d.Get("target_ids").(*schema.Set))); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func startConfigurationPolicyAssociations(ctx context.Context, conn *securityhub.Client, policyID string, targetIDs []string) error {
+	var errs []error
+
+	for _, targetID := range targetIDs {
+		input := &securityhub.StartConfigurationPolicyAssociationInput{
+			ConfigurationPolicyIdentifier: aws.String(policyID),
+			Target:                        expandTarget(targetID),
+		}
+
+		if _, err := conn.StartConfigurationPolicyAssociation(ctx, input); err != nil {
+			errs = append(errs, fmt.Errorf("starting Security Hub Configuration Policy Association (%s): %w", targetID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func startConfigurationPolicyDisassociations(ctx context.Context, conn *securityhub.Client, policyID string, targetIDs []string) error {
+	var errs []error
+
+	for _, targetID := range targetIDs {
+		input := &securityhub.StartConfigurationPolicyDisassociationInput{
+			ConfigurationPolicyIdentifier: aws.String(policyID),
+			Target:                        expandTarget(targetID),
+		}
+
+		_, err := conn.StartConfigurationPolicyDisassociation(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("starting Security Hub Configuration Policy Disassociation (%s): %w", targetID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func findConfigurationPolicyAssociationsByPolicyID(ctx context.Context, conn *securityhub.Client, policyID string) ([]types.ConfigurationPolicyAssociationSummary, error) {
+	input := &securityhub.ListConfigurationPolicyAssociationsInput{
+		Filters: &types.AssociationFilters{
+			AssociationType:       types.AssociationTypeApplied,
+			ConfigurationPolicyId: aws.String(policyID),
+		},
+	}
+
+	return findConfigurationPolicyAssociations(ctx, conn, input)
+}
+
+func findConfigurationPolicyAssociations(ctx context.Context, conn *securityhub.Client, input *securityhub.ListConfigurationPolicyAssociationsInput) ([]types.ConfigurationPolicyAssociationSummary, error) {
+	var output []types.ConfigurationPolicyAssociationSummary
+
+	pages := securityhub.NewListConfigurationPolicyAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, errCodeAccessDeniedException, "Must be a Security Hub delegated administrator with Central Configuration enabled") || tfawserr.ErrMessageContains(err, errCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ConfigurationPolicyAssociationSummaries...)
+	}
+
+	return output, nil
+}
+
+func findConfigurationPolicyAssociationsByTargetIDs(ctx context.Context, conn *securityhub.Client, targetIDs []string) ([]types.ConfigurationPolicyAssociationSummary, error) {
+	input := &securityhub.BatchGetConfigurationPolicyAssociationsInput{}
+	for _, targetID := range targetIDs {
+		input.ConfigurationPolicyAssociationIdentifiers = append(input.ConfigurationPolicyAssociationIdentifiers, types.ConfigurationPolicyAssociation{
+			Target: expandTarget(targetID),
+		})
+	}
+
+	output, err := conn.BatchGetConfigurationPolicyAssociations(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	var errs []error
+	for _, v := range output.UnprocessedConfigurationPolicyAssociations {
+		errs = append(errs, fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.ErrorReason)))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return output.ConfigurationPolicyAssociations, nil
+}
+
+// statusConfigurationPolicyAssociations returns the aggregate status of the associations for the specified targets.
+// The aggregate status is FAILED if any association has failed, PENDING if any association is pending and SUCCESS otherwise.
+func statusConfigurationPolicyAssociations(ctx context.Context, conn *securityhub.Client, targetIDs []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findConfigurationPolicyAssociationsByTargetIDs(ctx, conn, targetIDs)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := types.ConfigurationPolicyAssociationStatusSuccess
+		for _, v := range output {
+			switch v.AssociationStatus {
+			case types.ConfigurationPolicyAssociationStatusFailed:
+				return output, string(v.AssociationStatus), nil
+			case types.ConfigurationPolicyAssociationStatusPending:
+				status = v.AssociationStatus
+			}
+		}
+
+		return output, string(status), nil
+	}
+}
+
+func waitConfigurationPolicyAssociationsSucceeded(ctx context.Context, conn *securityhub.Client, targetIDs []string, timeout time.Duration) ([]types.ConfigurationPolicyAssociationSummary, error) {
+	if len(targetIDs) == 0 {
+		return nil, nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ConfigurationPolicyAssociationStatusPending),
+		Target:  enum.Slice(types.ConfigurationPolicyAssociationStatusSuccess),
+		Refresh: statusConfigurationPolicyAssociations(ctx, conn, targetIDs),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if tfresource.TimedOut(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy Associations (%v) still in PENDING state. It can take up to 24 hours for the status to change from PENDING to SUCCESS or FAILURE", targetIDs)
+		// Don't error if still in PENDING state, consistent with aws_securityhub_configuration_policy_association.
+		return findConfigurationPolicyAssociationsByTargetIDs(ctx, conn, targetIDs)
+	}
+
+	if output, ok := outputRaw.([]types.ConfigurationPolicyAssociationSummary); ok {
+		var errs []error
+		for _, v := range output {
+			if v.AssociationStatus == types.ConfigurationPolicyAssociationStatusFailed {
+				errs = append(errs, fmt.Errorf("%s: %s", aws.ToString(v.TargetId), aws.ToString(v.AssociationStatusMessage)))
+			}
+		}
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return output, err
+	}
+
+	return nil, err
+}
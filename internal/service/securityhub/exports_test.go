@@ -5,27 +5,30 @@ package securityhub
 
 // Exports for use in tests only.
 var (
-	ResourceAccount                        = resourceAccount
-	ResourceActionTarget                   = resourceActionTarget
-	ResourceAutomationRule                 = newAutomationRuleResource
-	ResourceConfigurationPolicy            = resourceConfigurationPolicy
-	ResourceConfigurationPolicyAssociation = resourceConfigurationPolicyAssociation
-	ResourceFindingAggregator              = resourceFindingAggregator
-	ResourceInsight                        = resourceInsight
-	ResourceInviteAccepter                 = resourceInviteAccepter
-	ResourceMember                         = resourceMember
-	ResourceOrganizationAdminAccount       = resourceOrganizationAdminAccount
-	ResourceOrganizationConfiguration      = resourceOrganizationConfiguration
-	ResourceProductSubscription            = resourceProductSubscription
-	ResourceStandardsControl               = resourceStandardsControl
-	ResourceStandardsControlAssociation    = newStandardsControlAssociationResource
-	ResourceStandardsSubscription          = resourceStandardsSubscription
+	ResourceAccount                         = resourceAccount
+	ResourceActionTarget                    = resourceActionTarget
+	ResourceAutomationRule                  = newAutomationRuleResource
+	ResourceAutomationRules                 = newAutomationRulesResource
+	ResourceConfigurationPolicy             = resourceConfigurationPolicy
+	ResourceConfigurationPolicyAssociation  = resourceConfigurationPolicyAssociation
+	ResourceConfigurationPolicyAssociations = resourceConfigurationPolicyAssociations
+	ResourceFindingAggregator               = resourceFindingAggregator
+	ResourceInsight                         = resourceInsight
+	ResourceInviteAccepter                  = resourceInviteAccepter
+	ResourceMember                          = resourceMember
+	ResourceOrganizationAdminAccount        = resourceOrganizationAdminAccount
+	ResourceOrganizationConfiguration       = resourceOrganizationConfiguration
+	ResourceProductSubscription             = resourceProductSubscription
+	ResourceStandardsControl                = resourceStandardsControl
+	ResourceStandardsControlAssociation     = newStandardsControlAssociationResource
+	ResourceStandardsSubscription           = resourceStandardsSubscription
 
 	AccountHubARN                                 = accountHubARN
 	FindActionTargetByARN                         = findActionTargetByARN
 	FindAdminAccountByID                          = findAdminAccountByID
 	FindAutomationRuleByARN                       = findAutomationRuleByARN
 	FindConfigurationPolicyAssociationByID        = findConfigurationPolicyAssociationByID
+	FindConfigurationPolicyAssociationsByPolicyID = findConfigurationPolicyAssociationsByPolicyID
 	FindConfigurationPolicyByID                   = findConfigurationPolicyByID
 	FindFindingAggregatorByARN                    = findFindingAggregatorByARN
 	FindHubByARN                                  = findHubByARN
@@ -30,6 +30,15 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"dateFilters":        testAccAutomationRule_dateFilters,
 			"mapFilters":         testAccAutomationRule_mapFilters,
 			"tags":               testAccAutomationRule_tags,
+			"ruleOrderSwap":      testAccAutomationRule_ruleOrderSwap,
+		},
+		"AutomationRules": {
+			acctest.CtBasic:      testAccAutomationRules_basic,
+			acctest.CtDisappears: testAccAutomationRules_disappears,
+			"update":             testAccAutomationRules_update,
+			"duplicateRuleOrder": testAccAutomationRules_duplicateRuleOrder,
+			"duplicateRuleName":  testAccAutomationRules_duplicateRuleName,
+			"insert":             testAccAutomationRules_insert,
 		},
 		"ActionTarget": {
 			acctest.CtBasic:      testAccActionTarget_basic,
@@ -47,6 +56,10 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			acctest.CtBasic:      testAccConfigurationPolicyAssociation_basic,
 			acctest.CtDisappears: testAccConfigurationPolicyAssociation_disappears,
 		},
+		"ConfigurationPolicyAssociations": {
+			acctest.CtBasic:      testAccConfigurationPolicyAssociations_basic,
+			acctest.CtDisappears: testAccConfigurationPolicyAssociations_disappears,
+		},
 		"FindingAggregator": {
 			acctest.CtBasic:      testAccFindingAggregator_basic,
 			acctest.CtDisappears: testAccFindingAggregator_disappears,
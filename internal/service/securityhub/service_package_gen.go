@@ -32,6 +32,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newAutomationRulesResource,
+			Name:    "Automation Rules",
+		},
 		{
 			Factory: newStandardsControlAssociationResource,
 			Name:    "Standards Control Association",
@@ -65,6 +69,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_securityhub_configuration_policy_association",
 			Name:     "Configuration Policy Association",
 		},
+		{
+			Factory:  resourceConfigurationPolicyAssociations,
+			TypeName: "aws_securityhub_configuration_policy_associations",
+			Name:     "Configuration Policy Associations",
+		},
 		{
 			Factory:  resourceFindingAggregator,
 			TypeName: "aws_securityhub_finding_aggregator",
@@ -309,11 +309,22 @@ func (r *dataLakeResource) Update(ctx context.Context, request resource.UpdateRe
 			return
 		}
 
-		if _, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		dataLake, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) update", new.ID.ValueString()), err.Error())
 
 			return
 		}
+
+		var configuration dataLakeConfigurationModel
+		response.Diagnostics.Append(fwflex.Flatten(ctx, dataLake, &configuration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Lifecycle transitions/expiration and replication Regions are updated in place.
+		new.Configurations = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &configuration)
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
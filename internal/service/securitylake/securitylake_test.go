@@ -44,6 +44,7 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"lifecycle":          testAccDataLake_lifeCycle,
 			"lifecycleUpdate":    testAccDataLake_lifeCycleUpdate,
 			"replication":        testAccDataLake_replication,
+			"replicationUpdate":  testAccDataLake_replicationUpdate,
 		},
 		"Subscriber": {
 			"accessType":         testAccSubscriber_accessType,
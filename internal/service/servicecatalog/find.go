@@ -208,3 +208,53 @@ func findProductByID(ctx context.Context, conn *servicecatalog.Client, productID
 
 	return out, nil
 }
+
+// findLatestProvisioningArtifactID returns the ID of the most recently created active, non-deprecated provisioning artifact of a product.
+func findLatestProvisioningArtifactID(ctx context.Context, conn *servicecatalog.Client, acceptLanguage, productID, productName string) (string, error) {
+	in := &servicecatalog.DescribeProductInput{}
+
+	if acceptLanguage != "" {
+		in.AcceptLanguage = aws.String(acceptLanguage)
+	}
+
+	if productID != "" {
+		in.Id = aws.String(productID)
+	} else {
+		in.Name = aws.String(productName)
+	}
+
+	out, err := conn.DescribeProduct(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if out == nil {
+		return "", tfresource.NewEmptyResultError(in)
+	}
+
+	var latest *awstypes.ProvisioningArtifact
+
+	for _, v := range out.ProvisioningArtifacts {
+		if v.Guidance == awstypes.ProvisioningArtifactGuidanceDeprecated {
+			continue
+		}
+
+		if latest == nil || aws.ToTime(v.CreatedTime).After(aws.ToTime(latest.CreatedTime)) {
+			latest = &v
+		}
+	}
+
+	if latest == nil {
+		return "", tfresource.NewEmptyResultError(in)
+	}
+
+	return aws.ToString(latest.Id), nil
+}
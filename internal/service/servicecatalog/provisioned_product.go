@@ -104,6 +104,11 @@ func resourceProvisionedProduct() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"output_values": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"outputs": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -160,17 +165,15 @@ func resourceProvisionedProduct() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ExactlyOneOf: []string{
-					"provisioning_artifact_id",
+				ConflictsWith: []string{
 					"provisioning_artifact_name",
 				},
 			},
 			"provisioning_artifact_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ExactlyOneOf: []string{
+				ConflictsWith: []string{
 					"provisioning_artifact_id",
-					"provisioning_artifact_name",
 				},
 			},
 			"provisioning_parameters": {
@@ -259,6 +262,11 @@ func resourceProvisionedProduct() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"track_latest_provisioning_artifact": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrType: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -266,6 +274,7 @@ func resourceProvisionedProduct() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
+			provisioningArtifactDiff,
 			refreshOutputsDiff,
 			verify.SetTagsDiff,
 		),
@@ -277,6 +286,58 @@ func refreshOutputsDiff(_ context.Context, diff *schema.ResourceDiff, meta inter
 		if err := diff.SetNewComputed("outputs"); err != nil {
 			return err
 		}
+
+		if err := diff.SetNewComputed("output_values"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// provisioningArtifactDiff requires exactly one way of selecting the provisioning artifact and,
+// when tracking the latest artifact, plans an update to the product's newest active artifact.
+func provisioningArtifactDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	artifactConfigured := !rawConfig.GetAttr("provisioning_artifact_id").IsNull() || !rawConfig.GetAttr("provisioning_artifact_name").IsNull()
+
+	if !diff.Get("track_latest_provisioning_artifact").(bool) {
+		if !artifactConfigured {
+			return errors.New(`one of "provisioning_artifact_id" or "provisioning_artifact_name" must be specified when "track_latest_provisioning_artifact" is false`)
+		}
+
+		return nil
+	}
+
+	if artifactConfigured {
+		return errors.New(`"track_latest_provisioning_artifact" cannot be specified with "provisioning_artifact_id" or "provisioning_artifact_name"`)
+	}
+
+	productID, productName := "", diff.Get("product_name").(string)
+	if productName == "" {
+		if !diff.NewValueKnown("product_id") {
+			return nil
+		}
+
+		productID = diff.Get("product_id").(string)
+	} else if !diff.NewValueKnown("product_name") {
+		return nil
+	}
+
+	if productID == "" && productName == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ServiceCatalogClient(ctx)
+
+	artifactID, err := findLatestProvisioningArtifactID(ctx, conn, diff.Get("accept_language").(string), productID, productName)
+
+	if err != nil {
+		return fmt.Errorf("finding latest Service Catalog Provisioning Artifact: %w", err)
+	}
+
+	if diff.Get("provisioning_artifact_id").(string) != artifactID {
+		return diff.SetNew("provisioning_artifact_id", artifactID)
 	}
 
 	return nil
@@ -324,6 +385,17 @@ func resourceProvisionedProductCreate(ctx context.Context, d *schema.ResourceDat
 		input.ProvisioningArtifactName = aws.String(v.(string))
 	}
 
+	// The product may not have been known at plan time.
+	if d.Get("track_latest_provisioning_artifact").(bool) && input.ProvisioningArtifactId == nil {
+		artifactID, err := findLatestProvisioningArtifactID(ctx, conn, d.Get("accept_language").(string), aws.ToString(input.ProductId), aws.ToString(input.ProductName))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "finding latest Service Catalog Provisioning Artifact: %s", err)
+		}
+
+		input.ProvisioningArtifactId = aws.String(artifactID)
+	}
+
 	if v, ok := d.GetOk("provisioning_parameters"); ok && len(v.([]interface{})) > 0 {
 		input.ProvisioningParameters = expandProvisioningParameters(v.([]interface{}))
 	}
@@ -481,6 +553,10 @@ func resourceProvisionedProductRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "setting outputs: %s", err)
 	}
 
+	if err := d.Set("output_values", flattenRecordOutputValues(recordOutput.RecordOutputs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_values: %s", err)
+	}
+
 	d.Set("path_id", recordOutput.RecordDetail.PathId)
 
 	setTagsOut(ctx, Tags(recordKeyValueTags(ctx, recordOutput.RecordDetail.RecordTags)))
@@ -790,3 +866,21 @@ func flattenRecordOutputs(apiObjects []awstypes.RecordOutput) []interface{} {
 
 	return tfList
 }
+
+func flattenRecordOutputValues(apiObjects []awstypes.RecordOutput) map[string]interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	for _, apiObject := range apiObjects {
+		if apiObject.OutputKey == nil {
+			continue
+		}
+
+		tfMap[aws.ToString(apiObject.OutputKey)] = aws.ToString(apiObject.OutputValue)
+	}
+
+	return tfMap
+}
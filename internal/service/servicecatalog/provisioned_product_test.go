@@ -57,9 +57,12 @@ func TestAccServiceCatalogProvisionedProduct_basic(t *testing.T) {
 					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "outputs.*", map[string]*regexp.Regexp{
 						names.AttrValue: regexache.MustCompile(`vpc-.+`),
 					}),
+					resource.TestCheckResourceAttr(resourceName, "output_values.%", "2"),
+					resource.TestMatchResourceAttr(resourceName, "output_values.VpcID", regexache.MustCompile(`vpc-.+`)),
 					resource.TestCheckResourceAttrPair(resourceName, "path_id", "data.aws_servicecatalog_launch_paths.test", "summaries.0.path_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", names.AttrID),
 					resource.TestCheckResourceAttrPair(resourceName, "provisioning_artifact_name", "aws_servicecatalog_product.test", "provisioning_artifact_parameters.0.name"),
+					resource.TestCheckResourceAttr(resourceName, "track_latest_provisioning_artifact", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.StatusAvailable)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrType, "CFN_STACK"),
 				),
@@ -293,6 +296,50 @@ func TestAccServiceCatalogProvisionedProduct_ProvisioningArtifactName_update(t *
 	})
 }
 
+func TestAccServiceCatalogProvisionedProduct_trackLatestProvisioningArtifact(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+	artifactResourceName := "aws_servicecatalog_provisioning_artifact.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	artifactName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var pprod1, pprod2 awstypes.ProvisionedProductDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedProductDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisionedProductConfig_trackLatestProvisioningArtifactConflict(rName, "10.1.0.0/16"),
+				ExpectError: regexache.MustCompile(`"track_latest_provisioning_artifact" cannot be specified with`),
+			},
+			{
+				Config: testAccProvisionedProductConfig_trackLatestProvisioningArtifact(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod1),
+					resource.TestCheckResourceAttr(resourceName, "track_latest_provisioning_artifact", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "provisioning_artifact_id"),
+				),
+			},
+			{
+				// The new artifact is created during this apply, so the provisioned product is only updated on the next one.
+				Config:             testAccProvisionedProductConfig_trackLatestProvisioningArtifactNewArtifact(rName, "10.1.0.0/16", artifactName),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccProvisionedProductConfig_trackLatestProvisioningArtifactNewArtifact(rName, "10.1.0.0/16", artifactName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedProductExists(ctx, resourceName, &pprod2),
+					resource.TestCheckResourceAttrPair(resourceName, "provisioning_artifact_id", artifactResourceName, "provisioning_artifact_id"),
+					testAccCheckProvisionedProductProvisioningArtifactIDChanged(&pprod1, &pprod2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccServiceCatalogProvisionedProduct_computedOutputs(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_servicecatalog_provisioned_product.test"
@@ -918,6 +965,63 @@ resource "aws_servicecatalog_provisioned_product" "test" {
 `, rName, vpcCidr, artifactName))
 }
 
+func testAccProvisionedProductConfig_trackLatestProvisioningArtifactConflict(rName, vpcCidr string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                               = %[1]q
+  product_id                         = aws_servicecatalog_product.test.id
+  provisioning_artifact_name         = %[1]q
+  path_id                            = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+  track_latest_provisioning_artifact = true
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = ""
+  }
+}
+`, rName, vpcCidr))
+}
+
+func testAccProvisionedProductConfig_trackLatestProvisioningArtifact(rName, vpcCidr string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductTemplateURLBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                               = %[1]q
+  product_id                         = aws_servicecatalog_product.test.id
+  path_id                            = data.aws_servicecatalog_launch_paths.test.summaries[0].path_id
+  track_latest_provisioning_artifact = true
+
+  provisioning_parameters {
+    key   = "VPCPrimaryCIDR"
+    value = %[2]q
+  }
+
+  provisioning_parameters {
+    key   = "LeaveMeEmpty"
+    value = ""
+  }
+}
+`, rName, vpcCidr))
+}
+
+func testAccProvisionedProductConfig_trackLatestProvisioningArtifactNewArtifact(rName, vpcCidr, artifactName string) string {
+	return acctest.ConfigCompose(testAccProvisionedProductConfig_trackLatestProvisioningArtifact(rName, vpcCidr),
+		fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  product_id   = aws_servicecatalog_product.test.id
+  template_url = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_object.test.key}"
+  name         = %[1]q
+  type         = "CLOUD_FORMATION_TEMPLATE"
+}
+`, artifactName))
+}
+
 // Because the `provisioning_parameter` "LeaveMeEmpty" is not empty, this configuration results in an error.
 // The `status_message` will be:
 // AmazonCloudFormationException  Unresolved resource dependencies [MyVPC] in the Outputs block of the template
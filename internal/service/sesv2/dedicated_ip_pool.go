@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -52,14 +53,19 @@ func resourceDedicatedIPPool() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[types.ScalingMode](),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// A managed pool cannot be converted back to a standard pool.
+			customdiff.ForceNewIfChange("scaling_mode", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(string) == string(types.ScalingModeManaged) && new.(string) == string(types.ScalingModeStandard)
+			}),
+		),
 	}
 }
 
@@ -116,8 +122,22 @@ func resourceDedicatedIPPoolRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceDedicatedIPPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Tags only.
-	return resourceDedicatedIPPoolRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SESV2Client(ctx)
+
+	if d.HasChange("scaling_mode") {
+		in := &sesv2.PutDedicatedIpPoolScalingAttributesInput{
+			PoolName:    aws.String(d.Id()),
+			ScalingMode: types.ScalingMode(d.Get("scaling_mode").(string)),
+		}
+
+		_, err := conn.PutDedicatedIpPoolScalingAttributes(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.SESV2, create.ErrActionUpdating, resNameDedicatedIPPool, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDedicatedIPPoolRead(ctx, d, meta)...)
 }
 
 func resourceDedicatedIPPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -5,9 +5,11 @@ package shield
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	"github.com/aws/aws-sdk-go-v2/service/shield/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -40,6 +43,15 @@ func resourceProtection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"health_check_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -78,6 +90,12 @@ func resourceProtectionCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(output.ProtectionId))
 
+	if v, ok := d.GetOk("health_check_arns"); ok && v.(*schema.Set).Len() > 0 {
+		if err := associateProtectionHealthChecks(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set))); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceProtectionRead(ctx, d, meta)...)
 }
 
@@ -98,6 +116,15 @@ func resourceProtectionRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	d.Set(names.AttrARN, protection.ProtectionArn)
+	healthCheckARNs := make([]string, 0, len(protection.HealthCheckIds))
+	for _, id := range protection.HealthCheckIds {
+		healthCheckARNs = append(healthCheckARNs, arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition(ctx),
+			Service:   "route53",
+			Resource:  "healthcheck/" + id,
+		}.String())
+	}
+	d.Set("health_check_arns", healthCheckARNs)
 	d.Set(names.AttrName, protection.Name)
 	d.Set(names.AttrResourceARN, protection.ResourceArn)
 
@@ -106,8 +133,21 @@ func resourceProtectionRead(ctx context.Context, d *schema.ResourceData, meta in
 
 func resourceProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ShieldClient(ctx)
 
-	// Tags only.
+	if d.HasChange("health_check_arns") {
+		o, n := d.GetChange("health_check_arns")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		del, add := flex.ExpandStringValueSet(os.Difference(ns)), flex.ExpandStringValueSet(ns.Difference(os))
+
+		if err := disassociateProtectionHealthChecks(ctx, conn, d.Id(), del); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := associateProtectionHealthChecks(ctx, conn, d.Id(), add); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
 
 	return append(diags, resourceProtectionRead(ctx, d, meta)...)
 }
@@ -131,6 +171,44 @@ func resourceProtectionDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func associateProtectionHealthChecks(ctx context.Context, conn *shield.Client, protectionID string, healthCheckARNs []string) error {
+	for _, healthCheckARN := range healthCheckARNs {
+		input := &shield.AssociateHealthCheckInput{
+			HealthCheckArn: aws.String(healthCheckARN),
+			ProtectionId:   aws.String(protectionID),
+		}
+
+		_, err := conn.AssociateHealthCheck(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("associating Route53 Health Check (%s) with Shield Protection (%s): %w", healthCheckARN, protectionID, err)
+		}
+	}
+
+	return nil
+}
+
+func disassociateProtectionHealthChecks(ctx context.Context, conn *shield.Client, protectionID string, healthCheckARNs []string) error {
+	for _, healthCheckARN := range healthCheckARNs {
+		input := &shield.DisassociateHealthCheckInput{
+			HealthCheckArn: aws.String(healthCheckARN),
+			ProtectionId:   aws.String(protectionID),
+		}
+
+		_, err := conn.DisassociateHealthCheck(ctx, input)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating Route53 Health Check (%s) from Shield Protection (%s): %w", healthCheckARN, protectionID, err)
+		}
+	}
+
+	return nil
+}
+
 func findProtectionByID(ctx context.Context, conn *shield.Client, id string) (*types.Protection, error) {
 	input := &shield.DescribeProtectionInput{
 		ProtectionId: aws.String(id),
@@ -86,6 +86,47 @@ func TestAccShieldProtection_elasticIPAddress(t *testing.T) {
 	})
 }
 
+func TestAccShieldProtection_healthCheckARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_shield_protection.test"
+	rName := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ShieldEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ShieldServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProtectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProtectionConfig_healthCheckARNs(rName, "aws_route53_health_check.test[0].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProtectionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "health_check_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "health_check_arns.*", "aws_route53_health_check.test.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProtectionConfig_healthCheckARNs(rName, "aws_route53_health_check.test[1].arn, aws_route53_health_check.test[2].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProtectionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "health_check_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "health_check_arns.*", "aws_route53_health_check.test.1", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "health_check_arns.*", "aws_route53_health_check.test.2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccShieldProtection_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_shield_protection.test"
@@ -782,6 +823,45 @@ resource "aws_shield_protection" "test" {
 `, rName)
 }
 
+func testAccProtectionConfig_healthCheckARNs(rName, healthCheckARNs string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_eip" "test" {
+  domain = "vpc"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_health_check" "test" {
+  count = 3
+
+  fqdn              = "example${count.index}.com"
+  port              = 80
+  type              = "HTTP"
+  resource_path     = "/"
+  failure_threshold = "5"
+  request_interval  = "30"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_shield_protection" "test" {
+  name              = %[1]q
+  resource_arn      = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:eip-allocation/${aws_eip.test.id}"
+  health_check_arns = [%[2]s]
+}
+`, rName, healthCheckARNs)
+}
+
 func testAccProtectionConfig_globalAccelerator(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_shield_protection" "test" {
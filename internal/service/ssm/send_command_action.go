@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	sendCommandDefaultTimeout = 30 * time.Minute
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+// @Region(overrideEnabled=true)
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+type sendCommandAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *sendCommandAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "aws_ssm_send_command"
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Runs an AWS Systems Manager document on one or more managed nodes and waits for the command to complete.",
+		Attributes: map[string]schema.Attribute{
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "User-specified information about the command.",
+			},
+			"document_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name or ARN of the SSM document to run.",
+			},
+			"document_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "SSM document version to use.",
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "IDs of the managed nodes on which the command should run.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			names.AttrParameters: schema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Required and optional parameters specified in the document being run.",
+			},
+			names.AttrTimeout: schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: "How long to wait for the command to complete, e.g. `10m`. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var config sendCommandActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+	var input ssm.SendCommandInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Parameters.IsNull() {
+		var parameters map[string][]string
+		response.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Parameters = parameters
+	}
+
+	timeout := sendCommandDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = config.Timeout.ValueDuration()
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending SSM Command (%s)...", documentName),
+	})
+
+	output, err := conn.SendCommand(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("sending SSM Command (%s)", documentName), err.Error())
+
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for SSM Command (%s) to complete...", commandID),
+	})
+
+	command, err := waitCommandSucceeded(ctx, conn, commandID, timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SSM Command (%s) complete", commandID), err.Error())
+
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("SSM Command (%s) completed on %d of %d managed nodes", commandID, command.CompletedCount, command.TargetCount),
+	})
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment         types.String         `tfsdk:"comment"`
+	DocumentName    types.String         `tfsdk:"document_name"`
+	DocumentVersion types.String         `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString `tfsdk:"instance_ids"`
+	Parameters      types.Map            `tfsdk:"parameters" autoflex:"-"`
+	Timeout         fwtypes.Duration     `tfsdk:"timeout" autoflex:"-"`
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	return findCommand(ctx, conn, input)
+}
+
+func findCommand(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) (*awstypes.Command, error) {
+	output, err := findCommands(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCommands(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) ([]awstypes.Command, error) {
+	var output []awstypes.Command
+
+	pages := ssm.NewListCommandsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Commands...)
+	}
+
+	return output, nil
+}
+
+func statusCommand(ctx context.Context, conn *ssm.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCommandByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCommandSucceeded(ctx context.Context, conn *ssm.Client, id string, timeout time.Duration) (*awstypes.Command, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.CommandStatusPending, awstypes.CommandStatusInProgress, awstypes.CommandStatusCancelling),
+		Target:     enum.Slice(awstypes.CommandStatusSuccess),
+		Refresh:    statusCommand(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Command); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
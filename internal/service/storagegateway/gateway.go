@@ -62,14 +62,66 @@ func resourceGateway() *schema.Resource {
 				Computed: true,
 			},
 			"average_download_rate_limit_in_bits_per_sec": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntAtLeast(102400),
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(102400),
+				ConflictsWith: []string{"bandwidth_rate_limit_interval"},
 			},
 			"average_upload_rate_limit_in_bits_per_sec": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				ValidateFunc: validation.IntAtLeast(51200),
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(51200),
+				ConflictsWith: []string{"bandwidth_rate_limit_interval"},
+			},
+			"bandwidth_rate_limit_interval": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      20,
+				ConflictsWith: []string{"average_download_rate_limit_in_bits_per_sec", "average_upload_rate_limit_in_bits_per_sec"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"average_download_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(102400),
+						},
+						"average_upload_rate_limit_in_bits_per_sec": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(51200),
+						},
+						"days_of_week": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 7,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 6),
+							},
+						},
+						"end_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"end_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+						"start_hour_of_day": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"start_minute_of_hour": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
 			},
 			names.AttrCloudWatchLogGroupARN: {
 				Type:         schema.TypeString,
@@ -84,6 +136,12 @@ func resourceGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"gateway_capacity": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.GatewayCapacity](),
+			},
 			"gateway_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -167,6 +225,21 @@ func resourceGateway() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.IntBetween(0, 59),
 						},
+						"software_update_preferences": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"automatic_update_policy": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.AutomaticUpdatePolicy](),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -255,6 +328,11 @@ func resourceGateway() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.SMBSecurityStrategy](),
 			},
+			"supported_gateway_capacities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"tape_drive_type": {
@@ -395,6 +473,19 @@ func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if v, ok := d.GetOk("gateway_capacity"); ok {
+		input := &storagegateway.UpdateGatewayInformationInput{
+			GatewayARN:      aws.String(d.Id()),
+			GatewayCapacity: awstypes.GatewayCapacity(v.(string)),
+		}
+
+		_, err := conn.UpdateGatewayInformation(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Storage Gateway Gateway (%s) gateway capacity: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("maintenance_start_time"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input := expandUpdateMaintenanceStartTimeInput(v.([]interface{})[0].(map[string]interface{}))
 		input.GatewayARN = aws.String(d.Id())
@@ -482,6 +573,19 @@ func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if v, ok := d.GetOk("bandwidth_rate_limit_interval"); ok && len(v.([]interface{})) > 0 {
+		input := &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+			BandwidthRateLimitIntervals: expandBandwidthRateLimitIntervals(v.([]interface{})),
+			GatewayARN:                  aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateBandwidthRateLimitSchedule(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Storage Gateway Gateway (%s) bandwidth rate limit schedule: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceGatewayRead(ctx, d, meta)...)
 }
 
@@ -514,6 +618,7 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set(names.AttrCloudWatchLogGroupARN, outputDGI.CloudWatchLogGroupARN)
 	d.Set("ec2_instance_id", outputDGI.Ec2InstanceId)
 	d.Set(names.AttrEndpointType, outputDGI.EndpointType)
+	d.Set("gateway_capacity", outputDGI.GatewayCapacity)
 	d.Set("gateway_id", outputDGI.GatewayId)
 	d.Set("gateway_ip_address", d.Get("gateway_ip_address").(string))
 	d.Set("gateway_name", outputDGI.GatewayName)
@@ -566,6 +671,7 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("smb_file_share_visibility", outputDSS.FileSharesVisible)
 		d.Set("smb_security_strategy", outputDSS.SMBSecurityStrategy)
 	}
+	d.Set("supported_gateway_capacities", outputDGI.SupportedGatewayCapacities)
 	d.Set("tape_drive_type", d.Get("tape_drive_type").(string))
 
 	setTagsOut(ctx, outputDGI.Tags)
@@ -589,6 +695,27 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	outputDBRLS, err := findBandwidthRateLimitScheduleByARN(ctx, conn, d.Id())
+
+	switch {
+	case errs.IsAErrorMessageContains[*awstypes.InvalidGatewayRequestException](err, "not supported"):
+		fallthrough
+	case errs.IsAErrorMessageContains[*awstypes.InvalidGatewayRequestException](err, "not valid"):
+		d.Set("bandwidth_rate_limit_interval", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Storage Gateway Gateway (%s) bandwidth rate limit schedule: %s", d.Id(), err)
+	default:
+		if err := d.Set("bandwidth_rate_limit_interval", flattenBandwidthRateLimitIntervals(outputDBRLS.BandwidthRateLimitIntervals)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting bandwidth_rate_limit_interval: %s", err)
+		}
+
+		// While a schedule is in effect the gateway reports the active interval's limits.
+		if len(outputDBRLS.BandwidthRateLimitIntervals) > 0 {
+			d.Set("average_download_rate_limit_in_bits_per_sec", nil)
+			d.Set("average_upload_rate_limit_in_bits_per_sec", nil)
+		}
+	}
+
 	input := &storagegateway.DescribeMaintenanceStartTimeInput{
 		GatewayARN: aws.String(d.Id()),
 	}
@@ -630,6 +757,19 @@ func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("gateway_capacity") {
+		input := &storagegateway.UpdateGatewayInformationInput{
+			GatewayARN:      aws.String(d.Id()),
+			GatewayCapacity: awstypes.GatewayCapacity(d.Get("gateway_capacity").(string)),
+		}
+
+		_, err := conn.UpdateGatewayInformation(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Storage Gateway Gateway (%s) gateway capacity: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("maintenance_start_time") {
 		if v, ok := d.GetOk("maintenance_start_time"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input := expandUpdateMaintenanceStartTimeInput(v.([]interface{})[0].(map[string]interface{}))
@@ -743,6 +883,19 @@ func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("bandwidth_rate_limit_interval") {
+		input := &storagegateway.UpdateBandwidthRateLimitScheduleInput{
+			BandwidthRateLimitIntervals: expandBandwidthRateLimitIntervals(d.Get("bandwidth_rate_limit_interval").([]interface{})),
+			GatewayARN:                  aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateBandwidthRateLimitSchedule(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Storage Gateway Gateway (%s) bandwidth rate limit schedule: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceGatewayRead(ctx, d, meta)...)
 }
 
@@ -795,6 +948,35 @@ func findGateway(ctx context.Context, conn *storagegateway.Client, input *storag
 	return output, nil
 }
 
+func findBandwidthRateLimitScheduleByARN(ctx context.Context, conn *storagegateway.Client, arn string) (*storagegateway.DescribeBandwidthRateLimitScheduleOutput, error) {
+	input := &storagegateway.DescribeBandwidthRateLimitScheduleInput{
+		GatewayARN: aws.String(arn),
+	}
+
+	return findBandwidthRateLimitSchedule(ctx, conn, input)
+}
+
+func findBandwidthRateLimitSchedule(ctx context.Context, conn *storagegateway.Client, input *storagegateway.DescribeBandwidthRateLimitScheduleInput) (*storagegateway.DescribeBandwidthRateLimitScheduleOutput, error) {
+	output, err := conn.DescribeBandwidthRateLimitSchedule(ctx, input)
+
+	if isGatewayNotFoundErr(err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func findSMBSettingsByARN(ctx context.Context, conn *storagegateway.Client, arn string) (*storagegateway.DescribeSMBSettingsOutput, error) {
 	input := &storagegateway.DescribeSMBSettingsInput{
 		GatewayARN: aws.String(arn),
@@ -974,6 +1156,24 @@ func expandUpdateMaintenanceStartTimeInput(tfMap map[string]interface{}) *storag
 		apiObject.MinuteOfHour = aws.Int32(int32(v))
 	}
 
+	if v, ok := tfMap["software_update_preferences"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SoftwareUpdatePreferences = expandSoftwareUpdatePreferences(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSoftwareUpdatePreferences(tfMap map[string]interface{}) *awstypes.SoftwareUpdatePreferences {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.SoftwareUpdatePreferences{}
+
+	if v, ok := tfMap["automatic_update_policy"].(string); ok && v != "" {
+		apiObject.AutomaticUpdatePolicy = awstypes.AutomaticUpdatePolicy(v)
+	}
+
 	return apiObject
 }
 
@@ -1000,5 +1200,78 @@ func flattenDescribeMaintenanceStartTimeOutput(apiObject *storagegateway.Describ
 		tfMap["minute_of_hour"] = aws.ToInt32(v)
 	}
 
+	if v := apiObject.SoftwareUpdatePreferences; v != nil {
+		tfMap["software_update_preferences"] = []interface{}{flattenSoftwareUpdatePreferences(v)}
+	}
+
+	return tfMap
+}
+
+func flattenSoftwareUpdatePreferences(apiObject *awstypes.SoftwareUpdatePreferences) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"automatic_update_policy": apiObject.AutomaticUpdatePolicy,
+	}
+
 	return tfMap
 }
+
+func expandBandwidthRateLimitIntervals(tfList []interface{}) []awstypes.BandwidthRateLimitInterval {
+	// An empty list removes all scheduled intervals.
+	apiObjects := []awstypes.BandwidthRateLimitInterval{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.BandwidthRateLimitInterval{
+			DaysOfWeek:        flex.ExpandInt32ValueSet(tfMap["days_of_week"].(*schema.Set)),
+			EndHourOfDay:      aws.Int32(int32(tfMap["end_hour_of_day"].(int))),
+			EndMinuteOfHour:   aws.Int32(int32(tfMap["end_minute_of_hour"].(int))),
+			StartHourOfDay:    aws.Int32(int32(tfMap["start_hour_of_day"].(int))),
+			StartMinuteOfHour: aws.Int32(int32(tfMap["start_minute_of_hour"].(int))),
+		}
+
+		if v, ok := tfMap["average_download_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageDownloadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["average_upload_rate_limit_in_bits_per_sec"].(int); ok && v > 0 {
+			apiObject.AverageUploadRateLimitInBitsPerSec = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBandwidthRateLimitIntervals(apiObjects []awstypes.BandwidthRateLimitInterval) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"average_download_rate_limit_in_bits_per_sec": aws.ToInt64(apiObject.AverageDownloadRateLimitInBitsPerSec),
+			"average_upload_rate_limit_in_bits_per_sec":   aws.ToInt64(apiObject.AverageUploadRateLimitInBitsPerSec),
+			"days_of_week":         flex.FlattenInt32ValueSet(apiObject.DaysOfWeek),
+			"end_hour_of_day":      aws.ToInt32(apiObject.EndHourOfDay),
+			"end_minute_of_hour":   aws.ToInt32(apiObject.EndMinuteOfHour),
+			"start_hour_of_day":    aws.ToInt32(apiObject.StartHourOfDay),
+			"start_minute_of_hour": aws.ToInt32(apiObject.StartMinuteOfHour),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -11,8 +11,10 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/storagegateway"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/storagegateway/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -871,6 +873,148 @@ func TestAccStorageGatewayGateway_maintenanceStartTime(t *testing.T) {
 	})
 }
 
+func TestAccStorageGatewayGateway_softwareUpdatePreferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_softwareUpdatePreferences(rName, string(awstypes.AutomaticUpdatePolicyEmergencyVersionsOnly)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.0.automatic_update_policy", string(awstypes.AutomaticUpdatePolicyEmergencyVersionsOnly)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+			{
+				Config: testAccGatewayConfig_softwareUpdatePreferences(rName, string(awstypes.AutomaticUpdatePolicyAllVersions)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_start_time.0.software_update_preferences.0.automatic_update_policy", string(awstypes.AutomaticUpdatePolicyAllVersions)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageGatewayGateway_bandwidthRateLimitInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_bandwidthRateLimitInterval(rName, 102400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_download_rate_limit_in_bits_per_sec", "102400"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_upload_rate_limit_in_bits_per_sec", "102400"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.days_of_week.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.start_hour_of_day", "8"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.start_minute_of_hour", "0"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.end_hour_of_day", "17"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.end_minute_of_hour", "59"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+			{
+				Config: testAccGatewayConfig_bandwidthRateLimitInterval(rName, 2*102400),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_download_rate_limit_in_bits_per_sec", "204800"),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.0.average_upload_rate_limit_in_bits_per_sec", "204800"),
+				),
+			},
+			{
+				Config: testAccGatewayConfig_typeCached(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "bandwidth_rate_limit_interval.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageGatewayGateway_gatewayCapacity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_gatewayCapacity(rName, string(awstypes.GatewayCapacitySmall)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "gateway_capacity", string(awstypes.GatewayCapacitySmall)),
+					resource.TestCheckResourceAttrSet(resourceName, "supported_gateway_capacities.#"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address"},
+			},
+			{
+				Config: testAccGatewayConfig_gatewayCapacity(rName, string(awstypes.GatewayCapacityMedium)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "gateway_capacity", string(awstypes.GatewayCapacityMedium)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGatewayDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayClient(ctx)
@@ -1494,3 +1638,57 @@ resource "aws_storagegateway_gateway" "test" {
 }
 `, rName, hourOfDay, minuteOfHour, dayOfWeek, dayOfMonth))
 }
+
+func testAccGatewayConfig_softwareUpdatePreferences(rName, automaticUpdatePolicy string) string {
+	return acctest.ConfigCompose(testAccGatewayConfig_baseTapeAndVolume(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "CACHED"
+
+  maintenance_start_time {
+    hour_of_day    = 22
+    minute_of_hour = 0
+    day_of_week    = 3
+
+    software_update_preferences {
+      automatic_update_policy = %[2]q
+    }
+  }
+}
+`, rName, automaticUpdatePolicy))
+}
+
+func testAccGatewayConfig_bandwidthRateLimitInterval(rName string, rate int) string {
+	return acctest.ConfigCompose(testAccGatewayConfig_baseTapeAndVolume(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "CACHED"
+
+  bandwidth_rate_limit_interval {
+    average_download_rate_limit_in_bits_per_sec = %[2]d
+    average_upload_rate_limit_in_bits_per_sec   = %[2]d
+    days_of_week                                = [1, 2, 3, 4, 5]
+    start_hour_of_day                           = 8
+    start_minute_of_hour                        = 0
+    end_hour_of_day                             = 17
+    end_minute_of_hour                          = 59
+  }
+}
+`, rName, rate))
+}
+
+func testAccGatewayConfig_gatewayCapacity(rName, gatewayCapacity string) string {
+	return acctest.ConfigCompose(testAccGatewayConfig_baseFile(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "FILE_S3"
+  gateway_capacity   = %[2]q
+}
+`, rName, gatewayCapacity))
+}
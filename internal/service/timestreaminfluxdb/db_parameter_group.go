@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_timestreaminfluxdb_db_parameter_group", name="DB Parameter Group")
+// @Tags(identifierAttribute="arn")
+func newResourceDBParameterGroup(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceDBParameterGroup{}, nil
+}
+
+const (
+	ResNameDBParameterGroup = "DB Parameter Group"
+)
+
+type resourceDBParameterGroup struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[resourceDBParameterGroupData]
+	framework.WithImportByID
+}
+
+func (r *resourceDBParameterGroup) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_timestreaminfluxdb_db_parameter_group"
+}
+
+func (r *resourceDBParameterGroup) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	durationBlock := func(description string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[durationData](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"duration_type": schema.StringAttribute{
+						CustomType:  fwtypes.StringEnumType[awstypes.DurationType](),
+						Required:    true,
+						Description: `The unit of the duration.`,
+					},
+					names.AttrValue: schema.Int64Attribute{
+						Required:    true,
+						Description: `The value of the duration.`,
+					},
+				},
+			},
+			Description: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: `A description of the DB parameter group.`,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 64),
+					stringvalidator.RegexMatches(
+						regexache.MustCompile("^[a-zA-Z][a-zA-Z0-9]*(-[a-zA-Z0-9]+)*$"),
+						"must start with a letter, contain only alphanumeric characters and hyphens, and must not end with a hyphen or contain two consecutive hyphens",
+					),
+				},
+				Description: `The name of the DB parameter group. The name must be unique per customer and per region.`,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[parametersData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"influxdbv2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[influxDBv2ParametersData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"flux_log_enabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: `Include option to show detailed logs for Flux queries.`,
+									},
+									"influxql_max_select_buckets": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Maximum number of group by time buckets a SELECT statement can create.`,
+									},
+									"influxql_max_select_point": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Maximum number of points a SELECT statement can process.`,
+									},
+									"influxql_max_select_series": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Maximum number of series a SELECT statement can return.`,
+									},
+									"log_level": schema.StringAttribute{
+										CustomType:  fwtypes.StringEnumType[awstypes.LogLevel](),
+										Optional:    true,
+										Description: `Log output level.`,
+									},
+									"metrics_disabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: `Disable the HTTP /metrics endpoint which exposes internal InfluxDB metrics.`,
+									},
+									"no_tasks": schema.BoolAttribute{
+										Optional:    true,
+										Description: `Disable the task scheduler.`,
+									},
+									"pprof_disabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: `Disable the /debug/pprof HTTP endpoint.`,
+									},
+									"query_concurrency": schema.Int32Attribute{
+										Optional:    true,
+										Description: `Number of queries allowed to execute concurrently.`,
+									},
+									"query_initial_memory_bytes": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Initial bytes of memory allocated for a query.`,
+									},
+									"query_max_memory_bytes": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Maximum total bytes of memory allowed for queries.`,
+									},
+									"query_memory_bytes": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Maximum bytes of memory allowed for a single query.`,
+									},
+									"query_queue_size": schema.Int32Attribute{
+										Optional:    true,
+										Description: `Maximum number of queries allowed in execution queue.`,
+									},
+									"session_length": schema.Int32Attribute{
+										Optional:    true,
+										Description: `Specifies the Time to Live (TTL) in minutes for newly created user sessions.`,
+									},
+									"session_renew_disabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: `Disables automatically extending a user's session TTL on each request.`,
+									},
+									"storage_cache_max_memory_size": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Maximum size (in bytes) a shard's cache can reach before it starts rejecting writes.`,
+									},
+									"storage_cache_snapshot_memory_size": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Size (in bytes) at which the storage engine will snapshot the cache and write it to a TSM file.`,
+									},
+									"storage_compact_throughput_burst": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Rate limit (in bytes per second) that TSM compactions can write to disk.`,
+									},
+									"storage_max_concurrent_compactions": schema.Int32Attribute{
+										Optional:    true,
+										Description: `Maximum number of full and level compactions that can run concurrently.`,
+									},
+									"storage_max_index_log_file_size": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Size (in bytes) at which an index write-ahead log (WAL) file will compact into an index file.`,
+									},
+									"storage_no_validate_field_size": schema.BoolAttribute{
+										Optional:    true,
+										Description: `Skip field size validation on incoming write requests.`,
+									},
+									"storage_series_file_max_concurrent_snapshot_compactions": schema.Int32Attribute{
+										Optional:    true,
+										Description: `Maximum number of snapshot compactions that can run concurrently across all series partitions in a database.`,
+									},
+									"storage_series_id_set_cache_size": schema.Int64Attribute{
+										Optional:    true,
+										Description: `Size of the internal cache used in the TSI index to store previously calculated series results.`,
+									},
+									"storage_wal_max_concurrent_writes": schema.Int32Attribute{
+										Optional:    true,
+										Description: `Maximum number writes to the WAL directory to attempt at the same time.`,
+									},
+									"tracing_type": schema.StringAttribute{
+										CustomType:  fwtypes.StringEnumType[awstypes.TracingType](),
+										Optional:    true,
+										Description: `Enable tracing in InfluxDB and specifies the tracing type.`,
+									},
+									"ui_disabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: `Disable the InfluxDB user interface (UI).`,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"http_idle_timeout":                          durationBlock(`Maximum duration the server should keep established connections alive while waiting for new requests.`),
+									"http_read_header_timeout":                   durationBlock(`Maximum duration the server should try to read HTTP headers for new requests.`),
+									"http_read_timeout":                          durationBlock(`Maximum duration the server should try to read the entirety of new requests.`),
+									"http_write_timeout":                         durationBlock(`Maximum duration the server should spend processing and responding to write requests.`),
+									"storage_cache_snapshot_write_cold_duration": durationBlock(`Duration at which the storage engine will snapshot the cache and write it to a new TSM file if the shard hasn't received writes or deletes.`),
+									"storage_compact_full_write_cold_duration":   durationBlock(`Duration at which the storage engine will compact all TSM files in a shard if it hasn't received writes or deletes.`),
+									"storage_retention_check_interval":           durationBlock(`Interval of time for checking data retention policies.`),
+									"storage_wal_max_write_delay":                durationBlock(`Maximum amount of time a write request to the WAL directory will wait when the maximum number of concurrent active writes to the WAL directory has been met.`),
+								},
+							},
+							Description: `All the customer-modifiable InfluxDB v2 parameters in Timestream for InfluxDB.`,
+						},
+					},
+				},
+				Description: `The parameters that comprise the DB parameter group.`,
+			},
+		},
+	}
+}
+
+func (r *resourceDBParameterGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	var plan resourceDBParameterGroupData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := timestreaminfluxdb.CreateDbParameterGroupInput{}
+
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, &in)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreateDbParameterGroup(ctx, &in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.TimestreamInfluxDB, create.ErrActionCreating, ResNameDBParameterGroup, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil || out.Id == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.TimestreamInfluxDB, create.ErrActionCreating, ResNameDBParameterGroup, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.ARN = flex.StringToFramework(ctx, out.Arn)
+	state.ID = flex.StringToFramework(ctx, out.Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceDBParameterGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	var state resourceDBParameterGroupData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findDBParameterGroupByID(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.TimestreamInfluxDB, create.ErrActionSetting, ResNameDBParameterGroup, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, output, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete only removes the DB parameter group from state, as Timestream for InfluxDB
+// does not support deleting DB parameter groups.
+func (r *resourceDBParameterGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *resourceDBParameterGroup) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findDBParameterGroupByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbParameterGroupOutput, error) {
+	in := &timestreaminfluxdb.GetDbParameterGroupInput{
+		Identifier: aws.String(id),
+	}
+
+	out, err := conn.GetDbParameterGroup(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Id == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceDBParameterGroupData struct {
+	ARN         types.String                                    `tfsdk:"arn"`
+	Description types.String                                    `tfsdk:"description"`
+	ID          types.String                                    `tfsdk:"id"`
+	Name        types.String                                    `tfsdk:"name"`
+	Parameters  fwtypes.ListNestedObjectValueOf[parametersData] `tfsdk:"parameters"`
+	Tags        tftags.Map                                      `tfsdk:"tags"`
+	TagsAll     tftags.Map                                      `tfsdk:"tags_all"`
+}
+
+var (
+	_ flex.Expander  = parametersData{}
+	_ flex.Flattener = &parametersData{}
+)
+
+type parametersData struct {
+	InfluxDBv2 fwtypes.ListNestedObjectValueOf[influxDBv2ParametersData] `tfsdk:"influxdbv2"`
+}
+
+func (m parametersData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.InfluxDBv2.IsNull():
+		influxDBv2ParametersData, d := m.InfluxDBv2.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ParametersMemberInfluxDBv2
+		diags.Append(flex.Expand(ctx, influxDBv2ParametersData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *parametersData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case *awstypes.ParametersMemberInfluxDBv2:
+		var data influxDBv2ParametersData
+		diags.Append(flex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.InfluxDBv2 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+		return diags
+	}
+
+	return diags
+}
+
+type influxDBv2ParametersData struct {
+	FluxLogEnabled                                    types.Bool                                    `tfsdk:"flux_log_enabled"`
+	HttpIdleTimeout                                   fwtypes.ListNestedObjectValueOf[durationData] `tfsdk:"http_idle_timeout"`
+	HttpReadHeaderTimeout                             fwtypes.ListNestedObjectValueOf[durationData] `tfsdk:"http_read_header_timeout"`
+	HttpReadTimeout                                   fwtypes.ListNestedObjectValueOf[durationData] `tfsdk:"http_read_timeout"`
+	HttpWriteTimeout                                  fwtypes.ListNestedObjectValueOf[durationData] `tfsdk:"http_write_timeout"`
+	InfluxqlMaxSelectBuckets                          types.Int64                                   `tfsdk:"influxql_max_select_buckets"`
+	InfluxqlMaxSelectPoint                            types.Int64                                   `tfsdk:"influxql_max_select_point"`
+	InfluxqlMaxSelectSeries                           types.Int64                                   `tfsdk:"influxql_max_select_series"`
+	LogLevel                                          fwtypes.StringEnum[awstypes.LogLevel]         `tfsdk:"log_level"`
+	MetricsDisabled                                   types.Bool                                    `tfsdk:"metrics_disabled"`
+	NoTasks                                           types.Bool                                    `tfsdk:"no_tasks"`
+	PprofDisabled                                     types.Bool                                    `tfsdk:"pprof_disabled"`
+	QueryConcurrency                                  types.Int32                                   `tfsdk:"query_concurrency"`
+	QueryInitialMemoryBytes                           types.Int64                                   `tfsdk:"query_initial_memory_bytes"`
+	QueryMaxMemoryBytes                               types.Int64                                   `tfsdk:"query_max_memory_bytes"`
+	QueryMemoryBytes                                  types.Int64                                   `tfsdk:"query_memory_bytes"`
+	QueryQueueSize                                    types.Int32                                   `tfsdk:"query_queue_size"`
+	SessionLength                                     types.Int32                                   `tfsdk:"session_length"`
+	SessionRenewDisabled                              types.Bool                                    `tfsdk:"session_renew_disabled"`
+	StorageCacheMaxMemorySize                         types.Int64                                   `tfsdk:"storage_cache_max_memory_size"`
+	StorageCacheSnapshotMemorySize                    types.Int64                                   `tfsdk:"storage_cache_snapshot_memory_size"`
+	StorageCacheSnapshotWriteColdDuration             fwtypes.ListNestedObjectValueOf[durationData] `tfsdk:"storage_cache_snapshot_write_cold_duration"`
+	StorageCompactFullWriteColdDuration               fwtypes.ListNestedObjectValueOf[durationData] `tfsdk:"storage_compact_full_write_cold_duration"`
+	StorageCompactThroughputBurst                     types.Int64                                   `tfsdk:"storage_compact_throughput_burst"`
+	StorageMaxConcurrentCompactions                   types.Int32                                   `tfsdk:"storage_max_concurrent_compactions"`
+	StorageMaxIndexLogFileSize                        types.Int64                                   `tfsdk:"storage_max_index_log_file_size"`
+	StorageNoValidateFieldSize                        types.Bool                                    `tfsdk:"storage_no_validate_field_size"`
+	StorageRetentionCheckInterval                     fwtypes.ListNestedObjectValueOf[durationData] `tfsdk:"storage_retention_check_interval"`
+	StorageSeriesFileMaxConcurrentSnapshotCompactions types.Int32                                   `tfsdk:"storage_series_file_max_concurrent_snapshot_compactions"`
+	StorageSeriesIdSetCacheSize                       types.Int64                                   `tfsdk:"storage_series_id_set_cache_size"`
+	StorageWalMaxConcurrentWrites                     types.Int32                                   `tfsdk:"storage_wal_max_concurrent_writes"`
+	StorageWalMaxWriteDelay                           fwtypes.ListNestedObjectValueOf[durationData] `tfsdk:"storage_wal_max_write_delay"`
+	TracingType                                       fwtypes.StringEnum[awstypes.TracingType]      `tfsdk:"tracing_type"`
+	UiDisabled                                        types.Bool                                    `tfsdk:"ui_disabled"`
+}
+
+type durationData struct {
+	DurationType fwtypes.StringEnum[awstypes.DurationType] `tfsdk:"duration_type"`
+	Value        types.Int64                               `tfsdk:"value"`
+}
@@ -5,7 +5,9 @@ package timestreaminfluxdb
 
 // Exports for use in tests only.
 var (
-	ResourceDBInstance = newResourceDBInstance
+	ResourceDBInstance       = newResourceDBInstance
+	ResourceDBParameterGroup = newResourceDBParameterGroup
 
-	FindDBInstanceByID = findDBInstanceByID
+	FindDBInstanceByID       = findDBInstanceByID
+	FindDBParameterGroupByID = findDBParameterGroupByID
 )
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreamwrite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftimestreamwrite "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTimestreamWriteBatchLoadTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.BatchLoadTaskDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamwrite_batch_load_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamWriteServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchLoadTaskConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBatchLoadTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "target_database_name", "aws_timestreamwrite_database.test", names.AttrDatabaseName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_table_name", "aws_timestreamwrite_table.test", names.AttrTableName),
+					resource.TestCheckResourceAttrSet(resourceName, "task_id"),
+					resource.TestCheckResourceAttr(resourceName, "task_status", string(awstypes.BatchLoadStatusSucceeded)),
+					resource.TestCheckResourceAttr(resourceName, "data_source_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_source_configuration.0.data_format", string(awstypes.BatchLoadDataFormatCsv)),
+					resource.TestCheckResourceAttr(resourceName, "data_model_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_model_configuration.0.data_model.0.dimension_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report_configuration.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckBatchLoadTaskExists(ctx context.Context, n string, v *awstypes.BatchLoadTaskDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamWriteClient(ctx)
+
+		output, err := tftimestreamwrite.FindBatchLoadTaskByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBatchLoadTaskConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket        = "%[1]s-source"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = "data/test.csv"
+  content = <<EOT
+time,host,cpu_utilization
+1700000000000,host-1,42.5
+1700000060000,host-1,43.1
+EOT
+}
+
+resource "aws_s3_bucket" "report" {
+  bucket        = "%[1]s-report"
+  force_destroy = true
+}
+
+resource "aws_timestreamwrite_database" "test" {
+  database_name = %[1]q
+}
+
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  magnetic_store_write_properties {
+    enable_magnetic_store_writes = true
+  }
+}
+
+resource "aws_timestreamwrite_batch_load_task" "test" {
+  target_database_name = aws_timestreamwrite_database.test.database_name
+  target_table_name    = aws_timestreamwrite_table.test.table_name
+
+  data_source_configuration {
+    data_format = "CSV"
+
+    data_source_s3_configuration {
+      bucket_name       = aws_s3_object.test.bucket
+      object_key_prefix = "data/"
+    }
+  }
+
+  data_model_configuration {
+    data_model {
+      time_column = "time"
+      time_unit   = "MILLISECONDS"
+
+      dimension_mapping {
+        source_column      = "host"
+        destination_column = "host"
+      }
+
+      multi_measure_mappings {
+        target_multi_measure_name = "metrics"
+
+        multi_measure_attribute_mapping {
+          source_column      = "cpu_utilization"
+          measure_value_type = "DOUBLE"
+        }
+      }
+    }
+  }
+
+  report_configuration {
+    report_s3_configuration {
+      bucket_name       = aws_s3_bucket.report.bucket
+      object_key_prefix = "reports"
+    }
+  }
+}
+`, rName)
+}
@@ -8,6 +8,7 @@ var (
 	ResourceDatabase = resourceDatabase
 	ResourceTable    = resourceTable
 
+	FindBatchLoadTaskByID = findBatchLoadTaskByID
 	FindDatabaseByName    = findDatabaseByName
 	FindTableByTwoPartKey = findTableByTwoPartKey
 
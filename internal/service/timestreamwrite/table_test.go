@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -350,6 +351,11 @@ func TestAccTimestreamWriteTable_schema(t *testing.T) {
 			},
 			{
 				Config: testAccTableConfig_schema(rName, "REQUIRED"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTableExists(ctx, resourceName, &table2),
 					testAccCheckTableNotRecreated(&table2, &table1),
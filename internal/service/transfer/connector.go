@@ -49,9 +49,10 @@ func resourceConnector() *schema.Resource {
 				Computed: true,
 			},
 			"as2_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"as2_config", "sftp_config"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"compression": {
@@ -111,10 +112,16 @@ func resourceConnector() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`^TransferSFTPConnectorSecurityPolicy-[A-Za-z0-9-]+$`), "must be in the format matching TransferSFTPConnectorSecurityPolicy-[A-Za-z0-9-]+"),
 				),
 			},
-			"sftp_config": {
+			"service_managed_egress_ip_addresses": {
 				Type:     schema.TypeList,
-				MaxItems: 1,
-				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sftp_config": {
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"as2_config", "sftp_config"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"trusted_host_keys": {
@@ -208,6 +215,7 @@ func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("connector_id", output.ConnectorId)
 	d.Set("logging_role", output.LoggingRole)
 	d.Set("security_policy_name", output.SecurityPolicyName)
+	d.Set("service_managed_egress_ip_addresses", output.ServiceManagedEgressIpAddresses)
 	if err := d.Set("sftp_config", flattenSftpConnectorConfig(output.SftpConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting sftp_config: %s", err)
 	}
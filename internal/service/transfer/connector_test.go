@@ -83,6 +83,11 @@ func TestAccTransferConnector_sftpConfig(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckConnectorExists(ctx, resourceName, &conf),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "service_managed_egress_ip_addresses.#"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sftp_config.0.trusted_host_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "sftp_config.0.trusted_host_keys.*", publicKey),
+					resource.TestCheckResourceAttrPair(resourceName, "sftp_config.0.user_secret_id", "aws_secretsmanager_secret.test", names.AttrID),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrURL, "sftp://s-fakeserver.server.transfer.test.amazonaws.com"),
 				),
@@ -5,16 +5,18 @@ package transfer
 
 // Exports for use in tests only.
 var (
-	ResourceAccess      = resourceAccess
-	ResourceAgreement   = resourceAgreement
-	ResourceCertificate = resourceCertificate
-	ResourceConnector   = resourceConnector
-	ResourceProfile     = resourceProfile
-	ResourceServer      = resourceServer
-	ResourceSSHKey      = resourceSSHKey
-	ResourceTag         = resourceTag
-	ResourceUser        = resourceUser
-	ResourceWorkflow    = resourceWorkflow
+	ResourceAccess              = resourceAccess
+	ResourceAgreement           = resourceAgreement
+	ResourceCertificate         = resourceCertificate
+	ResourceConnector           = resourceConnector
+	ResourceProfile             = resourceProfile
+	ResourceServer              = resourceServer
+	ResourceSSHKey              = resourceSSHKey
+	ResourceTag                 = resourceTag
+	ResourceUser                = resourceUser
+	ResourceWebApp              = newWebAppResource
+	ResourceWebAppCustomization = newWebAppCustomizationResource
+	ResourceWorkflow            = resourceWorkflow
 
 	FindAccessByTwoPartKey       = findAccessByTwoPartKey
 	FindAgreementByTwoPartKey    = findAgreementByTwoPartKey
@@ -25,5 +27,7 @@ var (
 	FindTag                      = findTag
 	FindUserByTwoPartKey         = findUserByTwoPartKey
 	FindUserSSHKeyByThreePartKey = findUserSSHKeyByThreePartKey
+	FindWebAppByID               = findWebAppByID
+	FindWebAppCustomizationByID  = findWebAppCustomizationByID
 	FindWorkflowByID             = findWorkflowByID
 )
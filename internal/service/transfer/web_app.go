@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Web App")
+// @Tags(identifierAttribute="arn")
+func newWebAppResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &webAppResource{}, nil
+}
+
+const (
+	ResNameWebApp = "Web App"
+)
+
+type webAppResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *webAppResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_transfer_web_app"
+}
+
+func (r *webAppResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_endpoint": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			names.AttrARN:     framework.ARNAttributeComputedOnly(),
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"web_app_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"web_app_units": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[webAppUnitsModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"identity_provider_details": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[webAppIdentityProviderDetailsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"identity_center_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[identityCenterConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"application_arn": schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"instance_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									names.AttrRole: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *webAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().TransferClient(ctx)
+
+	var data webAppResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var input transfer.CreateWebAppInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWebApp(ctx, &input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Transfer, create.ErrActionCreating, ResNameWebApp, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.WebAppID = fwflex.StringToFramework(ctx, output.WebAppId)
+
+	webApp, err := findWebAppByID(ctx, conn, data.WebAppID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Transfer, create.ErrActionReading, ResNameWebApp, data.WebAppID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, webApp, &data, fwflex.WithFieldNamePrefix("Described"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *webAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().TransferClient(ctx)
+
+	var data webAppResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findWebAppByID(ctx, conn, data.WebAppID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Transfer, create.ErrActionReading, ResNameWebApp, data.WebAppID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix("Described"))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *webAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().TransferClient(ctx)
+
+	var new, old webAppResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &new)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &old)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.AccessEndpoint.Equal(old.AccessEndpoint) ||
+		!new.IdentityProviderDetails.Equal(old.IdentityProviderDetails) ||
+		!new.WebAppUnits.Equal(old.WebAppUnits) {
+		var input transfer.UpdateWebAppInput
+		resp.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWebApp(ctx, &input)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Transfer, create.ErrActionUpdating, ResNameWebApp, new.WebAppID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		webApp, err := findWebAppByID(ctx, conn, new.WebAppID.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Transfer, create.ErrActionReading, ResNameWebApp, new.WebAppID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(fwflex.Flatten(ctx, webApp, &new, fwflex.WithFieldNamePrefix("Described"))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+}
+
+func (r *webAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().TransferClient(ctx)
+
+	var data webAppResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := transfer.DeleteWebAppInput{
+		WebAppId: data.WebAppID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteWebApp(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Transfer, create.ErrActionDeleting, ResNameWebApp, data.WebAppID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *webAppResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func (r *webAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func findWebAppByID(ctx context.Context, conn *transfer.Client, id string) (*awstypes.DescribedWebApp, error) {
+	input := &transfer.DescribeWebAppInput{
+		WebAppId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWebApp(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebApp == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WebApp, nil
+}
+
+type webAppResourceModel struct {
+	AccessEndpoint          types.String                                                        `tfsdk:"access_endpoint"`
+	ARN                     types.String                                                        `tfsdk:"arn"`
+	IdentityProviderDetails fwtypes.ListNestedObjectValueOf[webAppIdentityProviderDetailsModel] `tfsdk:"identity_provider_details"`
+	Tags                    tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                          `tfsdk:"tags_all"`
+	WebAppEndpoint          types.String                                                        `tfsdk:"web_app_endpoint"`
+	WebAppID                types.String                                                        `tfsdk:"id"`
+	WebAppUnits             fwtypes.ListNestedObjectValueOf[webAppUnitsModel]                   `tfsdk:"web_app_units"`
+}
+
+type webAppIdentityProviderDetailsModel struct {
+	IdentityCenterConfig fwtypes.ListNestedObjectValueOf[identityCenterConfigModel] `tfsdk:"identity_center_config"`
+}
+
+var (
+	_ fwflex.TypedExpander = webAppIdentityProviderDetailsModel{}
+	_ fwflex.Flattener     = &webAppIdentityProviderDetailsModel{}
+)
+
+func (m webAppIdentityProviderDetailsModel) ExpandTo(ctx context.Context, targetType reflect.Type) (result any, diags diag.Diagnostics) {
+	switch targetType {
+	case reflect.TypeFor[awstypes.WebAppIdentityProviderDetails]():
+		return m.expandToWebAppIdentityProviderDetails(ctx)
+
+	case reflect.TypeFor[awstypes.UpdateWebAppIdentityProviderDetails]():
+		return m.expandToUpdateWebAppIdentityProviderDetails(ctx)
+	}
+
+	return nil, diags
+}
+
+func (m webAppIdentityProviderDetailsModel) expandToWebAppIdentityProviderDetails(ctx context.Context) (result awstypes.WebAppIdentityProviderDetails, diags diag.Diagnostics) {
+	switch {
+	case !m.IdentityCenterConfig.IsNull():
+		var result awstypes.WebAppIdentityProviderDetailsMemberIdentityCenterConfig
+		diags.Append(fwflex.Expand(ctx, m.IdentityCenterConfig, &result.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &result, diags
+	}
+
+	return nil, diags
+}
+
+func (m webAppIdentityProviderDetailsModel) expandToUpdateWebAppIdentityProviderDetails(ctx context.Context) (result awstypes.UpdateWebAppIdentityProviderDetails, diags diag.Diagnostics) {
+	switch {
+	case !m.IdentityCenterConfig.IsNull():
+		var result awstypes.UpdateWebAppIdentityProviderDetailsMemberIdentityCenterConfig
+		diags.Append(fwflex.Expand(ctx, m.IdentityCenterConfig, &result.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &result, diags
+	}
+
+	return nil, diags
+}
+
+func (m *webAppIdentityProviderDetailsModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.DescribedWebAppIdentityProviderDetailsMemberIdentityCenterConfig:
+		var model identityCenterConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.IdentityCenterConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type identityCenterConfigModel struct {
+	ApplicationARN types.String `tfsdk:"application_arn"`
+	InstanceARN    fwtypes.ARN  `tfsdk:"instance_arn"`
+	Role           fwtypes.ARN  `tfsdk:"role"`
+}
+
+type webAppUnitsModel struct {
+	Provisioned types.Int64 `tfsdk:"provisioned"`
+}
+
+var (
+	_ fwflex.Expander  = webAppUnitsModel{}
+	_ fwflex.Flattener = &webAppUnitsModel{}
+)
+
+func (m webAppUnitsModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Provisioned.IsNull():
+		var result awstypes.WebAppUnitsMemberProvisioned
+		diags.Append(fwflex.Expand(ctx, m.Provisioned, &result.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &result, diags
+	}
+
+	return nil, diags
+}
+
+func (m *webAppUnitsModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.WebAppUnitsMemberProvisioned:
+		m.Provisioned = fwflex.Int32ValueToFramework(ctx, t.Value)
+
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transfer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Web App Customization")
+func newWebAppCustomizationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &webAppCustomizationResource{}, nil
+}
+
+const (
+	ResNameWebAppCustomization = "Web App Customization"
+)
+
+type webAppCustomizationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *webAppCustomizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_transfer_web_app_customization"
+}
+
+func (r *webAppCustomizationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"favicon_file": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"logo_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"title": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 100),
+				},
+			},
+			"web_app_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *webAppCustomizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().TransferClient(ctx)
+
+	var data webAppCustomizationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.expandUpdateWebAppCustomizationInput()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdateWebAppCustomization(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Transfer, create.ErrActionCreating, ResNameWebAppCustomization, data.WebAppID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findWebAppCustomizationByID(ctx, conn, data.WebAppID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Transfer, create.ErrActionReading, ResNameWebAppCustomization, data.WebAppID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.ID = data.WebAppID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *webAppCustomizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().TransferClient(ctx)
+
+	var data webAppCustomizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findWebAppCustomizationByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Transfer, create.ErrActionReading, ResNameWebAppCustomization, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.FaviconFile = flattenWebAppCustomizationFile(output.FaviconFile)
+	data.LogoFile = flattenWebAppCustomizationFile(output.LogoFile)
+	data.Title = fwflex.StringToFramework(ctx, output.Title)
+	data.WebAppID = fwflex.StringToFramework(ctx, output.WebAppId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *webAppCustomizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().TransferClient(ctx)
+
+	var new, old webAppCustomizationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &new)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &old)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.FaviconFile.Equal(old.FaviconFile) ||
+		!new.LogoFile.Equal(old.LogoFile) ||
+		!new.Title.Equal(old.Title) {
+		input, diags := new.expandUpdateWebAppCustomizationInput()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWebAppCustomization(ctx, input)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Transfer, create.ErrActionUpdating, ResNameWebAppCustomization, new.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+}
+
+func (r *webAppCustomizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().TransferClient(ctx)
+
+	var data webAppCustomizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := transfer.DeleteWebAppCustomizationInput{
+		WebAppId: data.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteWebAppCustomization(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Transfer, create.ErrActionDeleting, ResNameWebAppCustomization, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *webAppCustomizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func findWebAppCustomizationByID(ctx context.Context, conn *transfer.Client, id string) (*awstypes.DescribedWebAppCustomization, error) {
+	input := &transfer.DescribeWebAppCustomizationInput{
+		WebAppId: aws.String(id),
+	}
+
+	output, err := conn.DescribeWebAppCustomization(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebAppCustomization == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WebAppCustomization, nil
+}
+
+type webAppCustomizationResourceModel struct {
+	ARN         types.String `tfsdk:"arn"`
+	FaviconFile types.String `tfsdk:"favicon_file"`
+	ID          types.String `tfsdk:"id"`
+	LogoFile    types.String `tfsdk:"logo_file"`
+	Title       types.String `tfsdk:"title"`
+	WebAppID    types.String `tfsdk:"web_app_id"`
+}
+
+func (m webAppCustomizationResourceModel) expandUpdateWebAppCustomizationInput() (*transfer.UpdateWebAppCustomizationInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	input := &transfer.UpdateWebAppCustomizationInput{
+		Title:    m.Title.ValueStringPointer(),
+		WebAppId: m.WebAppID.ValueStringPointer(),
+	}
+
+	// The favicon and logo images are supplied base64-encoded.
+	if v := m.FaviconFile.ValueString(); v != "" {
+		b, err := itypes.Base64Decode(v)
+		if err != nil {
+			diags.AddAttributeError(path.Root("favicon_file"), "Invalid base64 value", err.Error())
+			return nil, diags
+		}
+		input.FaviconFile = b
+	}
+
+	if v := m.LogoFile.ValueString(); v != "" {
+		b, err := itypes.Base64Decode(v)
+		if err != nil {
+			diags.AddAttributeError(path.Root("logo_file"), "Invalid base64 value", err.Error())
+			return nil, diags
+		}
+		input.LogoFile = b
+	}
+
+	return input, diags
+}
+
+func flattenWebAppCustomizationFile(b []byte) types.String {
+	if len(b) == 0 {
+		return types.StringNull()
+	}
+
+	return types.StringValue(itypes.Base64Encode(b))
+}
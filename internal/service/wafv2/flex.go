@@ -104,6 +104,41 @@ func expandChallengeConfig(l []interface{}) *awstypes.ChallengeConfig {
 	return configuration
 }
 
+func expandApplicationConfig(l []interface{}) *awstypes.ApplicationConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	configuration := &awstypes.ApplicationConfig{}
+
+	for _, v := range m["attribute"].([]interface{}) {
+		if v == nil {
+			continue
+		}
+
+		m := v.(map[string]interface{})
+		configuration.Attributes = append(configuration.Attributes, awstypes.ApplicationAttribute{
+			Name:   aws.String(m[names.AttrName].(string)),
+			Values: flex.ExpandStringValueSet(m[names.AttrValues].(*schema.Set)),
+		})
+	}
+
+	return configuration
+}
+
+func expandOnSourceDDoSProtectionConfig(l []interface{}) *awstypes.OnSourceDDoSProtectionConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &awstypes.OnSourceDDoSProtectionConfig{
+		ALBLowReputationMode: awstypes.LowReputationMode(m["alb_low_reputation_mode"].(string)),
+	}
+}
+
 func expandAssociationConfig(l []interface{}) *awstypes.AssociationConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -586,6 +621,10 @@ func expandFieldToMatch(l []interface{}) *awstypes.FieldToMatch {
 		f.JA3Fingerprint = expandJA3Fingerprint(v.([]interface{}))
 	}
 
+	if v, ok := m["ja4_fingerprint"]; ok && len(v.([]interface{})) > 0 {
+		f.JA4Fingerprint = expandJA4Fingerprint(v.([]interface{}))
+	}
+
 	if v, ok := m["single_query_argument"]; ok && len(v.([]interface{})) > 0 {
 		f.SingleQueryArgument = expandSingleQueryArgument(m["single_query_argument"].([]interface{}))
 	}
@@ -716,6 +755,20 @@ func expandJA3Fingerprint(l []interface{}) *awstypes.JA3Fingerprint {
 	return ja3fingerprint
 }
 
+func expandJA4Fingerprint(l []interface{}) *awstypes.JA4Fingerprint {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	ja4fingerprint := &awstypes.JA4Fingerprint{
+		FallbackBehavior: awstypes.FallbackBehavior(m["fallback_behavior"].(string)),
+	}
+
+	return ja4fingerprint
+}
+
 func expandJSONMatchPattern(l []interface{}) *awstypes.JsonMatchPattern {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1019,6 +1072,175 @@ func expandWebACLRulesJSON(rawRules string) ([]awstypes.Rule, error) {
 	return rules, nil
 }
 
+// flattenWebACLRulesJSON returns the configured rule JSON if every value that it sets matches the rules returned from the API.
+// Otherwise the API rules are returned as JSON so that drift is surfaced in the plan.
+// Values not present in the configuration (service-side defaults) are ignored.
+func flattenWebACLRulesJSON(configured string, apiRules []awstypes.Rule) (string, error) {
+	configRules, err := expandWebACLRulesJSON(configured)
+	if err != nil {
+		return "", err
+	}
+
+	want, err := normalizeWebACLRulesJSON(configRules)
+	if err != nil {
+		return "", err
+	}
+
+	got, err := normalizeWebACLRulesJSON(apiRules)
+	if err != nil {
+		return "", err
+	}
+
+	if webACLRulesJSONContains(got, want) {
+		return configured, nil
+	}
+
+	for _, v := range got {
+		unwalkWebACLJSON(v)
+	}
+
+	return tfjson.EncodeToString(got)
+}
+
+// normalizeWebACLRulesJSON converts rules to their generic JSON representation with unset values removed.
+func normalizeWebACLRulesJSON(rules []awstypes.Rule) ([]any, error) {
+	b, err := tfjson.EncodeToBytes(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	var v []any
+	if err := tfjson.DecodeFromBytes(b, &v); err != nil {
+		return nil, err
+	}
+
+	for i := range v {
+		v[i] = pruneWebACLJSON(v[i])
+	}
+
+	return v, nil
+}
+
+// pruneWebACLJSON removes null, empty string, zero number and empty array values from JSON objects.
+// Empty objects are kept as they are significant (e.g. `"Count": {}`).
+func pruneWebACLJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			e = pruneWebACLJSON(e)
+			switch e := e.(type) {
+			case nil:
+				delete(v, k)
+				continue
+			case string:
+				if e == "" {
+					delete(v, k)
+					continue
+				}
+			case float64:
+				if e == 0 {
+					delete(v, k)
+					continue
+				}
+			case []any:
+				if len(e) == 0 {
+					delete(v, k)
+					continue
+				}
+			}
+			v[k] = e
+		}
+	case []any:
+		for i := range v {
+			v[i] = pruneWebACLJSON(v[i])
+		}
+	}
+
+	return v
+}
+
+func webACLRulesJSONContains(got, want []any) bool {
+	if len(got) != len(want) {
+		return false
+	}
+
+	// Rules are matched by name as the API may return them in a different order.
+	for _, w := range want {
+		wm, ok := w.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		var found bool
+		for _, g := range got {
+			if gm, ok := g.(map[string]any); ok && gm["Name"] == wm["Name"] {
+				found = webACLJSONContains(gm, wm)
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func webACLJSONContains(got, want any) bool {
+	switch want := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for k, w := range want {
+			if v, ok := g[k]; !ok || !webACLJSONContains(v, w) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(want) {
+			return false
+		}
+
+		for i := range want {
+			if !webACLJSONContains(g[i], want[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(got, want)
+	}
+}
+
+// unwalkWebACLJSON reverses the base64 encoding of binary values applied by walkWebACLJSON.
+func unwalkWebACLJSON(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if st, ok := e.(map[string]any); ok && k == "ByteMatchStatement" {
+				if str, ok := st["SearchString"].(string); ok {
+					if b, err := itypes.Base64Decode(str); err == nil {
+						st["SearchString"] = string(b)
+					}
+				}
+			}
+			unwalkWebACLJSON(e)
+		}
+	case []any:
+		for _, e := range v {
+			unwalkWebACLJSON(e)
+		}
+	}
+}
+
 func walkWebACLJSON(v reflect.Value) {
 	m := map[string][]struct {
 		key        string
@@ -1561,6 +1783,26 @@ func expandRateLimitHeader(l []interface{}) *awstypes.RateLimitHeader {
 	}
 }
 
+func expandRateLimitJA3Fingerprint(l []interface{}) *awstypes.RateLimitJA3Fingerprint {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+	return &awstypes.RateLimitJA3Fingerprint{
+		FallbackBehavior: awstypes.FallbackBehavior(m["fallback_behavior"].(string)),
+	}
+}
+
+func expandRateLimitJA4Fingerprint(l []interface{}) *awstypes.RateLimitJA4Fingerprint {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+	return &awstypes.RateLimitJA4Fingerprint{
+		FallbackBehavior: awstypes.FallbackBehavior(m["fallback_behavior"].(string)),
+	}
+}
+
 func expandRateLimitLabelNamespace(l []interface{}) *awstypes.RateLimitLabelNamespace {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1626,6 +1868,12 @@ func expandRateBasedStatementCustomKeys(l []interface{}) []awstypes.RateBasedSta
 		if v, ok := m["ip"]; ok && len(v.([]interface{})) > 0 {
 			r.IP = &awstypes.RateLimitIP{}
 		}
+		if v, ok := m["ja3_fingerprint"]; ok {
+			r.JA3Fingerprint = expandRateLimitJA3Fingerprint(v.([]interface{}))
+		}
+		if v, ok := m["ja4_fingerprint"]; ok {
+			r.JA4Fingerprint = expandRateLimitJA4Fingerprint(v.([]interface{}))
+		}
 		if v, ok := m["label_namespace"]; ok {
 			r.LabelNamespace = expandRateLimitLabelNamespace(v.([]interface{}))
 		}
@@ -1849,6 +2097,38 @@ func flattenChallengeConfig(config *awstypes.ChallengeConfig) interface{} {
 	return []interface{}{m}
 }
 
+func flattenApplicationConfig(config *awstypes.ApplicationConfig) interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	attributes := make([]interface{}, 0, len(config.Attributes))
+	for _, v := range config.Attributes {
+		attributes = append(attributes, map[string]interface{}{
+			names.AttrName:   aws.ToString(v.Name),
+			names.AttrValues: v.Values,
+		})
+	}
+
+	m := map[string]interface{}{
+		"attribute": attributes,
+	}
+
+	return []interface{}{m}
+}
+
+func flattenOnSourceDDoSProtectionConfig(config *awstypes.OnSourceDDoSProtectionConfig) interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"alb_low_reputation_mode": config.ALBLowReputationMode,
+	}
+
+	return []interface{}{m}
+}
+
 func flattenAssociationConfig(config *awstypes.AssociationConfig) interface{} {
 	associationConfig := []interface{}{}
 	if config == nil {
@@ -2124,6 +2404,10 @@ func flattenFieldToMatch(f *awstypes.FieldToMatch) interface{} {
 		m["ja3_fingerprint"] = flattenJA3Fingerprint(f.JA3Fingerprint)
 	}
 
+	if f.JA4Fingerprint != nil {
+		m["ja4_fingerprint"] = flattenJA4Fingerprint(f.JA4Fingerprint)
+	}
+
 	if f.JsonBody != nil {
 		m["json_body"] = flattenJSONBody(f.JsonBody)
 	}
@@ -2221,6 +2505,18 @@ func flattenJA3Fingerprint(j *awstypes.JA3Fingerprint) interface{} {
 	return []interface{}{m}
 }
 
+func flattenJA4Fingerprint(j *awstypes.JA4Fingerprint) interface{} {
+	if j == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"fallback_behavior": j.FallbackBehavior,
+	}
+
+	return []interface{}{m}
+}
+
 func flattenJSONBody(b *awstypes.JsonBody) interface{} {
 	if b == nil {
 		return []interface{}{}
@@ -2949,6 +3245,28 @@ func flattenRateLimitHeader(apiObject *awstypes.RateLimitHeader) []interface{} {
 	}
 }
 
+func flattenRateLimitJA3Fingerprint(apiObject *awstypes.RateLimitJA3Fingerprint) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"fallback_behavior": apiObject.FallbackBehavior,
+		},
+	}
+}
+
+func flattenRateLimitJA4Fingerprint(apiObject *awstypes.RateLimitJA4Fingerprint) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"fallback_behavior": apiObject.FallbackBehavior,
+		},
+	}
+}
+
 func flattenRateLimitLabelNamespace(apiObject *awstypes.RateLimitLabelNamespace) []interface{} {
 	if apiObject == nil {
 		return nil
@@ -3024,6 +3342,12 @@ func flattenRateBasedStatementCustomKeys(apiObject []awstypes.RateBasedStatement
 				map[string]interface{}{},
 			}
 		}
+		if o.JA3Fingerprint != nil {
+			tfMap["ja3_fingerprint"] = flattenRateLimitJA3Fingerprint(o.JA3Fingerprint)
+		}
+		if o.JA4Fingerprint != nil {
+			tfMap["ja4_fingerprint"] = flattenRateLimitJA4Fingerprint(o.JA4Fingerprint)
+		}
 		if o.LabelNamespace != nil {
 			tfMap["label_namespace"] = flattenRateLimitLabelNamespace(o.LabelNamespace)
 		}
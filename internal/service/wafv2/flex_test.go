@@ -157,3 +157,77 @@ func Test_expandWebACLRulesJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_flattenWebACLRulesJSON(t *testing.T) {
+	t.Parallel()
+
+	const configured = `[{"Action":{"Count":{}},"Name":"rule-1","Priority":1,"Statement":{"RateBasedStatement":{"AggregateKeyType":"IP","Limit":10000}},"VisibilityConfig":{"CloudWatchMetricsEnabled":false,"MetricName":"rule-1","SampledRequestsEnabled":false}}]`
+	apiRule := func() awstypes.Rule {
+		return awstypes.Rule{
+			Name:     aws.String("rule-1"),
+			Priority: 1,
+			Action: &awstypes.RuleAction{
+				Count: &awstypes.CountAction{},
+			},
+			Statement: &awstypes.Statement{
+				RateBasedStatement: &awstypes.RateBasedStatement{
+					AggregateKeyType:    awstypes.RateBasedStatementAggregateKeyTypeIp,
+					EvaluationWindowSec: 300,
+					Limit:               aws.Int64(10000),
+				},
+			},
+			VisibilityConfig: &awstypes.VisibilityConfig{
+				MetricName: aws.String("rule-1"),
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		apiRules  func() []awstypes.Rule
+		wantDrift bool
+	}{
+		"equivalent with service defaults": {
+			apiRules: func() []awstypes.Rule {
+				return []awstypes.Rule{apiRule()}
+			},
+		},
+		"changed action": {
+			apiRules: func() []awstypes.Rule {
+				r := apiRule()
+				r.Action = &awstypes.RuleAction{Block: &awstypes.BlockAction{}}
+				return []awstypes.Rule{r}
+			},
+			wantDrift: true,
+		},
+		"changed limit": {
+			apiRules: func() []awstypes.Rule {
+				r := apiRule()
+				r.Statement.RateBasedStatement.Limit = aws.Int64(2000)
+				return []awstypes.Rule{r}
+			},
+			wantDrift: true,
+		},
+		"rule added out of band": {
+			apiRules: func() []awstypes.Rule {
+				r := apiRule()
+				r.Name = aws.String("rule-2")
+				return []awstypes.Rule{apiRule(), r}
+			},
+			wantDrift: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := flattenWebACLRulesJSON(configured, tc.apiRules())
+			if err != nil {
+				t.Fatalf("flattenWebACLRulesJSON() error = %v", err)
+			}
+			if drift := got != configured; drift != tc.wantDrift {
+				t.Errorf("flattenWebACLRulesJSON() drift = %t, want %t: %s", drift, tc.wantDrift, got)
+			}
+		})
+	}
+}
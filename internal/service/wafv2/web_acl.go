@@ -61,6 +61,7 @@ func resourceWebACL() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"application_config": applicationConfigSchema(),
 				"application_integration_url": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -93,6 +94,7 @@ func resourceWebACL() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"on_source_ddos_protection_config": onSourceDDoSProtectionConfigSchema(),
 				names.AttrName: {
 					Type:     schema.TypeString,
 					Required: true,
@@ -194,14 +196,16 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	name := d.Get(names.AttrName).(string)
 
 	input := &wafv2.CreateWebACLInput{
-		AssociationConfig: expandAssociationConfig(d.Get("association_config").([]interface{})),
-		CaptchaConfig:     expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
-		ChallengeConfig:   expandChallengeConfig(d.Get("challenge_config").([]interface{})),
-		DefaultAction:     expandDefaultAction(d.Get(names.AttrDefaultAction).([]interface{})),
-		Name:              aws.String(name),
-		Scope:             awstypes.Scope(d.Get(names.AttrScope).(string)),
-		Tags:              getTagsIn(ctx),
-		VisibilityConfig:  expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
+		ApplicationConfig:            expandApplicationConfig(d.Get("application_config").([]interface{})),
+		AssociationConfig:            expandAssociationConfig(d.Get("association_config").([]interface{})),
+		CaptchaConfig:                expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
+		ChallengeConfig:              expandChallengeConfig(d.Get("challenge_config").([]interface{})),
+		DefaultAction:                expandDefaultAction(d.Get(names.AttrDefaultAction).([]interface{})),
+		Name:                         aws.String(name),
+		OnSourceDDoSProtectionConfig: expandOnSourceDDoSProtectionConfig(d.Get("on_source_ddos_protection_config").([]interface{})),
+		Scope:                        awstypes.Scope(d.Get(names.AttrScope).(string)),
+		Tags:                         getTagsIn(ctx),
+		VisibilityConfig:             expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 	}
 
 	if v, ok := d.GetOk(names.AttrRule); ok {
@@ -263,6 +267,9 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	webACL := output.WebACL
+	if err := d.Set("application_config", flattenApplicationConfig(webACL.ApplicationConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting application_config: %s", err)
+	}
 	d.Set("application_integration_url", output.ApplicationIntegrationURL)
 	d.Set(names.AttrARN, webACL.ARN)
 	d.Set("capacity", webACL.Capacity)
@@ -284,6 +291,9 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrDescription, webACL.Description)
 	d.Set("lock_token", output.LockToken)
 	d.Set(names.AttrName, webACL.Name)
+	if err := d.Set("on_source_ddos_protection_config", flattenOnSourceDDoSProtectionConfig(webACL.OnSourceDDoSProtectionConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting on_source_ddos_protection_config: %s", err)
+	}
 
 	if _, ok := d.GetOk(names.AttrRule); ok {
 		rules := filterWebACLRules(webACL.Rules, expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List()))
@@ -292,7 +302,18 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	d.Set("rule_json", d.Get("rule_json"))
+	if v, ok := d.GetOk("rule_json"); ok {
+		configRules, err := expandWebACLRulesJSON(v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "expanding WAFv2 WebACL JSON rule (%s): %s", d.Id(), err)
+		}
+
+		ruleJSON, err := flattenWebACLRulesJSON(v.(string), filterWebACLRules(webACL.Rules, configRules))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting rule_json: %s", err)
+		}
+		d.Set("rule_json", ruleJSON)
+	}
 
 	d.Set("token_domains", aws.StringSlice(webACL.TokenDomains))
 	if err := d.Set("visibility_config", flattenVisibilityConfig(webACL.VisibilityConfig)); err != nil {
@@ -343,16 +364,17 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 
 		input := &wafv2.UpdateWebACLInput{
-			AssociationConfig: expandAssociationConfig(d.Get("association_config").([]interface{})),
-			CaptchaConfig:     expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
-			ChallengeConfig:   expandChallengeConfig(d.Get("challenge_config").([]interface{})),
-			DefaultAction:     expandDefaultAction(d.Get(names.AttrDefaultAction).([]interface{})),
-			Id:                aws.String(d.Id()),
-			LockToken:         aws.String(aclLockToken),
-			Name:              aws.String(aclName),
-			Rules:             rules,
-			Scope:             awstypes.Scope(aclScope),
-			VisibilityConfig:  expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
+			AssociationConfig:            expandAssociationConfig(d.Get("association_config").([]interface{})),
+			CaptchaConfig:                expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
+			ChallengeConfig:              expandChallengeConfig(d.Get("challenge_config").([]interface{})),
+			DefaultAction:                expandDefaultAction(d.Get(names.AttrDefaultAction).([]interface{})),
+			Id:                           aws.String(d.Id()),
+			LockToken:                    aws.String(aclLockToken),
+			Name:                         aws.String(aclName),
+			OnSourceDDoSProtectionConfig: expandOnSourceDDoSProtectionConfig(d.Get("on_source_ddos_protection_config").([]interface{})),
+			Rules:                        rules,
+			Scope:                        awstypes.Scope(aclScope),
+			VisibilityConfig:             expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 		}
 
 		if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
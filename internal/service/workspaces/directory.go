@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/workspaces"
 	"github.com/aws/aws-sdk-go-v2/service/workspaces/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -41,17 +42,60 @@ func resourceDirectory() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"active_directory_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDomainName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"service_account_secret_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			names.AttrAlias: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"certificate_based_auth_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_authority_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.CertificateBasedAuthStatusEnumDisabled,
+							ValidateDiagFunc: enum.Validate[types.CertificateBasedAuthStatusEnum](),
+						},
+					},
+				},
+			},
 			"customer_user_name": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"directory_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"directory_name": {
@@ -150,6 +194,13 @@ func resourceDirectory() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"user_identity_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.UserIdentityType](),
+			},
 			"workspace_access_properties": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -233,13 +284,46 @@ func resourceDirectory() *schema.Resource {
 					},
 				},
 			},
+			"workspace_directory_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"workspace_directory_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"workspace_security_group_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"workspace_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.WorkspaceType](),
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// Only directories for WorkSpaces Pools can be registered without an existing directory.
+				if d.Id() != "" || d.Get("workspace_type").(string) == string(types.WorkspaceTypePools) {
+					return nil
+				}
+
+				if d.GetRawConfig().GetAttr("directory_id").IsNull() {
+					return errors.New(`"directory_id" is required unless "workspace_type" is "POOLS"`)
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
@@ -249,21 +333,44 @@ func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	directoryID := d.Get("directory_id").(string)
 	input := &workspaces.RegisterWorkspaceDirectoryInput{
-		DirectoryId:       aws.String(directoryID),
 		EnableSelfService: aws.Bool(false), // this is handled separately below
 		EnableWorkDocs:    aws.Bool(false),
 		Tenancy:           types.TenancyShared,
 		Tags:              getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("active_directory_config"); ok {
+		input.ActiveDirectoryConfig = expandActiveDirectoryConfig(v.([]interface{}))
+	}
+
+	if directoryID != "" {
+		input.DirectoryId = aws.String(directoryID)
+	}
+
 	if v, ok := d.GetOk(names.AttrSubnetIDs); ok {
 		input.SubnetIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("user_identity_type"); ok {
+		input.UserIdentityType = types.UserIdentityType(v.(string))
+	}
+
+	if v, ok := d.GetOk("workspace_directory_description"); ok {
+		input.WorkspaceDirectoryDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("workspace_directory_name"); ok {
+		input.WorkspaceDirectoryName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("workspace_type"); ok {
+		input.WorkspaceType = types.WorkspaceType(v.(string))
+	}
+
 	const (
 		timeout = 2 * time.Minute
 	)
-	_, err := tfresource.RetryWhenIsA[*types.InvalidResourceStateException](ctx, timeout,
+	outputRaw, err := tfresource.RetryWhenIsA[*types.InvalidResourceStateException](ctx, timeout,
 		func() (interface{}, error) {
 			return conn.RegisterWorkspaceDirectory(ctx, input)
 		})
@@ -272,12 +379,25 @@ func resourceDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "registering WorkSpaces Directory (%s): %s", directoryID, err)
 	}
 
-	d.SetId(directoryID)
+	d.SetId(aws.ToString(outputRaw.(*workspaces.RegisterWorkspaceDirectoryOutput).DirectoryId))
 
 	if _, err := waitDirectoryRegistered(ctx, conn, d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for WorkSpaces Directory (%s) create: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("certificate_based_auth_properties"); ok {
+		input := &workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			CertificateBasedAuthProperties: expandCertificateBasedAuthProperties(v.([]interface{})),
+			ResourceId:                     aws.String(d.Id()),
+		}
+
+		_, err := conn.ModifyCertificateBasedAuthProperties(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting WorkSpaces Directory (%s) certificate-based authentication properties: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("saml_properties"); ok {
 		input := &workspaces.ModifySamlPropertiesInput{
 			ResourceId:     aws.String(d.Id()),
@@ -362,7 +482,14 @@ func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading WorkSpaces Directory (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("active_directory_config", flattenActiveDirectoryConfig(directory.ActiveDirectoryConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting active_directory_config: %s", err)
+	}
 	d.Set(names.AttrAlias, directory.Alias)
+	if err := d.Set("certificate_based_auth_properties", flattenCertificateBasedAuthProperties(directory.CertificateBasedAuthProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting certificate_based_auth_properties: %s", err)
+	}
+	d.Set("customer_user_name", directory.CustomerUserName)
 	d.Set("directory_id", directory.DirectoryId)
 	d.Set("directory_name", directory.DirectoryName)
 	d.Set("directory_type", directory.DirectoryType)
@@ -377,13 +504,17 @@ func resourceDirectoryRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting saml_properties: %s", err)
 	}
 	d.Set(names.AttrSubnetIDs, directory.SubnetIds)
+	d.Set("user_identity_type", directory.UserIdentityType)
 	if err := d.Set("workspace_access_properties", flattenWorkspaceAccessProperties(directory.WorkspaceAccessProperties)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting workspace_access_properties: %s", err)
 	}
 	if err := d.Set("workspace_creation_properties", flattenDefaultWorkspaceCreationProperties(directory.WorkspaceCreationProperties)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting workspace_creation_properties: %s", err)
 	}
+	d.Set("workspace_directory_description", directory.WorkspaceDirectoryDescription)
+	d.Set("workspace_directory_name", directory.WorkspaceDirectoryName)
 	d.Set("workspace_security_group_id", directory.WorkspaceSecurityGroupId)
+	d.Set("workspace_type", directory.WorkspaceType)
 
 	return diags
 }
@@ -392,6 +523,27 @@ func resourceDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
 
+	if d.HasChange("certificate_based_auth_properties") {
+		tfList := d.Get("certificate_based_auth_properties").([]interface{})
+
+		var dels []types.DeletableCertificateBasedAuthProperty
+		if len(tfList) == 0 || tfList[0] == nil || tfList[0].(map[string]interface{})["certificate_authority_arn"].(string) == "" {
+			dels = append(dels, types.DeletableCertificateBasedAuthPropertyCertificateBasedAuthPropertiesCertificateAuthorityArn)
+		}
+
+		input := &workspaces.ModifyCertificateBasedAuthPropertiesInput{
+			CertificateBasedAuthProperties: expandCertificateBasedAuthProperties(tfList),
+			PropertiesToDelete:             dels,
+			ResourceId:                     aws.String(d.Id()),
+		}
+
+		_, err := conn.ModifyCertificateBasedAuthProperties(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating WorkSpaces Directory (%s) certificate-based authentication properties: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("saml_properties") {
 		tfListSAMLProperties := d.Get("saml_properties").([]interface{})
 		tfMap := tfListSAMLProperties[0].(map[string]interface{})
@@ -680,6 +832,44 @@ func expandWorkspaceAccessProperties(tfList []interface{}) *types.WorkspaceAcces
 	return apiObject
 }
 
+func expandActiveDirectoryConfig(tfList []interface{}) *types.ActiveDirectoryConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.ActiveDirectoryConfig{}
+
+	if v, ok := tfMap[names.AttrDomainName].(string); ok && v != "" {
+		apiObject.DomainName = aws.String(v)
+	}
+
+	if v, ok := tfMap["service_account_secret_arn"].(string); ok && v != "" {
+		apiObject.ServiceAccountSecretArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCertificateBasedAuthProperties(tfList []interface{}) *types.CertificateBasedAuthProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &types.CertificateBasedAuthProperties{}
+
+	if v, ok := tfMap["certificate_authority_arn"].(string); ok && v != "" {
+		apiObject.CertificateAuthorityArn = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
+		apiObject.Status = types.CertificateBasedAuthStatusEnum(v)
+	}
+
+	return apiObject
+}
+
 func expandSAMLProperties(tfList []interface{}) *types.SamlProperties {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -786,6 +976,32 @@ func flattenWorkspaceAccessProperties(apiObject *types.WorkspaceAccessProperties
 	}
 }
 
+func flattenActiveDirectoryConfig(apiObject *types.ActiveDirectoryConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			names.AttrDomainName:         aws.ToString(apiObject.DomainName),
+			"service_account_secret_arn": aws.ToString(apiObject.ServiceAccountSecretArn),
+		},
+	}
+}
+
+func flattenCertificateBasedAuthProperties(apiObject *types.CertificateBasedAuthProperties) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"certificate_authority_arn": aws.ToString(apiObject.CertificateAuthorityArn),
+			names.AttrStatus:            apiObject.Status,
+		},
+	}
+}
+
 func flattenSAMLProperties(apiObject *types.SamlProperties) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/workspaces"
 	"github.com/aws/aws-sdk-go-v2/service/workspaces/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -505,6 +506,99 @@ func testAccDirectory_ipGroupIDs(t *testing.T) {
 	})
 }
 
+func testAccDirectory_certificateBasedAuthProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.WorkspaceDirectory
+	rName := sdkacctest.RandString(8)
+
+	resourceName := "aws_workspaces_directory.main"
+	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
+
+	domain := acctest.RandomDomainName()
+	uau := fmt.Sprintf("https://%s/", acctest.RandomDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_certificateBasedAuthProperties(rName, domain, uau, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_based_auth_properties.0.certificate_authority_arn", certificateAuthorityResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.0.status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDirectoryConfig_certificateBasedAuthProperties(rName, domain, uau, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_based_auth_properties.0.certificate_authority_arn", certificateAuthorityResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.0.status", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectory_workspaceTypePools(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.WorkspaceDirectory
+	rName := sdkacctest.RandString(8)
+
+	resourceName := "aws_workspaces_directory.test"
+
+	uau := fmt.Sprintf("https://%s/", acctest.RandomDomainName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDirectory(ctx, t)
+			acctest.PreCheckHasIAMRole(ctx, t, "workspaces_DefaultRole")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(workspaces.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_workspaceTypePools(rName, uau),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "active_directory_config.#", "0"),
+					resource.TestMatchResourceAttr(resourceName, "directory_id", regexache.MustCompile(`^wsd-`)),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "saml_properties.0.user_access_url", uau),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "user_identity_type", string(types.UserIdentityTypeCustomerManaged)),
+					resource.TestCheckResourceAttr(resourceName, "workspace_directory_description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_directory_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "workspace_type", string(types.WorkspaceTypePools)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDirectoryDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesClient(ctx)
@@ -933,3 +1027,64 @@ resource "aws_workspaces_directory" "test" {
 }
 `, rName))
 }
+
+func testAccDirectoryConfig_certificateBasedAuthProperties(rName, domain, uau, status string) string {
+	return acctest.ConfigCompose(
+		testAccDirectoryConfig_Prerequisites(rName, domain),
+		fmt.Sprintf(`
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[4]q
+    }
+  }
+}
+
+resource "aws_workspaces_directory" "main" {
+  directory_id = aws_directory_service_directory.main.id
+
+  certificate_based_auth_properties {
+    certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+    status                    = %[3]q
+  }
+
+  saml_properties {
+    user_access_url = %[2]q
+    status          = "ENABLED"
+  }
+
+  tags = {
+    Name = "tf-testacc-workspaces-directory-%[1]s"
+  }
+}
+`, rName, uau, status, domain))
+}
+
+func testAccDirectoryConfig_workspaceTypePools(rName, uau string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		fmt.Sprintf(`
+resource "aws_workspaces_directory" "test" {
+  subnet_ids                      = aws_subnet.test[*].id
+  user_identity_type              = "CUSTOMER_MANAGED"
+  workspace_directory_description = "Terraform acceptance test"
+  workspace_directory_name        = %[1]q
+  workspace_type                  = "POOLS"
+
+  saml_properties {
+    user_access_url = %[2]q
+    status          = "ENABLED"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, uau))
+}
@@ -8,10 +8,12 @@ var (
 	ResourceConnectionAlias = newConnectionAliasResource
 	ResourceDirectory       = resourceDirectory
 	ResourceIPGroup         = resourceIPGroup
+	ResourcePool            = newPoolResource
 	ResourceWorkspace       = resourceWorkspace
 
 	FindConnectionAliasByID = findConnectionAliasByID
 	FindDirectoryByID       = findDirectoryByID
 	FindIPGroupByID         = findIPGroupByID
+	FindPoolByID            = findPoolByID
 	FindWorkspaceByID       = findWorkspaceByID
 )
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeConnectionAliases,DescribeIpGroups,DescribeWorkspaceImages"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeConnectionAliases,DescribeIpGroups,DescribeWorkspaceImages,DescribeWorkspacesPools"; DO NOT EDIT.
 
 package workspaces
 
@@ -57,3 +57,19 @@ func describeWorkspaceImagesPages(ctx context.Context, conn *workspaces.Client,
 	}
 	return nil
 }
+func describeWorkspacesPoolsPages(ctx context.Context, conn *workspaces.Client, input *workspaces.DescribeWorkspacesPoolsInput, fn func(*workspaces.DescribeWorkspacesPoolsOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeWorkspacesPools(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
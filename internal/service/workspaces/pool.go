@@ -0,0 +1,603 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspaces"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspaces/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Pool")
+// @Tags(identifierAttribute="id")
+func newPoolResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &poolResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type poolResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*poolResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspaces_pool"
+}
+
+func (r *poolResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bundle_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"directory_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"pool_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkspacesPoolState](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"application_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[applicationSettingsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_bucket_name": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"settings_group": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(100),
+							},
+						},
+						names.AttrStatus: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ApplicationSettingsStatusEnum](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"capacity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[capacityModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"desired_user_sessions": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"timeout_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[timeoutSettingsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"disconnect_timeout_in_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(60, 36000),
+							},
+						},
+						"idle_disconnect_timeout_in_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(0, 36000),
+							},
+						},
+						"max_user_duration_in_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(600, 432000),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *poolResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	var input workspaces.CreateWorkspacesPoolInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkspacesPool(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkSpaces Pool (%s)", data.PoolName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PoolID = fwflex.StringToFramework(ctx, output.WorkspacesPool.PoolId)
+
+	pool, err := waitPoolCreated(ctx, conn, data.PoolID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) create", data.PoolID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, pool)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *poolResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	pool, err := findPoolByID(ctx, conn, data.PoolID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Pool (%s)", data.PoolID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, pool)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *poolResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old poolResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	if !new.ApplicationSettings.Equal(old.ApplicationSettings) ||
+		!new.BundleID.Equal(old.BundleID) ||
+		!new.Capacity.Equal(old.Capacity) ||
+		!new.Description.Equal(old.Description) ||
+		!new.DirectoryID.Equal(old.DirectoryID) ||
+		!new.TimeoutSettings.Equal(old.TimeoutSettings) {
+		var input workspaces.UpdateWorkspacesPoolInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWorkspacesPool(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Pool (%s)", new.PoolID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	pool, err := waitPoolUpdated(ctx, conn, new.PoolID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) update", new.PoolID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, pool)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *poolResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data poolResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesClient(ctx)
+
+	id := data.PoolID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// A pool must be stopped before it can be terminated.
+	pool, err := findPoolByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Pool (%s)", id), err.Error())
+
+		return
+	}
+
+	switch pool.State {
+	case awstypes.WorkspacesPoolStateRunning, awstypes.WorkspacesPoolStateStarting, awstypes.WorkspacesPoolStateUpdating:
+		if pool.State != awstypes.WorkspacesPoolStateRunning {
+			if _, err := waitPoolUpdated(ctx, conn, id, timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) to become available", id), err.Error())
+
+				return
+			}
+		}
+
+		_, err := conn.StopWorkspacesPool(ctx, &workspaces.StopWorkspacesPoolInput{
+			PoolId: aws.String(id),
+		})
+
+		if err != nil && !errs.IsA[*awstypes.InvalidResourceStateException](err) {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping WorkSpaces Pool (%s)", id), err.Error())
+
+			return
+		}
+
+		fallthrough
+	case awstypes.WorkspacesPoolStateStopping:
+		if _, err := waitPoolStopped(ctx, conn, id, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) stop", id), err.Error())
+
+			return
+		}
+	}
+
+	_, err = conn.TerminateWorkspacesPool(ctx, &workspaces.TerminateWorkspacesPoolInput{
+		PoolId: aws.String(id),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Pool (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitPoolDeleted(ctx, conn, id, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for WorkSpaces Pool (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *poolResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPoolByID(ctx context.Context, conn *workspaces.Client, id string) (*awstypes.WorkspacesPool, error) {
+	input := &workspaces.DescribeWorkspacesPoolsInput{
+		PoolIds: []string{id},
+	}
+
+	return findPool(ctx, conn, input)
+}
+
+func findPool(ctx context.Context, conn *workspaces.Client, input *workspaces.DescribeWorkspacesPoolsInput) (*awstypes.WorkspacesPool, error) {
+	output, err := findPools(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findPools(ctx context.Context, conn *workspaces.Client, input *workspaces.DescribeWorkspacesPoolsInput) ([]awstypes.WorkspacesPool, error) {
+	var output []awstypes.WorkspacesPool
+
+	err := describeWorkspacesPoolsPages(ctx, conn, input, func(page *workspaces.DescribeWorkspacesPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.WorkspacesPools...)
+
+		return !lastPage
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusPool(ctx context.Context, conn *workspaces.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPoolByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitPoolCreated(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateCreating),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateStopped, awstypes.WorkspacesPoolStateRunning),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolsError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolUpdated(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateUpdating, awstypes.WorkspacesPoolStateStarting),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateStopped, awstypes.WorkspacesPoolStateRunning),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolsError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolStopped(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateRunning, awstypes.WorkspacesPoolStateStopping),
+		Target:  enum.Slice(awstypes.WorkspacesPoolStateStopped),
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolsError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPoolDeleted(ctx context.Context, conn *workspaces.Client, id string, timeout time.Duration) (*awstypes.WorkspacesPool, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WorkspacesPoolStateDeleting, awstypes.WorkspacesPoolStateStopped),
+		Target:  []string{},
+		Refresh: statusPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.WorkspacesPool); ok {
+		tfresource.SetLastError(err, poolsError(output.Errors))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func poolsError(apiObjects []awstypes.WorkspacesPoolError) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("%s: %s", apiObject.ErrorCode, aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+type poolResourceModel struct {
+	ApplicationSettings fwtypes.ListNestedObjectValueOf[applicationSettingsModel] `tfsdk:"application_settings"`
+	BundleID            types.String                                              `tfsdk:"bundle_id"`
+	Capacity            fwtypes.ListNestedObjectValueOf[capacityModel]            `tfsdk:"capacity"`
+	Description         types.String                                              `tfsdk:"description"`
+	DirectoryID         types.String                                              `tfsdk:"directory_id"`
+	PoolARN             types.String                                              `tfsdk:"arn"`
+	PoolID              types.String                                              `tfsdk:"id"`
+	PoolName            types.String                                              `tfsdk:"pool_name"`
+	State               fwtypes.StringEnum[awstypes.WorkspacesPoolState]          `tfsdk:"state"`
+	Tags                tftags.Map                                                `tfsdk:"tags"`
+	TagsAll             tftags.Map                                                `tfsdk:"tags_all"`
+	TimeoutSettings     fwtypes.ListNestedObjectValueOf[timeoutSettingsModel]     `tfsdk:"timeout_settings"`
+	Timeouts            timeouts.Value                                            `tfsdk:"timeouts"`
+}
+
+// flatten sets the model's attributes from the API representation.
+// Application and timeout settings always have service defaults, so they are
+// only recorded if they were configured or, for application settings, enabled.
+func (m *poolResourceModel) flatten(ctx context.Context, pool *awstypes.WorkspacesPool) (diags diag.Diagnostics) {
+	applicationSettings, timeoutSettings := m.ApplicationSettings, m.TimeoutSettings
+
+	diags.Append(fwflex.Flatten(ctx, pool, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(applicationSettings.Elements()) == 0 && (pool.ApplicationSettings == nil || pool.ApplicationSettings.Status != awstypes.ApplicationSettingsStatusEnumEnabled) {
+		m.ApplicationSettings = applicationSettings
+	}
+
+	if len(timeoutSettings.Elements()) == 0 {
+		m.TimeoutSettings = timeoutSettings
+	}
+
+	if v := pool.CapacityStatus; v != nil {
+		m.Capacity = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &capacityModel{
+			DesiredUserSessions: fwflex.Int32ToFramework(ctx, v.DesiredUserSessions),
+		})
+	}
+
+	return diags
+}
+
+type applicationSettingsModel struct {
+	S3BucketName  types.String                                               `tfsdk:"s3_bucket_name"`
+	SettingsGroup types.String                                               `tfsdk:"settings_group"`
+	Status        fwtypes.StringEnum[awstypes.ApplicationSettingsStatusEnum] `tfsdk:"status"`
+}
+
+type capacityModel struct {
+	DesiredUserSessions types.Int64 `tfsdk:"desired_user_sessions"`
+}
+
+type timeoutSettingsModel struct {
+	DisconnectTimeoutInSeconds     types.Int64 `tfsdk:"disconnect_timeout_in_seconds"`
+	IdleDisconnectTimeoutInSeconds types.Int64 `tfsdk:"idle_disconnect_timeout_in_seconds"`
+	MaxUserDurationInSeconds       types.Int64 `tfsdk:"max_user_duration_in_seconds"`
+}
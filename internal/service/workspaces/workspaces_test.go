@@ -14,16 +14,18 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 
 	testCases := map[string]map[string]func(t *testing.T){
 		"Directory": {
-			acctest.CtBasic:               testAccDirectory_basic,
-			acctest.CtDisappears:          testAccDirectory_disappears,
-			"ipGroupIds":                  testAccDirectory_ipGroupIDs,
-			"selfServicePermissions":      testAccDirectory_selfServicePermissions,
-			"subnetIDs":                   testAccDirectory_subnetIDs,
-			"tags":                        testAccDirectory_tags,
-			"workspaceAccessProperties":   testAccDirectory_workspaceAccessProperties,
-			"workspaceCreationProperties": testAccDirectory_workspaceCreationProperties,
+			acctest.CtBasic:                  testAccDirectory_basic,
+			acctest.CtDisappears:             testAccDirectory_disappears,
+			"certificateBasedAuthProperties": testAccDirectory_certificateBasedAuthProperties,
+			"ipGroupIds":                     testAccDirectory_ipGroupIDs,
+			"selfServicePermissions":         testAccDirectory_selfServicePermissions,
+			"subnetIDs":                      testAccDirectory_subnetIDs,
+			"tags":                           testAccDirectory_tags,
+			"workspaceAccessProperties":      testAccDirectory_workspaceAccessProperties,
+			"workspaceCreationProperties":    testAccDirectory_workspaceCreationProperties,
 			"workspaceCreationProperties_customSecurityGroupId_defaultOu": testAccDirectory_workspaceCreationProperties_customSecurityGroupId_defaultOu,
 			"workspaceSamlProperties":                                     testAccDirectory_SamlProperties,
+			"workspaceTypePools":                                          testAccDirectory_workspaceTypePools,
 		},
 		"IpGroup": {
 			acctest.CtBasic:       testAccIPGroup_basic,
@@ -31,6 +33,13 @@ func TestAccWorkSpaces_serial(t *testing.T) {
 			"multipleDirectories": testAccIPGroup_MultipleDirectories,
 			"tags":                testAccIPGroup_tags,
 		},
+		"Pool": {
+			acctest.CtBasic:       testAccPool_basic,
+			acctest.CtDisappears:  testAccPool_disappears,
+			"applicationSettings": testAccPool_applicationSettings,
+			"tags":                testAccPool_tags,
+			"update":              testAccPool_update,
+		},
 		"Workspace": {
 			acctest.CtBasic:          testAccWorkspace_basic,
 			"recreate":               testAccWorkspace_recreate,
@@ -51,7 +51,36 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	ExcludeResourceTypes  []string                        // Resource types to which no default tags are applied
+	ResourceTypeOverrides map[string]ResourceTypeOverride // Per-resource type overrides, keyed by resource type
+	Tags                  KeyValueTags
+}
+
+// ResourceTypeOverride contains overrides of the default tags for a single resource type.
+type ResourceTypeOverride struct {
+	ExcludeKeys KeyValueTags // Default tag keys not applied to the resource type
+	Tags        KeyValueTags // Tags merged on to the default tags
+}
+
+// ForResourceType returns the default tags configuration for the specified resource type.
+// Returns nil if the resource type is excluded from default tagging.
+func (dc *DefaultConfig) ForResourceType(typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	if slices.Contains(dc.ExcludeResourceTypes, typeName) {
+		return nil
+	}
+
+	override, ok := dc.ResourceTypeOverrides[typeName]
+	if !ok {
+		return dc
+	}
+
+	return &DefaultConfig{
+		Tags: dc.Tags.Ignore(override.ExcludeKeys).Merge(override.Tags),
+	}
 }
 
 // IgnoreConfig contains various options for removing resource tags.
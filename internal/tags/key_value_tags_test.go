@@ -314,6 +314,85 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		ExcludeResourceTypes: []string{"aws_excluded"},
+		ResourceTypeOverrides: map[string]ResourceTypeOverride{
+			"aws_overridden": {
+				ExcludeKeys: New(ctx, []string{"key1"}),
+				Tags: New(ctx, map[string]string{
+					"key2": "override2",
+					"key4": "value4",
+				}),
+			},
+		},
+		Tags: New(ctx, map[string]string{
+			"key1": "value1",
+			"key2": "value2",
+			"key3": "value3",
+		}),
+	}
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		wantNil       bool
+		want          map[string]string
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_test",
+			wantNil:  true,
+		},
+		{
+			name:          "no override",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_test",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name:          "excluded",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_excluded",
+			wantNil:       true,
+		},
+		{
+			name:          "overridden",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_overridden",
+			want: map[string]string{
+				"key2": "override2",
+				"key3": "value3",
+				"key4": "value4",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName)
+
+			if testCase.wantNil {
+				if got != nil {
+					t.Errorf("got %v, want nil", got)
+				}
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
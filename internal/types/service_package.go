@@ -6,8 +6,10 @@ package types
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -18,11 +20,31 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level Region information.
+type ServicePackageResourceRegion struct {
+	IsOverrideEnabled bool // Is per-resource Region override supported?
+}
+
+// ServicePackageResourceIdentity represents resource-level identity information.
+type ServicePackageResourceIdentity struct {
+	IdentityAttribute string // The attribute that uniquely identifies the resource within an account and Region.
+	IsGlobalResource  bool   // Is the resource global, i.e. not identified by Region?
+}
+
+// ServicePackageAction represents a Terraform Plugin Framework action
+// implemented by a service package.
+type ServicePackageAction struct {
+	Factory func(context.Context) (action.ActionWithConfigure, error)
+	Name    string
+	Region  *ServicePackageResourceRegion
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {
 	Factory func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
 	Name    string
+	Region  *ServicePackageResourceRegion
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
@@ -30,15 +52,18 @@ type ServicePackageEphemeralResource struct {
 type ServicePackageFrameworkDataSource struct {
 	Factory func(context.Context) (datasource.DataSourceWithConfigure, error)
 	Name    string
+	Region  *ServicePackageResourceRegion
 	Tags    *ServicePackageResourceTags
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
+	Name     string
+	Identity *ServicePackageResourceIdentity
+	Region   *ServicePackageResourceRegion
+	Tags     *ServicePackageResourceTags
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -50,11 +75,21 @@ type ServicePackageSDKDataSource struct {
 	Tags     *ServicePackageResourceTags
 }
 
+// ServicePackageSDKListResource represents a Terraform Plugin Framework list resource
+// for a Terraform Plugin SDK resource implemented by a service package.
+type ServicePackageSDKListResource struct {
+	Factory  func() list.ListResourceWithConfigure
+	TypeName string
+	Name     string
+	Region   *ServicePackageResourceRegion
+}
+
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
 	Factory  func() *schema.Resource
 	TypeName string
 	Name     string
+	Identity *ServicePackageResourceIdentity
 	Tags     *ServicePackageResourceTags
 }
@@ -150,6 +150,7 @@ const (
 	MQ                           = "mq"
 	MWAA                         = "mwaa"
 	Macie2                       = "macie2"
+	MailManager                  = "mailmanager"
 	MediaConnect                 = "mediaconnect"
 	MediaConvert                 = "mediaconvert"
 	MediaLive                    = "medialive"
@@ -403,6 +404,7 @@ const (
 	MQServiceID                           = "mq"
 	MWAAServiceID                         = "MWAA"
 	Macie2ServiceID                       = "Macie2"
+	MailManagerServiceID                  = "MailManager"
 	MediaConnectServiceID                 = "MediaConnect"
 	MediaConvertServiceID                 = "MediaConvert"
 	MediaLiveServiceID                    = "MediaLive"
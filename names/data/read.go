@@ -183,6 +183,10 @@ func (sr ServiceRecord) EndpointOnly() bool {
 	return false
 }
 
+func (sr ServiceRecord) IsGlobal() bool {
+	return sr.service.IsGlobal
+}
+
 func (sr ServiceRecord) AllowedSubcategory() bool {
 	return sr.service.AllowedSubcategory
 }
@@ -339,6 +343,7 @@ type Service struct {
 	Exclude                       bool     `hcl:"exclude,optional"`
 	NotImplemented                bool     `hcl:"not_implemented,optional"`
 	AllowedSubcategory            bool     `hcl:"allowed_subcategory,optional"`
+	IsGlobal                      bool     `hcl:"is_global,optional"`
 	Note                          string   `hcl:"note,optional"`
 }
 
@@ -106,6 +106,7 @@ const (
 	M2EndpointID                           = "m2"
 	MQEndpointID                           = "mq"
 	Macie2EndpointID                       = "macie2"
+	MailManagerEndpointID                  = "mail-manager"
 	MediaConvertEndpointID                 = "mediaconvert"
 	MediaLiveEndpointID                    = "medialive"
 	ObservabilityAccessManagerEndpointID   = "oam"
@@ -166,6 +167,7 @@ type serviceDatum struct {
 	aliases           []string
 	brand             string
 	humanFriendly     string
+	isGlobal          bool
 	providerNameUpper string
 }
 
@@ -204,6 +206,7 @@ func readHCLIntoServiceData() error {
 		sd := serviceDatum{
 			brand:             l.Brand(),
 			humanFriendly:     l.HumanFriendly(),
+			isGlobal:          l.IsGlobal(),
 			providerNameUpper: l.ProviderNameUpper(),
 		}
 
@@ -290,3 +293,12 @@ func HumanFriendly(service string) (string, error) {
 
 	return "", fmt.Errorf("no service data found for %s", service)
 }
+
+// IsGlobal returns whether the specified service package's resources are not Regional.
+func IsGlobal(service string) bool {
+	if v, ok := serviceData[service]; ok {
+		return v.isGlobal
+	}
+
+	return false
+}
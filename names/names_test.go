@@ -388,3 +388,44 @@ func TestFullHumanFriendly(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGlobal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected bool
+	}{
+		{
+			TestName: IAM,
+			Input:    IAM,
+			Expected: true,
+		},
+		{
+			TestName: CostOptimizationHub,
+			Input:    CostOptimizationHub,
+			Expected: true,
+		},
+		{
+			TestName: EC2,
+			Input:    EC2,
+			Expected: false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsGlobal(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}